@@ -98,7 +98,11 @@ import (
 	"github.com/0glabs/0g-chain/app/ante"
 	chainparams "github.com/0glabs/0g-chain/app/params"
 	"github.com/0glabs/0g-chain/chaincfg"
+	conversionprecompile "github.com/0glabs/0g-chain/precompiles/conversion"
 	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
+	govprecompile "github.com/0glabs/0g-chain/precompiles/gov"
+	pricefeedprecompile "github.com/0glabs/0g-chain/precompiles/pricefeed"
+	stakingprecompile "github.com/0glabs/0g-chain/precompiles/staking"
 
 	"github.com/0glabs/0g-chain/x/bep3"
 	bep3keeper "github.com/0glabs/0g-chain/x/bep3/keeper"
@@ -447,7 +451,12 @@ func NewApp(
 
 	evmBankKeeper := evmutilkeeper.NewEvmBankKeeper(app.evmutilKeeper, app.bankKeeper, app.accountKeeper)
 	// dasigners keeper
-	app.dasignersKeeper = dasignerskeeper.NewKeeper(keys[dasignerstypes.StoreKey], appCodec, app.stakingKeeper)
+	app.dasignersKeeper = dasignerskeeper.NewKeeper(keys[dasignerstypes.StoreKey], appCodec, app.stakingKeeper, govAuthorityAddr.String())
+	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
+		appCodec,
+		keys[pricefeedtypes.StoreKey],
+		pricefeedSubspace,
+	)
 	// precopmiles
 	precompiles := make(map[common.Address]vm.PrecompiledContract)
 	daSignersPrecompile, err := dasignersprecompile.NewDASignersPrecompile(app.dasignersKeeper)
@@ -455,6 +464,26 @@ func NewApp(
 		panic("initialize precompile failed")
 	}
 	precompiles[daSignersPrecompile.Address()] = daSignersPrecompile
+	priceFeedPrecompile, err := pricefeedprecompile.NewPriceFeedPrecompile(app.pricefeedKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[priceFeedPrecompile.Address()] = priceFeedPrecompile
+	stakingPrecompile, err := stakingprecompile.NewStakingPrecompile(&app.stakingKeeper, app.distrKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[stakingPrecompile.Address()] = stakingPrecompile
+	conversionPrecompile, err := conversionprecompile.NewConversionPrecompile(&app.evmutilKeeper, app.bankKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[conversionPrecompile.Address()] = conversionPrecompile
+	govPrecompile, err := govprecompile.NewGovPrecompile(&app.govKeeper, &app.committeeKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[govPrecompile.Address()] = govPrecompile
 	// evm keeper
 	app.evmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey],
@@ -501,11 +530,6 @@ func NewApp(
 		bep3Subspace,
 		app.ModuleAccountAddrs(),
 	)
-	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
-		appCodec,
-		keys[pricefeedtypes.StoreKey],
-		pricefeedSubspace,
-	)
 
 	app.mintKeeper = mintkeeper.NewKeeper(
 		appCodec,
@@ -517,6 +541,10 @@ func NewApp(
 		authtypes.FeeCollectorName,
 	)
 
+	app.CouncilKeeper = councilkeeper.NewKeeper(
+		keys[counciltypes.StoreKey], appCodec, app.stakingKeeper,
+	)
+
 	// create committee keeper with router
 	committeeGovRouter := govv1beta1.NewRouter()
 	committeeGovRouter.
@@ -533,6 +561,7 @@ func NewApp(
 		app.paramsKeeper,
 		app.accountKeeper,
 		app.bankKeeper,
+		app.CouncilKeeper,
 	)
 
 	// register the staking hooks
@@ -541,6 +570,8 @@ func NewApp(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
+			app.dasignersKeeper.Hooks(),
+			app.CouncilKeeper.Hooks(),
 		)))
 
 	// create gov keeper with router
@@ -573,10 +604,6 @@ func NewApp(
 	)
 	app.govKeeper.SetTallyHandler(tallyHandler)
 
-	app.CouncilKeeper = councilkeeper.NewKeeper(
-		keys[counciltypes.StoreKey], appCodec, app.stakingKeeper,
-	)
-
 	// create the module manager (Note: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.)
 	app.mm = module.NewManager(
@@ -616,6 +643,9 @@ func NewApp(
 		upgradetypes.ModuleName,
 		// Capability begin blocker runs non state changing initialization.
 		capabilitytypes.ModuleName,
+		// Council begin blocker elects council members, run before committee so council committees
+		// rotate their members in the block of the election.
+		counciltypes.ModuleName,
 		// Committee begin blocker changes module params by enacting proposals.
 		// Run before to ensure params are updated together before state changes.
 		committeetypes.ModuleName,
@@ -650,7 +680,6 @@ func NewApp(
 		authz.ModuleName,
 		evmutiltypes.ModuleName,
 
-		counciltypes.ModuleName,
 		dasignerstypes.ModuleName,
 	)
 
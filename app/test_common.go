@@ -44,6 +44,7 @@ import (
 	"github.com/0glabs/0g-chain/chaincfg"
 	bep3keeper "github.com/0glabs/0g-chain/x/bep3/keeper"
 	committeekeeper "github.com/0glabs/0g-chain/x/committee/keeper"
+	dasignerskeeper "github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
 	evmutilkeeper "github.com/0glabs/0g-chain/x/evmutil/keeper"
 	issuancekeeper "github.com/0glabs/0g-chain/x/issuance/keeper"
 	pricefeedkeeper "github.com/0glabs/0g-chain/x/pricefeed/keeper"
@@ -110,6 +111,7 @@ func (tApp TestApp) GetCommitteeKeeper() committeekeeper.Keeper { return tApp.co
 func (tApp TestApp) GetEvmutilKeeper() evmutilkeeper.Keeper     { return tApp.evmutilKeeper }
 func (tApp TestApp) GetEvmKeeper() *evmkeeper.Keeper            { return tApp.evmKeeper }
 func (tApp TestApp) GetFeeMarketKeeper() feemarketkeeper.Keeper { return tApp.feeMarketKeeper }
+func (tApp TestApp) GetDASignersKeeper() dasignerskeeper.Keeper { return tApp.dasignersKeeper }
 
 func (tApp TestApp) GetKVStoreKey(key string) *storetypes.KVStoreKey {
 	return tApp.keys[key]
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// IsDirectCall returns true if the precompile is called by the transaction sender itself. Changes made by
+// a precompile through the keepers are not journaled by the StateDB, so methods moving funds only accept
+// direct calls: no calling contract frame can revert the EVM state while the keeper changes are kept.
+func IsDirectCall(evm *vm.EVM, contract *vm.Contract, precompile common.Address) bool {
+	return contract.Caller() == evm.Origin && contract.Address() == precompile
+}
+
+// SyncBalances runs fn and applies the changes it made to the bank balances of addrs to the StateDB.
+// Without this the StateDB would overwrite the balances with the ones it cached when it is committed.
+func SyncBalances(ctx sdk.Context, stateDB *statedb.StateDB, addrs []common.Address, fn func() error) error {
+	before := make(map[common.Address]*big.Int, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := before[addr]; ok {
+			continue
+		}
+		// load the account in the StateDB before its balance changes, accounts which are not loaded
+		// read the changed balance from the keeper once they are used
+		if !stateDB.Exist(addr) {
+			continue
+		}
+		before[addr] = bankBalance(ctx, stateDB, addr)
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	for addr, balance := range before {
+		delta := new(big.Int).Sub(bankBalance(ctx, stateDB, addr), balance)
+		switch delta.Sign() {
+		case 1:
+			stateDB.AddBalance(addr, delta)
+		case -1:
+			stateDB.SubBalance(addr, delta.Neg(delta))
+		}
+	}
+	return nil
+}
+
+func bankBalance(ctx sdk.Context, stateDB *statedb.StateDB, addr common.Address) *big.Int {
+	account := stateDB.Keeper().GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
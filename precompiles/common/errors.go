@@ -3,4 +3,8 @@ package common
 const (
 	ErrGetStateDB          = "get EVM StateDB failed"
 	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
+	ErrDirectCallOnly      = "%s can only be called directly by the transaction sender"
+	ErrInvalidInput        = "invalid input"
+	ErrMethodNotRegistered = "method %s not registered"
+	ErrEventNotFound       = "event %s not found in the ABI"
 )
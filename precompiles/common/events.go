@@ -0,0 +1,51 @@
+package common
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// EmitEvent adds a log of an ABI event to the StateDB, args are the values of all the event inputs in order,
+// the indexed ones become the topics of the log.
+func (p *Precompile) EmitEvent(ctx sdk.Context, stateDB *statedb.StateDB, name string, args ...interface{}) error {
+	event, ok := p.abi.Events[name]
+	if !ok {
+		return fmt.Errorf(ErrEventNotFound, name)
+	}
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf(ErrInvalidNumberOfArgs, len(event.Inputs), len(args))
+	}
+	rules := [][]interface{}{{event.ID}}
+	var data []interface{}
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			rules = append(rules, []interface{}{args[i]})
+		} else {
+			data = append(data, args[i])
+		}
+	}
+	topics, err := abi.MakeTopics(rules...)
+	if err != nil {
+		return err
+	}
+	b, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+	log := &types.Log{
+		Address:     p.address,
+		Topics:      make([]common.Hash, len(topics)),
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	}
+	for i, topic := range topics {
+		log.Topics[i] = topic[0]
+	}
+	stateDB.AddLog(log)
+	return nil
+}
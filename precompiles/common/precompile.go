@@ -0,0 +1,189 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// RequiredGasMax is charged for inputs which do not call a registered method
+const RequiredGasMax uint64 = 1000_000_000
+
+// QueryHandler executes a view method and returns its packed outputs. Its store changes are always discarded.
+type QueryHandler func(ctx sdk.Context, evm *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error)
+
+// TxHandler executes a state changing method and returns its packed outputs. Its store changes are discarded
+// if it fails.
+type TxHandler func(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error)
+
+// Method is a method registered to a precompile.
+type Method struct {
+	// RequiredGas is charged before the method is executed, the gas consumed by the store accesses of the
+	// method is charged on top of it
+	RequiredGas uint64
+
+	query          QueryHandler
+	tx             TxHandler
+	directCallOnly bool
+}
+
+// DirectCallOnly only allows the transaction sender to call the method, not contracts. Changes made through
+// the keepers are not journaled by the StateDB, so methods moving funds or writing state must not be called
+// from a contract frame which can revert while the keeper changes are kept.
+func (m *Method) DirectCallOnly() *Method {
+	m.directCallOnly = true
+	return m
+}
+
+// Precompile dispatches the calls of a precompiled contract to the handlers registered for the methods of
+// its ABI. Failed calls revert with an ABI encoded Error(string) reason.
+type Precompile struct {
+	abi         abi.ABI
+	address     common.Address
+	methods     map[string]*Method
+	kvGasConfig storetypes.GasConfig
+}
+
+func NewPrecompile(address string, abiJSON string) (*Precompile, error) {
+	abi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+	return &Precompile{
+		abi:         abi,
+		address:     common.HexToAddress(address),
+		methods:     make(map[string]*Method),
+		kvGasConfig: storetypes.KVGasConfig(),
+	}, nil
+}
+
+// ABI returns the ABI of the precompile.
+func (p *Precompile) ABI() abi.ABI {
+	return p.abi
+}
+
+// SetKVGasConfig sets the gas charged for the store accesses of the methods, it defaults to the gas config
+// of the cosmos transactions.
+func (p *Precompile) SetKVGasConfig(gasConfig storetypes.GasConfig) {
+	p.kvGasConfig = gasConfig
+}
+
+func (p *Precompile) register(name string, requiredGas uint64, constant bool) *Method {
+	method, ok := p.abi.Methods[name]
+	if !ok {
+		panic(fmt.Sprintf("method %s not found in the ABI", name))
+	}
+	if method.IsConstant() != constant {
+		panic(fmt.Sprintf("method %s has the wrong state mutability %s", name, method.StateMutability))
+	}
+	if _, ok := p.methods[name]; ok {
+		panic(fmt.Sprintf("method %s already registered", name))
+	}
+	m := &Method{RequiredGas: requiredGas}
+	p.methods[name] = m
+	return m
+}
+
+// RegisterQuery registers the handler of a view method, it panics if the method is not a view method of the ABI.
+func (p *Precompile) RegisterQuery(name string, requiredGas uint64, handler QueryHandler) *Method {
+	m := p.register(name, requiredGas, true)
+	m.query = handler
+	return m
+}
+
+// RegisterTx registers the handler of a state changing method, it panics if the method is not a state changing
+// method of the ABI.
+func (p *Precompile) RegisterTx(name string, requiredGas uint64, handler TxHandler) *Method {
+	m := p.register(name, requiredGas, false)
+	m.tx = handler
+	return m
+}
+
+// Address implements vm.PrecompiledContract.
+func (p *Precompile) Address() common.Address {
+	return p.address
+}
+
+// RequiredGas implements vm.PrecompiledContract.
+func (p *Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return RequiredGasMax
+	}
+	method, err := p.abi.MethodById(input[:4])
+	if err != nil {
+		return RequiredGasMax
+	}
+	if m, ok := p.methods[method.Name]; ok {
+		return m.RequiredGas
+	}
+	return RequiredGasMax
+}
+
+// Run implements vm.PrecompiledContract.
+func (p *Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) (bz []byte, err error) {
+	// parse input
+	if len(contract.Input) < 4 {
+		return RevertReason(fmt.Errorf(ErrInvalidInput))
+	}
+	method, err := p.abi.MethodById(contract.Input[:4])
+	if err != nil {
+		return RevertReason(err)
+	}
+	m, ok := p.methods[method.Name]
+	if !ok {
+		return RevertReason(fmt.Errorf(ErrMethodNotRegistered, method.Name))
+	}
+	if m.tx != nil {
+		if readonly {
+			return nil, vm.ErrWriteProtection
+		}
+		if m.directCallOnly && !IsDirectCall(evm, contract, p.address) {
+			return RevertReason(fmt.Errorf(ErrDirectCallOnly, method.Name))
+		}
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return RevertReason(err)
+	}
+	// get state db and context
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, fmt.Errorf(ErrGetStateDB)
+	}
+	// the store accesses and the gas consumed by the method are metered against the gas left in the
+	// contract, so a call runs out of gas as soon as it exceeds it rather than after all the work is done
+	gasMeter := sdk.NewGasMeter(contract.Gas)
+	ctx := stateDB.GetContext().WithGasMeter(gasMeter).WithKVGasConfig(p.kvGasConfig)
+	cacheCtx, write := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			bz, err = nil, vm.ErrOutOfGas
+		}
+	}()
+
+	if m.tx != nil {
+		bz, err = m.tx(cacheCtx, evm, contract, stateDB, method, args)
+	} else {
+		bz, err = m.query(cacheCtx, evm, method, args)
+	}
+
+	if !contract.UseGas(gasMeter.GasConsumed()) {
+		return nil, vm.ErrOutOfGas
+	}
+	if err != nil {
+		return RevertReason(err)
+	}
+	if m.tx != nil {
+		write()
+	}
+	return bz, nil
+}
@@ -0,0 +1,204 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/0glabs/0g-chain/app"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	evmutiltypes "github.com/0glabs/0g-chain/x/evmutil/types"
+)
+
+const (
+	testAddress = "0x00000000000000000000000000000000000010ff"
+
+	testABI = `[
+		{"type":"function","name":"get","stateMutability":"view","inputs":[{"name":"key","type":"bytes32"}],"outputs":[{"name":"value","type":"bytes"}]},
+		{"type":"function","name":"set","stateMutability":"nonpayable","inputs":[{"name":"key","type":"bytes32"},{"name":"value","type":"bytes"}],"outputs":[]},
+		{"type":"function","name":"setDirect","stateMutability":"nonpayable","inputs":[{"name":"key","type":"bytes32"},{"name":"value","type":"bytes"}],"outputs":[]},
+		{"type":"function","name":"unregistered","stateMutability":"view","inputs":[],"outputs":[]},
+		{"type":"event","name":"Set","anonymous":false,"inputs":[{"name":"key","type":"bytes32","indexed":true},{"name":"value","type":"bytes","indexed":false}]}
+	]`
+
+	requiredGas uint64 = 1000
+)
+
+var errFailed = errors.New("value is empty")
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	storeKey   storetypes.StoreKey
+	precompile *precopmiles_common.Precompile
+	stateDB    *statedb.StateDB
+	evm        *vm.EVM
+	origin     common.Address
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	suite.ctx = tApp.NewContext(true, tmproto.Header{Height: 1})
+	suite.storeKey = tApp.GetKVStoreKey(evmutiltypes.StoreKey)
+
+	var err error
+	suite.precompile, err = precopmiles_common.NewPrecompile(testAddress, testABI)
+	suite.Require().NoError(err)
+	suite.precompile.RegisterQuery("get", requiredGas, suite.get)
+	suite.precompile.RegisterTx("set", requiredGas, suite.set)
+	suite.precompile.RegisterTx("setDirect", requiredGas, suite.set).DirectCallOnly()
+
+	suite.stateDB = statedb.New(suite.ctx, tApp.GetEvmKeeper(), statedb.NewEmptyTxConfig(common.Hash{}))
+	suite.origin = common.HexToAddress("0x01")
+	suite.evm = vm.NewEVM(vm.BlockContext{}, vm.TxContext{Origin: suite.origin}, suite.stateDB, params.TestChainConfig, vm.Config{})
+}
+
+// get returns the value of a key, it writes to the store to check the writes of queries are discarded
+func (suite *PrecompileTestSuite) get(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	key := args[0].([32]byte)
+	value := ctx.KVStore(suite.storeKey).Get(key[:])
+	ctx.KVStore(suite.storeKey).Set(key[:], []byte("query"))
+	return method.Outputs.Pack(value)
+}
+
+// set writes the value of a key, it fails after the write if the value is empty
+func (suite *PrecompileTestSuite) set(ctx sdk.Context, _ *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	key, value := args[0].([32]byte), args[1].([]byte)
+	ctx.KVStore(suite.storeKey).Set(key[:], value)
+	if len(value) == 0 {
+		return nil, errFailed
+	}
+	if err := suite.precompile.EmitEvent(ctx, stateDB, "Set", key, value); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (suite *PrecompileTestSuite) run(caller common.Address, gas uint64, readonly bool, input []byte) ([]byte, *vm.Contract, error) {
+	contract := vm.NewContract(vm.AccountRef(caller), vm.AccountRef(suite.precompile.Address()), big.NewInt(0), gas)
+	contract.Input = input
+	bz, err := suite.precompile.Run(suite.evm, contract, readonly)
+	return bz, contract, err
+}
+
+func (suite *PrecompileTestSuite) pack(method string, args ...interface{}) []byte {
+	input, err := suite.precompile.ABI().Pack(method, args...)
+	suite.Require().NoError(err)
+	return input
+}
+
+func (suite *PrecompileTestSuite) requireRevert(bz []byte, err error, reason string) {
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	unpacked, unpackErr := abi.UnpackRevert(bz)
+	suite.Require().NoError(unpackErr)
+	suite.Require().Equal(reason, unpacked)
+}
+
+func (suite *PrecompileTestSuite) storedValue(key [32]byte) []byte {
+	return suite.stateDB.GetContext().KVStore(suite.storeKey).Get(key[:])
+}
+
+func (suite *PrecompileTestSuite) TestRun_Tx() {
+	key := [32]byte{1}
+	_, contract, err := suite.run(suite.origin, 1e6, false, suite.pack("set", key, []byte("value")))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("value"), suite.storedValue(key))
+
+	// the store accesses are charged
+	suite.Require().Less(contract.Gas, uint64(1e6))
+
+	logs := suite.stateDB.Logs()
+	suite.Require().Len(logs, 1)
+	event := suite.precompile.ABI().Events["Set"]
+	suite.Require().Equal([]common.Hash{event.ID, common.Hash(key)}, logs[0].Topics)
+	suite.Require().Equal(suite.precompile.Address(), logs[0].Address)
+
+	bz, _, err := suite.run(suite.origin, 1e6, false, suite.pack("get", key))
+	suite.Require().NoError(err)
+	out, err := suite.precompile.ABI().Unpack("get", bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("value"), out[0])
+	// the writes of queries are discarded
+	suite.Require().Equal([]byte("value"), suite.storedValue(key))
+}
+
+func (suite *PrecompileTestSuite) TestRun_RevertDiscardsWrites() {
+	key := [32]byte{1}
+	bz, _, err := suite.run(suite.origin, 1e6, false, suite.pack("set", key, []byte{}))
+	suite.requireRevert(bz, err, errFailed.Error())
+	suite.Require().Nil(suite.storedValue(key))
+}
+
+func (suite *PrecompileTestSuite) TestRun_ReadOnly() {
+	key := [32]byte{1}
+	_, _, err := suite.run(suite.origin, 1e6, true, suite.pack("set", key, []byte("value")))
+	suite.Require().ErrorIs(err, vm.ErrWriteProtection)
+	suite.Require().Nil(suite.storedValue(key))
+
+	_, _, err = suite.run(suite.origin, 1e6, true, suite.pack("get", key))
+	suite.Require().NoError(err)
+}
+
+func (suite *PrecompileTestSuite) TestRun_DirectCallOnly() {
+	key := [32]byte{1}
+	bz, _, err := suite.run(common.HexToAddress("0x02"), 1e6, false, suite.pack("setDirect", key, []byte("value")))
+	suite.requireRevert(bz, err, fmt.Sprintf(precopmiles_common.ErrDirectCallOnly, "setDirect"))
+	suite.Require().Nil(suite.storedValue(key))
+
+	_, _, err = suite.run(suite.origin, 1e6, false, suite.pack("setDirect", key, []byte("value")))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("value"), suite.storedValue(key))
+}
+
+func (suite *PrecompileTestSuite) TestRun_InvalidInput() {
+	bz, _, err := suite.run(suite.origin, 1e6, false, []byte{1, 2})
+	suite.requireRevert(bz, err, precopmiles_common.ErrInvalidInput)
+
+	bz, _, err = suite.run(suite.origin, 1e6, false, suite.pack("unregistered"))
+	suite.requireRevert(bz, err, fmt.Sprintf(precopmiles_common.ErrMethodNotRegistered, "unregistered"))
+
+	// the arguments cannot be unpacked
+	input := suite.pack("get", [32]byte{1})
+	bz, _, err = suite.run(suite.origin, 1e6, false, input[:len(input)-1])
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	_, err = abi.UnpackRevert(bz)
+	suite.Require().NoError(err)
+}
+
+func (suite *PrecompileTestSuite) TestRun_OutOfGas() {
+	key := [32]byte{1}
+	_, _, err := suite.run(suite.origin, 1, false, suite.pack("set", key, []byte("value")))
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+}
+
+func (suite *PrecompileTestSuite) TestRequiredGas() {
+	suite.Require().Equal(requiredGas, suite.precompile.RequiredGas(suite.pack("get", [32]byte{1})))
+	suite.Require().Equal(precopmiles_common.RequiredGasMax, suite.precompile.RequiredGas(suite.pack("unregistered")))
+	suite.Require().Equal(precopmiles_common.RequiredGasMax, suite.precompile.RequiredGas([]byte{1, 2}))
+}
+
+func (suite *PrecompileTestSuite) TestRegister() {
+	// unknown method
+	suite.Require().Panics(func() { suite.precompile.RegisterQuery("unknown", requiredGas, suite.get) })
+	// wrong state mutability
+	suite.Require().Panics(func() { suite.precompile.RegisterTx("unregistered", requiredGas, suite.set) })
+	suite.Require().Panics(func() { suite.precompile.RegisterQuery("set", requiredGas, suite.get) })
+	// registered twice
+	suite.Require().Panics(func() { suite.precompile.RegisterQuery("get", requiredGas, suite.get) })
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// revertSelector is the selector of Error(string), the revert reason raised by solidity
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+var revertArguments abi.Arguments
+
+func init() {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	revertArguments = abi.Arguments{{Type: stringType}}
+}
+
+// RevertReason returns the ABI encoded Error(string) reason of err along with vm.ErrExecutionReverted, so the
+// calling contract or client can read why the call failed and the unused gas is not consumed.
+func RevertReason(err error) ([]byte, error) {
+	packed, packErr := revertArguments.Pack(err.Error())
+	if packErr != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, revertSelector...), packed...), vm.ErrExecutionReverted
+}
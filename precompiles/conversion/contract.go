@@ -0,0 +1,1059 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package conversion
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ConversionMetaData contains all meta data concerning the Conversion contract.
+var ConversionMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"initiator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ConvertCoinToERC20\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"initiator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ConvertCosmosCoinFromERC20\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"initiator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ConvertCosmosCoinToERC20\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"initiator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ConvertERC20ToCoin\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"convertCoinToERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"convertCosmosCoinFromERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"convertCosmosCoinToERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"convertERC20ToCoin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// ConversionABI is the input ABI used to generate the binding from.
+// Deprecated: Use ConversionMetaData.ABI instead.
+var ConversionABI = ConversionMetaData.ABI
+
+// Conversion is an auto generated Go binding around an Ethereum contract.
+type Conversion struct {
+	ConversionCaller     // Read-only binding to the contract
+	ConversionTransactor // Write-only binding to the contract
+	ConversionFilterer   // Log filterer for contract events
+}
+
+// ConversionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ConversionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ConversionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ConversionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ConversionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ConversionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ConversionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ConversionSession struct {
+	Contract     *Conversion       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ConversionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ConversionCallerSession struct {
+	Contract *ConversionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// ConversionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ConversionTransactorSession struct {
+	Contract     *ConversionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// ConversionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ConversionRaw struct {
+	Contract *Conversion // Generic contract binding to access the raw methods on
+}
+
+// ConversionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ConversionCallerRaw struct {
+	Contract *ConversionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ConversionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ConversionTransactorRaw struct {
+	Contract *ConversionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewConversion creates a new instance of Conversion, bound to a specific deployed contract.
+func NewConversion(address common.Address, backend bind.ContractBackend) (*Conversion, error) {
+	contract, err := bindConversion(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Conversion{ConversionCaller: ConversionCaller{contract: contract}, ConversionTransactor: ConversionTransactor{contract: contract}, ConversionFilterer: ConversionFilterer{contract: contract}}, nil
+}
+
+// NewConversionCaller creates a new read-only instance of Conversion, bound to a specific deployed contract.
+func NewConversionCaller(address common.Address, caller bind.ContractCaller) (*ConversionCaller, error) {
+	contract, err := bindConversion(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionCaller{contract: contract}, nil
+}
+
+// NewConversionTransactor creates a new write-only instance of Conversion, bound to a specific deployed contract.
+func NewConversionTransactor(address common.Address, transactor bind.ContractTransactor) (*ConversionTransactor, error) {
+	contract, err := bindConversion(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionTransactor{contract: contract}, nil
+}
+
+// NewConversionFilterer creates a new log filterer instance of Conversion, bound to a specific deployed contract.
+func NewConversionFilterer(address common.Address, filterer bind.ContractFilterer) (*ConversionFilterer, error) {
+	contract, err := bindConversion(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionFilterer{contract: contract}, nil
+}
+
+// bindConversion binds a generic wrapper to an already deployed contract.
+func bindConversion(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ConversionABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Conversion *ConversionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Conversion.Contract.ConversionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Conversion *ConversionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Conversion.Contract.ConversionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Conversion *ConversionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Conversion.Contract.ConversionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Conversion *ConversionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Conversion.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Conversion *ConversionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Conversion.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Conversion *ConversionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Conversion.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xb9b092c8.
+//
+// Solidity: function balanceOf(address account, string denom) view returns(uint256 balance)
+func (_Conversion *ConversionCaller) BalanceOf(opts *bind.CallOpts, account common.Address, denom string) (*big.Int, error) {
+	var out []interface{}
+	err := _Conversion.contract.Call(opts, &out, "balanceOf", account, denom)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xb9b092c8.
+//
+// Solidity: function balanceOf(address account, string denom) view returns(uint256 balance)
+func (_Conversion *ConversionSession) BalanceOf(account common.Address, denom string) (*big.Int, error) {
+	return _Conversion.Contract.BalanceOf(&_Conversion.CallOpts, account, denom)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xb9b092c8.
+//
+// Solidity: function balanceOf(address account, string denom) view returns(uint256 balance)
+func (_Conversion *ConversionCallerSession) BalanceOf(account common.Address, denom string) (*big.Int, error) {
+	return _Conversion.Contract.BalanceOf(&_Conversion.CallOpts, account, denom)
+}
+
+// ConvertCoinToERC20 is a paid mutator transaction binding the contract method 0xf4f80fa4.
+//
+// Solidity: function convertCoinToERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactor) ConvertCoinToERC20(opts *bind.TransactOpts, receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.contract.Transact(opts, "convertCoinToERC20", receiver, denom, amount)
+}
+
+// ConvertCoinToERC20 is a paid mutator transaction binding the contract method 0xf4f80fa4.
+//
+// Solidity: function convertCoinToERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionSession) ConvertCoinToERC20(receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertCoinToERC20(&_Conversion.TransactOpts, receiver, denom, amount)
+}
+
+// ConvertCoinToERC20 is a paid mutator transaction binding the contract method 0xf4f80fa4.
+//
+// Solidity: function convertCoinToERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactorSession) ConvertCoinToERC20(receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertCoinToERC20(&_Conversion.TransactOpts, receiver, denom, amount)
+}
+
+// ConvertCosmosCoinFromERC20 is a paid mutator transaction binding the contract method 0x25aef443.
+//
+// Solidity: function convertCosmosCoinFromERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactor) ConvertCosmosCoinFromERC20(opts *bind.TransactOpts, receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.contract.Transact(opts, "convertCosmosCoinFromERC20", receiver, denom, amount)
+}
+
+// ConvertCosmosCoinFromERC20 is a paid mutator transaction binding the contract method 0x25aef443.
+//
+// Solidity: function convertCosmosCoinFromERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionSession) ConvertCosmosCoinFromERC20(receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertCosmosCoinFromERC20(&_Conversion.TransactOpts, receiver, denom, amount)
+}
+
+// ConvertCosmosCoinFromERC20 is a paid mutator transaction binding the contract method 0x25aef443.
+//
+// Solidity: function convertCosmosCoinFromERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactorSession) ConvertCosmosCoinFromERC20(receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertCosmosCoinFromERC20(&_Conversion.TransactOpts, receiver, denom, amount)
+}
+
+// ConvertCosmosCoinToERC20 is a paid mutator transaction binding the contract method 0xe80bb5c8.
+//
+// Solidity: function convertCosmosCoinToERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactor) ConvertCosmosCoinToERC20(opts *bind.TransactOpts, receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.contract.Transact(opts, "convertCosmosCoinToERC20", receiver, denom, amount)
+}
+
+// ConvertCosmosCoinToERC20 is a paid mutator transaction binding the contract method 0xe80bb5c8.
+//
+// Solidity: function convertCosmosCoinToERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionSession) ConvertCosmosCoinToERC20(receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertCosmosCoinToERC20(&_Conversion.TransactOpts, receiver, denom, amount)
+}
+
+// ConvertCosmosCoinToERC20 is a paid mutator transaction binding the contract method 0xe80bb5c8.
+//
+// Solidity: function convertCosmosCoinToERC20(address receiver, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactorSession) ConvertCosmosCoinToERC20(receiver common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertCosmosCoinToERC20(&_Conversion.TransactOpts, receiver, denom, amount)
+}
+
+// ConvertERC20ToCoin is a paid mutator transaction binding the contract method 0x77f42368.
+//
+// Solidity: function convertERC20ToCoin(address token, address receiver, uint256 amount) returns()
+func (_Conversion *ConversionTransactor) ConvertERC20ToCoin(opts *bind.TransactOpts, token common.Address, receiver common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.contract.Transact(opts, "convertERC20ToCoin", token, receiver, amount)
+}
+
+// ConvertERC20ToCoin is a paid mutator transaction binding the contract method 0x77f42368.
+//
+// Solidity: function convertERC20ToCoin(address token, address receiver, uint256 amount) returns()
+func (_Conversion *ConversionSession) ConvertERC20ToCoin(token common.Address, receiver common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertERC20ToCoin(&_Conversion.TransactOpts, token, receiver, amount)
+}
+
+// ConvertERC20ToCoin is a paid mutator transaction binding the contract method 0x77f42368.
+//
+// Solidity: function convertERC20ToCoin(address token, address receiver, uint256 amount) returns()
+func (_Conversion *ConversionTransactorSession) ConvertERC20ToCoin(token common.Address, receiver common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.ConvertERC20ToCoin(&_Conversion.TransactOpts, token, receiver, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xfff3a01b.
+//
+// Solidity: function transfer(address to, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactor) Transfer(opts *bind.TransactOpts, to common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.contract.Transact(opts, "transfer", to, denom, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xfff3a01b.
+//
+// Solidity: function transfer(address to, string denom, uint256 amount) returns()
+func (_Conversion *ConversionSession) Transfer(to common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.Transfer(&_Conversion.TransactOpts, to, denom, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xfff3a01b.
+//
+// Solidity: function transfer(address to, string denom, uint256 amount) returns()
+func (_Conversion *ConversionTransactorSession) Transfer(to common.Address, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _Conversion.Contract.Transfer(&_Conversion.TransactOpts, to, denom, amount)
+}
+
+// ConversionConvertCoinToERC20Iterator is returned from FilterConvertCoinToERC20 and is used to iterate over the raw logs and unpacked data for ConvertCoinToERC20 events raised by the Conversion contract.
+type ConversionConvertCoinToERC20Iterator struct {
+	Event *ConversionConvertCoinToERC20 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ConversionConvertCoinToERC20Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ConversionConvertCoinToERC20)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ConversionConvertCoinToERC20)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ConversionConvertCoinToERC20Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ConversionConvertCoinToERC20Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ConversionConvertCoinToERC20 represents a ConvertCoinToERC20 event raised by the Conversion contract.
+type ConversionConvertCoinToERC20 struct {
+	Initiator common.Address
+	Receiver  common.Address
+	Denom     string
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterConvertCoinToERC20 is a free log retrieval operation binding the contract event 0x544c4df8fa5d2ef707fd996e2b49dca98720cb75f6acaafe45c89a049598acab.
+//
+// Solidity: event ConvertCoinToERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) FilterConvertCoinToERC20(opts *bind.FilterOpts, initiator []common.Address) (*ConversionConvertCoinToERC20Iterator, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.FilterLogs(opts, "ConvertCoinToERC20", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionConvertCoinToERC20Iterator{contract: _Conversion.contract, event: "ConvertCoinToERC20", logs: logs, sub: sub}, nil
+}
+
+// WatchConvertCoinToERC20 is a free log subscription operation binding the contract event 0x544c4df8fa5d2ef707fd996e2b49dca98720cb75f6acaafe45c89a049598acab.
+//
+// Solidity: event ConvertCoinToERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) WatchConvertCoinToERC20(opts *bind.WatchOpts, sink chan<- *ConversionConvertCoinToERC20, initiator []common.Address) (event.Subscription, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.WatchLogs(opts, "ConvertCoinToERC20", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ConversionConvertCoinToERC20)
+				if err := _Conversion.contract.UnpackLog(event, "ConvertCoinToERC20", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConvertCoinToERC20 is a log parse operation binding the contract event 0x544c4df8fa5d2ef707fd996e2b49dca98720cb75f6acaafe45c89a049598acab.
+//
+// Solidity: event ConvertCoinToERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) ParseConvertCoinToERC20(log types.Log) (*ConversionConvertCoinToERC20, error) {
+	event := new(ConversionConvertCoinToERC20)
+	if err := _Conversion.contract.UnpackLog(event, "ConvertCoinToERC20", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ConversionConvertCosmosCoinFromERC20Iterator is returned from FilterConvertCosmosCoinFromERC20 and is used to iterate over the raw logs and unpacked data for ConvertCosmosCoinFromERC20 events raised by the Conversion contract.
+type ConversionConvertCosmosCoinFromERC20Iterator struct {
+	Event *ConversionConvertCosmosCoinFromERC20 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ConversionConvertCosmosCoinFromERC20Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ConversionConvertCosmosCoinFromERC20)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ConversionConvertCosmosCoinFromERC20)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ConversionConvertCosmosCoinFromERC20Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ConversionConvertCosmosCoinFromERC20Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ConversionConvertCosmosCoinFromERC20 represents a ConvertCosmosCoinFromERC20 event raised by the Conversion contract.
+type ConversionConvertCosmosCoinFromERC20 struct {
+	Initiator common.Address
+	Receiver  common.Address
+	Denom     string
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterConvertCosmosCoinFromERC20 is a free log retrieval operation binding the contract event 0x1e777739f74850f29d2d4c4997bb513562a0facf951fae98484d90ab862e91dc.
+//
+// Solidity: event ConvertCosmosCoinFromERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) FilterConvertCosmosCoinFromERC20(opts *bind.FilterOpts, initiator []common.Address) (*ConversionConvertCosmosCoinFromERC20Iterator, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.FilterLogs(opts, "ConvertCosmosCoinFromERC20", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionConvertCosmosCoinFromERC20Iterator{contract: _Conversion.contract, event: "ConvertCosmosCoinFromERC20", logs: logs, sub: sub}, nil
+}
+
+// WatchConvertCosmosCoinFromERC20 is a free log subscription operation binding the contract event 0x1e777739f74850f29d2d4c4997bb513562a0facf951fae98484d90ab862e91dc.
+//
+// Solidity: event ConvertCosmosCoinFromERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) WatchConvertCosmosCoinFromERC20(opts *bind.WatchOpts, sink chan<- *ConversionConvertCosmosCoinFromERC20, initiator []common.Address) (event.Subscription, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.WatchLogs(opts, "ConvertCosmosCoinFromERC20", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ConversionConvertCosmosCoinFromERC20)
+				if err := _Conversion.contract.UnpackLog(event, "ConvertCosmosCoinFromERC20", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConvertCosmosCoinFromERC20 is a log parse operation binding the contract event 0x1e777739f74850f29d2d4c4997bb513562a0facf951fae98484d90ab862e91dc.
+//
+// Solidity: event ConvertCosmosCoinFromERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) ParseConvertCosmosCoinFromERC20(log types.Log) (*ConversionConvertCosmosCoinFromERC20, error) {
+	event := new(ConversionConvertCosmosCoinFromERC20)
+	if err := _Conversion.contract.UnpackLog(event, "ConvertCosmosCoinFromERC20", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ConversionConvertCosmosCoinToERC20Iterator is returned from FilterConvertCosmosCoinToERC20 and is used to iterate over the raw logs and unpacked data for ConvertCosmosCoinToERC20 events raised by the Conversion contract.
+type ConversionConvertCosmosCoinToERC20Iterator struct {
+	Event *ConversionConvertCosmosCoinToERC20 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ConversionConvertCosmosCoinToERC20Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ConversionConvertCosmosCoinToERC20)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ConversionConvertCosmosCoinToERC20)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ConversionConvertCosmosCoinToERC20Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ConversionConvertCosmosCoinToERC20Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ConversionConvertCosmosCoinToERC20 represents a ConvertCosmosCoinToERC20 event raised by the Conversion contract.
+type ConversionConvertCosmosCoinToERC20 struct {
+	Initiator common.Address
+	Receiver  common.Address
+	Denom     string
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterConvertCosmosCoinToERC20 is a free log retrieval operation binding the contract event 0x0ead3b1dc4a08f20f3a3961a16856c5d1939d916e810add331094b689f14afba.
+//
+// Solidity: event ConvertCosmosCoinToERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) FilterConvertCosmosCoinToERC20(opts *bind.FilterOpts, initiator []common.Address) (*ConversionConvertCosmosCoinToERC20Iterator, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.FilterLogs(opts, "ConvertCosmosCoinToERC20", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionConvertCosmosCoinToERC20Iterator{contract: _Conversion.contract, event: "ConvertCosmosCoinToERC20", logs: logs, sub: sub}, nil
+}
+
+// WatchConvertCosmosCoinToERC20 is a free log subscription operation binding the contract event 0x0ead3b1dc4a08f20f3a3961a16856c5d1939d916e810add331094b689f14afba.
+//
+// Solidity: event ConvertCosmosCoinToERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) WatchConvertCosmosCoinToERC20(opts *bind.WatchOpts, sink chan<- *ConversionConvertCosmosCoinToERC20, initiator []common.Address) (event.Subscription, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.WatchLogs(opts, "ConvertCosmosCoinToERC20", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ConversionConvertCosmosCoinToERC20)
+				if err := _Conversion.contract.UnpackLog(event, "ConvertCosmosCoinToERC20", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConvertCosmosCoinToERC20 is a log parse operation binding the contract event 0x0ead3b1dc4a08f20f3a3961a16856c5d1939d916e810add331094b689f14afba.
+//
+// Solidity: event ConvertCosmosCoinToERC20(address indexed initiator, address receiver, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) ParseConvertCosmosCoinToERC20(log types.Log) (*ConversionConvertCosmosCoinToERC20, error) {
+	event := new(ConversionConvertCosmosCoinToERC20)
+	if err := _Conversion.contract.UnpackLog(event, "ConvertCosmosCoinToERC20", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ConversionConvertERC20ToCoinIterator is returned from FilterConvertERC20ToCoin and is used to iterate over the raw logs and unpacked data for ConvertERC20ToCoin events raised by the Conversion contract.
+type ConversionConvertERC20ToCoinIterator struct {
+	Event *ConversionConvertERC20ToCoin // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ConversionConvertERC20ToCoinIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ConversionConvertERC20ToCoin)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ConversionConvertERC20ToCoin)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ConversionConvertERC20ToCoinIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ConversionConvertERC20ToCoinIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ConversionConvertERC20ToCoin represents a ConvertERC20ToCoin event raised by the Conversion contract.
+type ConversionConvertERC20ToCoin struct {
+	Initiator common.Address
+	Receiver  common.Address
+	Token     common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterConvertERC20ToCoin is a free log retrieval operation binding the contract event 0x46ecd655a76c02ec127beebf9d00edcb5698049e1f76dcfa762659a3bc23ec6e.
+//
+// Solidity: event ConvertERC20ToCoin(address indexed initiator, address receiver, address token, uint256 amount)
+func (_Conversion *ConversionFilterer) FilterConvertERC20ToCoin(opts *bind.FilterOpts, initiator []common.Address) (*ConversionConvertERC20ToCoinIterator, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.FilterLogs(opts, "ConvertERC20ToCoin", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionConvertERC20ToCoinIterator{contract: _Conversion.contract, event: "ConvertERC20ToCoin", logs: logs, sub: sub}, nil
+}
+
+// WatchConvertERC20ToCoin is a free log subscription operation binding the contract event 0x46ecd655a76c02ec127beebf9d00edcb5698049e1f76dcfa762659a3bc23ec6e.
+//
+// Solidity: event ConvertERC20ToCoin(address indexed initiator, address receiver, address token, uint256 amount)
+func (_Conversion *ConversionFilterer) WatchConvertERC20ToCoin(opts *bind.WatchOpts, sink chan<- *ConversionConvertERC20ToCoin, initiator []common.Address) (event.Subscription, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _Conversion.contract.WatchLogs(opts, "ConvertERC20ToCoin", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ConversionConvertERC20ToCoin)
+				if err := _Conversion.contract.UnpackLog(event, "ConvertERC20ToCoin", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConvertERC20ToCoin is a log parse operation binding the contract event 0x46ecd655a76c02ec127beebf9d00edcb5698049e1f76dcfa762659a3bc23ec6e.
+//
+// Solidity: event ConvertERC20ToCoin(address indexed initiator, address receiver, address token, uint256 amount)
+func (_Conversion *ConversionFilterer) ParseConvertERC20ToCoin(log types.Log) (*ConversionConvertERC20ToCoin, error) {
+	event := new(ConversionConvertERC20ToCoin)
+	if err := _Conversion.contract.UnpackLog(event, "ConvertERC20ToCoin", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ConversionTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the Conversion contract.
+type ConversionTransferIterator struct {
+	Event *ConversionTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ConversionTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ConversionTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ConversionTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ConversionTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ConversionTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ConversionTransfer represents a Transfer event raised by the Conversion contract.
+type ConversionTransfer struct {
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ConversionTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Conversion.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversionTransferIterator{contract: _Conversion.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ConversionTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Conversion.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ConversionTransfer)
+				if err := _Conversion.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0x1d30d3db8e01fa0d5626c471596f822f597e720c26a2930ef20d3387313c3d78.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, string denom, uint256 amount)
+func (_Conversion *ConversionFilterer) ParseTransfer(log types.Log) (*ConversionTransfer, error) {
+	event := new(ConversionTransfer)
+	if err := _Conversion.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,50 @@
+package conversion
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	evmutilkeeper "github.com/0glabs/0g-chain/x/evmutil/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001003"
+
+	ConversionFunctionConvertCoinToERC20         = "convertCoinToERC20"
+	ConversionFunctionConvertERC20ToCoin         = "convertERC20ToCoin"
+	ConversionFunctionConvertCosmosCoinToERC20   = "convertCosmosCoinToERC20"
+	ConversionFunctionConvertCosmosCoinFromERC20 = "convertCosmosCoinFromERC20"
+	ConversionFunctionBalanceOf                  = "balanceOf"
+	ConversionFunctionTransfer                   = "transfer"
+)
+
+var _ vm.PrecompiledContract = &ConversionPrecompile{}
+
+type ConversionPrecompile struct {
+	*precopmiles_common.Precompile
+	// evmutilKeeper is a reference as the app sets its evm keeper after the precompiles are created
+	evmutilKeeper *evmutilkeeper.Keeper
+	bankKeeper    bankkeeper.Keeper
+}
+
+func NewConversionPrecompile(evmutilKeeper *evmutilkeeper.Keeper, bankKeeper bankkeeper.Keeper) (*ConversionPrecompile, error) {
+	precompile, err := precopmiles_common.NewPrecompile(PrecompileAddress, ConversionABI)
+	if err != nil {
+		return nil, err
+	}
+	c := &ConversionPrecompile{
+		Precompile:    precompile,
+		evmutilKeeper: evmutilKeeper,
+		bankKeeper:    bankKeeper,
+	}
+	// queries
+	c.RegisterQuery(ConversionFunctionBalanceOf, 5000, c.BalanceOf)
+	// txs, the conversions execute ERC20 calls outside of this EVM which must not touch the same state, the
+	// gas of the ERC20 calls is charged by the gas meter of the context
+	c.RegisterTx(ConversionFunctionConvertCoinToERC20, 100000, c.ConvertCoinToERC20).DirectCallOnly()
+	c.RegisterTx(ConversionFunctionConvertERC20ToCoin, 100000, c.ConvertERC20ToCoin).DirectCallOnly()
+	c.RegisterTx(ConversionFunctionConvertCosmosCoinToERC20, 100000, c.ConvertCosmosCoinToERC20).DirectCallOnly()
+	c.RegisterTx(ConversionFunctionConvertCosmosCoinFromERC20, 100000, c.ConvertCosmosCoinFromERC20).DirectCallOnly()
+	c.RegisterTx(ConversionFunctionTransfer, 50000, c.Transfer).DirectCallOnly()
+	return c, nil
+}
@@ -0,0 +1,148 @@
+package conversion_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/chaincfg"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	conversionprecompile "github.com/0glabs/0g-chain/precompiles/conversion"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	evmutiltestutil "github.com/0glabs/0g-chain/x/evmutil/testutil"
+	"github.com/0glabs/0g-chain/x/evmutil/types"
+)
+
+const cosmosDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+type ConversionTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	receiver common.Address
+}
+
+func (suite *ConversionTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+	suite.SetupPrecompile(conversionprecompile.PrecompileAddress, conversionprecompile.ConversionABI)
+	suite.receiver = evmutiltestutil.RandomEvmAddress()
+}
+
+func (suite *ConversionTestSuite) balance(addr common.Address, denom string) sdkmath.Int {
+	return suite.BankKeeper.GetBalance(suite.Ctx, addr.Bytes(), denom).Amount
+}
+
+func (suite *ConversionTestSuite) erc20Balance(contract types.InternalEVMAddress, addr common.Address) *big.Int {
+	balance, err := suite.Keeper.QueryERC20BalanceOf(suite.Ctx, contract, types.NewInternalEVMAddress(addr))
+	suite.Require().NoError(err)
+	return balance
+}
+
+func (suite *ConversionTestSuite) TestConvertCosmosCoin() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token(cosmosDenom, "0gChain EVM Atom", "ATOM", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+	err := suite.App.FundAccount(suite.Ctx, suite.Key1Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(cosmosDenom, 1e10)))
+	suite.Require().NoError(err)
+
+	suite.MustCall(conversionprecompile.ConversionFunctionConvertCosmosCoinToERC20, suite.receiver, cosmosDenom, big.NewInt(6e8))
+	suite.Require().Equal(sdkmath.NewInt(1e10-6e8), suite.balance(suite.Key1Addr.Address, cosmosDenom))
+	contract, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, cosmosDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(big.NewInt(6e8), suite.erc20Balance(contract, suite.receiver))
+
+	// converting back burns the tokens of the caller
+	_, _, err = suite.Call(conversionprecompile.ConversionFunctionConvertCosmosCoinFromERC20, suite.Key1Addr.Address, cosmosDenom, big.NewInt(1e8))
+	suite.Require().ErrorContains(err, "insufficient funds")
+
+	suite.FundAccountWithZgChain(suite.receiver.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(chaincfg.GasDenom, 1)))
+	_, _, err = suite.CallFrom(suite.receiver, suite.Precompile, conversionprecompile.ConversionFunctionConvertCosmosCoinFromERC20, suite.Key1Addr.Address, cosmosDenom, big.NewInt(1e8))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(1e10-5e8), suite.balance(suite.Key1Addr.Address, cosmosDenom))
+	suite.Require().Equal(big.NewInt(5e8), suite.erc20Balance(contract, suite.receiver))
+}
+
+func (suite *ConversionTestSuite) TestConvertCoin() {
+	contract := suite.DeployERC20()
+	pair := types.NewConversionPair(contract, "erc20/usdc")
+
+	// the module account holds the tokens backing the coins
+	_, err := suite.Keeper.MintConversionPairCoin(suite.Ctx, pair, big.NewInt(100), suite.Key1Addr.Bytes())
+	suite.Require().NoError(err)
+	err = suite.Keeper.MintERC20(suite.Ctx, contract, types.NewInternalEVMAddress(types.ModuleEVMAddress), big.NewInt(100))
+	suite.Require().NoError(err)
+
+	out := suite.MustCall(conversionprecompile.ConversionFunctionBalanceOf, suite.Key1Addr.Address, pair.Denom)
+	suite.Require().Equal(big.NewInt(100), out[0])
+
+	_, resp, err := suite.Call(conversionprecompile.ConversionFunctionConvertCoinToERC20, suite.Key1Addr.Address, pair.Denom, big.NewInt(60))
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Logs, 1)
+	suite.Require().Equal(suite.Abi.Events[conversionprecompile.ConvertCoinToERC20Event].ID.String(), resp.Logs[0].Topics[0])
+	suite.Require().Equal(sdkmath.NewInt(40), suite.balance(suite.Key1Addr.Address, pair.Denom))
+	suite.Require().Equal(big.NewInt(60), suite.erc20Balance(contract, suite.Key1Addr.Address))
+
+	suite.MustCall(conversionprecompile.ConversionFunctionConvertERC20ToCoin, contract.Address, suite.receiver, big.NewInt(20))
+	suite.Require().Equal(sdkmath.NewInt(20), suite.balance(suite.receiver, pair.Denom))
+	suite.Require().Equal(big.NewInt(40), suite.erc20Balance(contract, suite.Key1Addr.Address))
+
+	// tokens without a conversion pair cannot be converted
+	_, _, err = suite.Call(conversionprecompile.ConversionFunctionConvertERC20ToCoin, evmutiltestutil.RandomEvmAddress(), suite.receiver, big.NewInt(20))
+	suite.Require().Error(err)
+}
+
+func (suite *ConversionTestSuite) TestTransfer() {
+	before := suite.balance(suite.Key1Addr.Address, chaincfg.GasDenom)
+
+	_, resp, err := suite.Call(conversionprecompile.ConversionFunctionTransfer, suite.receiver, chaincfg.GasDenom, big.NewInt(1000))
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Logs, 1)
+	suite.Require().Len(resp.Logs[0].Topics, 3)
+
+	// the bank changes made by the precompile are not overwritten by the StateDB
+	suite.Require().Equal(before.SubRaw(1000), suite.balance(suite.Key1Addr.Address, chaincfg.GasDenom))
+	suite.Require().Equal(sdkmath.NewInt(1000), suite.balance(suite.receiver, chaincfg.GasDenom))
+
+	out := suite.MustCall(conversionprecompile.ConversionFunctionBalanceOf, suite.receiver, chaincfg.GasDenom)
+	suite.Require().Equal(big.NewInt(1000), out[0])
+
+	_, _, err = suite.Call(conversionprecompile.ConversionFunctionTransfer, suite.receiver, "invalid denom", big.NewInt(1000))
+	suite.Require().Error(err)
+	_, _, err = suite.CallFrom(suite.receiver, suite.Precompile, conversionprecompile.ConversionFunctionTransfer, suite.Key1Addr.Address, chaincfg.GasDenom, big.NewInt(1001))
+	suite.Require().ErrorContains(err, "insufficient funds")
+}
+
+// TestTransfer_FromContract checks an intermediate contract called by the user cannot spend the funds of the
+// transaction sender through the precompile.
+func (suite *ConversionTestSuite) TestTransfer_FromContract() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token(cosmosDenom, "0gChain EVM Atom", "ATOM", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+	err := suite.App.FundAccount(suite.Ctx, suite.Key1Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(cosmosDenom, 1e10)))
+	suite.Require().NoError(err)
+	before := suite.balance(suite.Key1Addr.Address, chaincfg.GasDenom)
+	proxy := suite.DeployProxy(suite.Precompile, false)
+
+	_, _, err = suite.CallFrom(suite.Key1Addr.Address, proxy, conversionprecompile.ConversionFunctionTransfer, suite.receiver, chaincfg.GasDenom, big.NewInt(1000))
+	suite.Require().EqualError(err, fmt.Sprintf(precopmiles_common.ErrDirectCallOnly, conversionprecompile.ConversionFunctionTransfer))
+	_, _, err = suite.CallFrom(suite.Key1Addr.Address, proxy, conversionprecompile.ConversionFunctionConvertCosmosCoinToERC20, suite.receiver, cosmosDenom, big.NewInt(1e8))
+	suite.Require().EqualError(err, fmt.Sprintf(precopmiles_common.ErrDirectCallOnly, conversionprecompile.ConversionFunctionConvertCosmosCoinToERC20))
+
+	suite.Require().Equal(before, suite.balance(suite.Key1Addr.Address, chaincfg.GasDenom))
+	suite.Require().Equal(sdkmath.NewInt(1e10), suite.balance(suite.Key1Addr.Address, cosmosDenom))
+	suite.Require().True(suite.balance(suite.receiver, chaincfg.GasDenom).IsZero())
+	_, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, cosmosDenom)
+	suite.Require().False(found)
+}
+
+func TestConversionTestSuite(t *testing.T) {
+	suite.Run(t, new(ConversionTestSuite))
+}
@@ -0,0 +1,26 @@
+package conversion
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	ConvertCoinToERC20Event         = "ConvertCoinToERC20"
+	ConvertERC20ToCoinEvent         = "ConvertERC20ToCoin"
+	ConvertCosmosCoinToERC20Event   = "ConvertCosmosCoinToERC20"
+	ConvertCosmosCoinFromERC20Event = "ConvertCosmosCoinFromERC20"
+	TransferEvent                   = "Transfer"
+)
+
+// EmitConvertEvent emits a conversion event, the asset is the denom of the coin or the address of the ERC20 token.
+func (c *ConversionPrecompile) EmitConvertEvent(ctx sdk.Context, stateDB *statedb.StateDB, name string, initiator, receiver common.Address, asset interface{}, amount *big.Int) error {
+	return c.EmitEvent(ctx, stateDB, name, initiator, receiver, asset, amount)
+}
+
+func (c *ConversionPrecompile) EmitTransferEvent(ctx sdk.Context, stateDB *statedb.StateDB, from, to common.Address, denom string, amount *big.Int) error {
+	return c.EmitEvent(ctx, stateDB, TransferEvent, from, to, denom, amount)
+}
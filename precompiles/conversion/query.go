@@ -0,0 +1,25 @@
+package conversion
+
+import (
+	"fmt"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// BalanceOf returns the bank balance of an account, it does not include the EVM fractional balance of the
+// EVM denom.
+func (c *ConversionPrecompile) BalanceOf(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	denom := args[1].(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+	balance := c.bankKeeper.GetBalance(ctx, ToAccAddress(args[0].(common.Address)), denom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
@@ -0,0 +1,142 @@
+package conversion
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	evmutilkeeper "github.com/0glabs/0g-chain/x/evmutil/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// ConvertCoinToERC20 converts a coin of a conversion pair of the caller to the ERC20 tokens of the receiver.
+func (c *ConversionPrecompile) ConvertCoinToERC20(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgConvertCoinToERC20(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	receiver := args[0].(common.Address)
+	err = precopmiles_common.SyncBalances(ctx, stateDB, []common.Address{contract.Caller(), receiver}, func() error {
+		_, err := evmutilkeeper.NewMsgServerImpl(*c.evmutilKeeper).ConvertCoinToERC20(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = c.EmitConvertEvent(ctx, stateDB, ConvertCoinToERC20Event, contract.Caller(), receiver, msg.Amount.Denom, msg.Amount.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// ConvertERC20ToCoin converts ERC20 tokens of a conversion pair of the caller to coins of the receiver.
+func (c *ConversionPrecompile) ConvertERC20ToCoin(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgConvertERC20ToCoin(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	receiver := args[1].(common.Address)
+	err = precopmiles_common.SyncBalances(ctx, stateDB, []common.Address{contract.Caller(), receiver}, func() error {
+		_, err := evmutilkeeper.NewMsgServerImpl(*c.evmutilKeeper).ConvertERC20ToCoin(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = c.EmitConvertEvent(ctx, stateDB, ConvertERC20ToCoinEvent, contract.Caller(), receiver, args[0].(common.Address), msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// ConvertCosmosCoinToERC20 converts a cosmos coin of the caller to the ERC20 wrapper tokens of the receiver.
+func (c *ConversionPrecompile) ConvertCosmosCoinToERC20(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgConvertCosmosCoinToERC20(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	receiver := args[0].(common.Address)
+	err = precopmiles_common.SyncBalances(ctx, stateDB, []common.Address{contract.Caller(), receiver}, func() error {
+		_, err := evmutilkeeper.NewMsgServerImpl(*c.evmutilKeeper).ConvertCosmosCoinToERC20(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = c.EmitConvertEvent(ctx, stateDB, ConvertCosmosCoinToERC20Event, contract.Caller(), receiver, msg.Amount.Denom, msg.Amount.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// ConvertCosmosCoinFromERC20 converts ERC20 wrapper tokens of the caller back to cosmos coins of the receiver.
+func (c *ConversionPrecompile) ConvertCosmosCoinFromERC20(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgConvertCosmosCoinFromERC20(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	receiver := args[0].(common.Address)
+	err = precopmiles_common.SyncBalances(ctx, stateDB, []common.Address{contract.Caller(), receiver}, func() error {
+		_, err := evmutilkeeper.NewMsgServerImpl(*c.evmutilKeeper).ConvertCosmosCoinFromERC20(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = c.EmitConvertEvent(ctx, stateDB, ConvertCosmosCoinFromERC20Event, contract.Caller(), receiver, msg.Amount.Denom, msg.Amount.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// Transfer sends bank coins of the caller to another account.
+func (c *ConversionPrecompile) Transfer(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgSend(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	to := args[0].(common.Address)
+	err = precopmiles_common.SyncBalances(ctx, stateDB, []common.Address{contract.Caller(), to}, func() error {
+		_, err := bankkeeper.NewMsgServerImpl(c.bankKeeper).Send(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = c.EmitTransferEvent(ctx, stateDB, contract.Caller(), to, msg.Amount[0].Denom, msg.Amount[0].Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
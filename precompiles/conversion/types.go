@@ -0,0 +1,82 @@
+package conversion
+
+import (
+	"fmt"
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	evmutiltypes "github.com/0glabs/0g-chain/x/evmutil/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ToAccAddress returns the cosmos address of an EVM address
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+// newCoin does not validate the denom, the messages are validated before being executed
+func newCoin(denom string, amount *big.Int) sdk.Coin {
+	return sdk.Coin{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)}
+}
+
+func NewMsgConvertCoinToERC20(args []interface{}, initiator common.Address) (*evmutiltypes.MsgConvertCoinToERC20, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	msg := evmutiltypes.NewMsgConvertCoinToERC20(
+		ToAccAddress(initiator).String(),
+		args[0].(common.Address).Hex(),
+		newCoin(args[1].(string), args[2].(*big.Int)),
+	)
+	return &msg, nil
+}
+
+func NewMsgConvertERC20ToCoin(args []interface{}, initiator common.Address) (*evmutiltypes.MsgConvertERC20ToCoin, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	msg := evmutiltypes.NewMsgConvertERC20ToCoin(
+		evmutiltypes.NewInternalEVMAddress(initiator),
+		ToAccAddress(args[1].(common.Address)),
+		evmutiltypes.NewInternalEVMAddress(args[0].(common.Address)),
+		sdk.NewIntFromBigInt(args[2].(*big.Int)),
+	)
+	return &msg, nil
+}
+
+func NewMsgConvertCosmosCoinToERC20(args []interface{}, initiator common.Address) (*evmutiltypes.MsgConvertCosmosCoinToERC20, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	msg := evmutiltypes.NewMsgConvertCosmosCoinToERC20(
+		ToAccAddress(initiator).String(),
+		args[0].(common.Address).Hex(),
+		newCoin(args[1].(string), args[2].(*big.Int)),
+	)
+	return &msg, nil
+}
+
+func NewMsgConvertCosmosCoinFromERC20(args []interface{}, initiator common.Address) (*evmutiltypes.MsgConvertCosmosCoinFromERC20, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	msg := evmutiltypes.NewMsgConvertCosmosCoinFromERC20(
+		initiator.Hex(),
+		ToAccAddress(args[0].(common.Address)).String(),
+		newCoin(args[1].(string), args[2].(*big.Int)),
+	)
+	return &msg, nil
+}
+
+func NewMsgSend(args []interface{}, from common.Address) (*banktypes.MsgSend, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	return banktypes.NewMsgSend(
+		ToAccAddress(from),
+		ToAccAddress(args[0].(common.Address)),
+		sdk.Coins{newCoin(args[1].(string), args[2].(*big.Int))},
+	), nil
+}
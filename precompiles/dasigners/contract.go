@@ -30,7 +30,7 @@ var (
 
 // DASignersMetaData contains all meta data concerning the DASigners contract.
 var DASignersMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"NewSigner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"}],\"name\":\"SocketUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"epochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"}],\"name\":\"getAggPkG1\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"aggPkG1\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"}],\"name\":\"getQuorum\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_rowIndex\",\"type\":\"uint32\"}],\"name\":\"getQuorumRow\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSigner\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isSigner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"quorumCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpoch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"registeredEpoch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_socket\",\"type\":\"string\"}],\"name\":\"updateSocket\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"NewSigner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"exitEpoch\",\"type\":\"uint256\"}],\"name\":\"SignerDeregistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveEpoch\",\"type\":\"uint256\"}],\"name\":\"SignerKeyRotated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"}],\"name\":\"SocketUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"cancelStanding\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deregisterSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"}],\"name\":\"getAggPkG1\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"aggPkG1\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"}],\"name\":\"getQuorum\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_rowIndex\",\"type\":\"uint32\"}],\"name\":\"getQuorumRow\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSigner\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"getSignerBallot\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"bonded\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"votes\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"capped\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"seats\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"_filter\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_offset\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_limit\",\"type\":\"uint256\"}],\"name\":\"getSigners\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"signers\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isSigner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"quorumCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpoch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerStanding\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"registeredEpoch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"_pkG2\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"rotateSignerKey\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_socket\",\"type\":\"string\"}],\"name\":\"updateSocket\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"},{\"internalType\":\"bytes32\",\"name\":\"_messageHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"verifyAggregateSignature\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"valid\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // DASignersABI is the input ABI used to generate the binding from.
@@ -353,6 +353,106 @@ func (_DASigners *DASignersCallerSession) GetSigner(_account []common.Address) (
 	return _DASigners.Contract.GetSigner(&_DASigners.CallOpts, _account)
 }
 
+// GetSignerBallot is a free data retrieval call binding the contract method 0x4a449c93.
+//
+// Solidity: function getSignerBallot(address _account, uint256 _epoch) view returns(uint256 bonded, uint256 votes, bool capped, uint256 seats)
+func (_DASigners *DASignersCaller) GetSignerBallot(opts *bind.CallOpts, _account common.Address, _epoch *big.Int) (struct {
+	Bonded *big.Int
+	Votes  *big.Int
+	Capped bool
+	Seats  *big.Int
+}, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "getSignerBallot", _account, _epoch)
+
+	outstruct := new(struct {
+		Bonded *big.Int
+		Votes  *big.Int
+		Capped bool
+		Seats  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Bonded = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Votes = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Capped = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.Seats = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetSignerBallot is a free data retrieval call binding the contract method 0x4a449c93.
+//
+// Solidity: function getSignerBallot(address _account, uint256 _epoch) view returns(uint256 bonded, uint256 votes, bool capped, uint256 seats)
+func (_DASigners *DASignersSession) GetSignerBallot(_account common.Address, _epoch *big.Int) (struct {
+	Bonded *big.Int
+	Votes  *big.Int
+	Capped bool
+	Seats  *big.Int
+}, error) {
+	return _DASigners.Contract.GetSignerBallot(&_DASigners.CallOpts, _account, _epoch)
+}
+
+// GetSignerBallot is a free data retrieval call binding the contract method 0x4a449c93.
+//
+// Solidity: function getSignerBallot(address _account, uint256 _epoch) view returns(uint256 bonded, uint256 votes, bool capped, uint256 seats)
+func (_DASigners *DASignersCallerSession) GetSignerBallot(_account common.Address, _epoch *big.Int) (struct {
+	Bonded *big.Int
+	Votes  *big.Int
+	Capped bool
+	Seats  *big.Int
+}, error) {
+	return _DASigners.Contract.GetSignerBallot(&_DASigners.CallOpts, _account, _epoch)
+}
+
+// GetSigners is a free data retrieval call binding the contract method 0x379c05a7.
+//
+// Solidity: function getSigners(uint8 _filter, uint256 _epoch, uint256 _offset, uint256 _limit) view returns((address,string,(uint256,uint256),(uint256[2],uint256[2]))[] signers, uint256 total)
+func (_DASigners *DASignersCaller) GetSigners(opts *bind.CallOpts, _filter uint8, _epoch *big.Int, _offset *big.Int, _limit *big.Int) (struct {
+	Signers []IDASignersSignerDetail
+	Total   *big.Int
+}, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "getSigners", _filter, _epoch, _offset, _limit)
+
+	outstruct := new(struct {
+		Signers []IDASignersSignerDetail
+		Total   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Signers = *abi.ConvertType(out[0], new([]IDASignersSignerDetail)).(*[]IDASignersSignerDetail)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetSigners is a free data retrieval call binding the contract method 0x379c05a7.
+//
+// Solidity: function getSigners(uint8 _filter, uint256 _epoch, uint256 _offset, uint256 _limit) view returns((address,string,(uint256,uint256),(uint256[2],uint256[2]))[] signers, uint256 total)
+func (_DASigners *DASignersSession) GetSigners(_filter uint8, _epoch *big.Int, _offset *big.Int, _limit *big.Int) (struct {
+	Signers []IDASignersSignerDetail
+	Total   *big.Int
+}, error) {
+	return _DASigners.Contract.GetSigners(&_DASigners.CallOpts, _filter, _epoch, _offset, _limit)
+}
+
+// GetSigners is a free data retrieval call binding the contract method 0x379c05a7.
+//
+// Solidity: function getSigners(uint8 _filter, uint256 _epoch, uint256 _offset, uint256 _limit) view returns((address,string,(uint256,uint256),(uint256[2],uint256[2]))[] signers, uint256 total)
+func (_DASigners *DASignersCallerSession) GetSigners(_filter uint8, _epoch *big.Int, _offset *big.Int, _limit *big.Int) (struct {
+	Signers []IDASignersSignerDetail
+	Total   *big.Int
+}, error) {
+	return _DASigners.Contract.GetSigners(&_DASigners.CallOpts, _filter, _epoch, _offset, _limit)
+}
+
 // IsSigner is a free data retrieval call binding the contract method 0x7df73e27.
 //
 // Solidity: function isSigner(address _account) view returns(bool)
@@ -446,6 +546,98 @@ func (_DASigners *DASignersCallerSession) RegisteredEpoch(_account common.Addres
 	return _DASigners.Contract.RegisteredEpoch(&_DASigners.CallOpts, _account, _epoch)
 }
 
+// VerifyAggregateSignature is a free data retrieval call binding the contract method 0x6abdf1aa.
+//
+// Solidity: function verifyAggregateSignature(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _messageHash, (uint256,uint256) _signature) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersCaller) VerifyAggregateSignature(opts *bind.CallOpts, _epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _messageHash [32]byte, _signature BN254G1Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "verifyAggregateSignature", _epoch, _quorumId, _quorumBitmap, _messageHash, _signature)
+
+	outstruct := new(struct {
+		Valid bool
+		Total *big.Int
+		Hit   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Valid = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Hit = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// VerifyAggregateSignature is a free data retrieval call binding the contract method 0x6abdf1aa.
+//
+// Solidity: function verifyAggregateSignature(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _messageHash, (uint256,uint256) _signature) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersSession) VerifyAggregateSignature(_epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _messageHash [32]byte, _signature BN254G1Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	return _DASigners.Contract.VerifyAggregateSignature(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap, _messageHash, _signature)
+}
+
+// VerifyAggregateSignature is a free data retrieval call binding the contract method 0x6abdf1aa.
+//
+// Solidity: function verifyAggregateSignature(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _messageHash, (uint256,uint256) _signature) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersCallerSession) VerifyAggregateSignature(_epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _messageHash [32]byte, _signature BN254G1Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	return _DASigners.Contract.VerifyAggregateSignature(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap, _messageHash, _signature)
+}
+
+// CancelStanding is a paid mutator transaction binding the contract method 0x86e75c0d.
+//
+// Solidity: function cancelStanding() returns()
+func (_DASigners *DASignersTransactor) CancelStanding(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "cancelStanding")
+}
+
+// CancelStanding is a paid mutator transaction binding the contract method 0x86e75c0d.
+//
+// Solidity: function cancelStanding() returns()
+func (_DASigners *DASignersSession) CancelStanding() (*types.Transaction, error) {
+	return _DASigners.Contract.CancelStanding(&_DASigners.TransactOpts)
+}
+
+// CancelStanding is a paid mutator transaction binding the contract method 0x86e75c0d.
+//
+// Solidity: function cancelStanding() returns()
+func (_DASigners *DASignersTransactorSession) CancelStanding() (*types.Transaction, error) {
+	return _DASigners.Contract.CancelStanding(&_DASigners.TransactOpts)
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersTransactor) DeregisterSigner(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "deregisterSigner")
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersSession) DeregisterSigner() (*types.Transaction, error) {
+	return _DASigners.Contract.DeregisterSigner(&_DASigners.TransactOpts)
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersTransactorSession) DeregisterSigner() (*types.Transaction, error) {
+	return _DASigners.Contract.DeregisterSigner(&_DASigners.TransactOpts)
+}
+
 // RegisterNextEpoch is a paid mutator transaction binding the contract method 0x56a32372.
 //
 // Solidity: function registerNextEpoch((uint256,uint256) _signature) returns()
@@ -488,6 +680,48 @@ func (_DASigners *DASignersTransactorSession) RegisterSigner(_signer IDASignersS
 	return _DASigners.Contract.RegisterSigner(&_DASigners.TransactOpts, _signer, _signature)
 }
 
+// RegisterStanding is a paid mutator transaction binding the contract method 0xb467082a.
+//
+// Solidity: function registerStanding((uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactor) RegisterStanding(opts *bind.TransactOpts, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "registerStanding", _signature)
+}
+
+// RegisterStanding is a paid mutator transaction binding the contract method 0xb467082a.
+//
+// Solidity: function registerStanding((uint256,uint256) _signature) returns()
+func (_DASigners *DASignersSession) RegisterStanding(_signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterStanding(&_DASigners.TransactOpts, _signature)
+}
+
+// RegisterStanding is a paid mutator transaction binding the contract method 0xb467082a.
+//
+// Solidity: function registerStanding((uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactorSession) RegisterStanding(_signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterStanding(&_DASigners.TransactOpts, _signature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0xdef9f50c.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactor) RotateSignerKey(opts *bind.TransactOpts, _pkG1 BN254G1Point, _pkG2 BN254G2Point, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "rotateSignerKey", _pkG1, _pkG2, _signature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0xdef9f50c.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersSession) RotateSignerKey(_pkG1 BN254G1Point, _pkG2 BN254G2Point, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RotateSignerKey(&_DASigners.TransactOpts, _pkG1, _pkG2, _signature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0xdef9f50c.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactorSession) RotateSignerKey(_pkG1 BN254G1Point, _pkG2 BN254G2Point, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RotateSignerKey(&_DASigners.TransactOpts, _pkG1, _pkG2, _signature)
+}
+
 // UpdateSocket is a paid mutator transaction binding the contract method 0x0cf4b767.
 //
 // Solidity: function updateSocket(string _socket) returns()
@@ -655,6 +889,298 @@ func (_DASigners *DASignersFilterer) ParseNewSigner(log types.Log) (*DASignersNe
 	return event, nil
 }
 
+// DASignersSignerDeregisteredIterator is returned from FilterSignerDeregistered and is used to iterate over the raw logs and unpacked data for SignerDeregistered events raised by the DASigners contract.
+type DASignersSignerDeregisteredIterator struct {
+	Event *DASignersSignerDeregistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerDeregisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerDeregistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerDeregistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerDeregisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerDeregisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerDeregistered represents a SignerDeregistered event raised by the DASigners contract.
+type DASignersSignerDeregistered struct {
+	Signer    common.Address
+	ExitEpoch *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerDeregistered is a free log retrieval operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 exitEpoch)
+func (_DASigners *DASignersFilterer) FilterSignerDeregistered(opts *bind.FilterOpts, signer []common.Address) (*DASignersSignerDeregisteredIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerDeregistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerDeregisteredIterator{contract: _DASigners.contract, event: "SignerDeregistered", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerDeregistered is a free log subscription operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 exitEpoch)
+func (_DASigners *DASignersFilterer) WatchSignerDeregistered(opts *bind.WatchOpts, sink chan<- *DASignersSignerDeregistered, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerDeregistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerDeregistered)
+				if err := _DASigners.contract.UnpackLog(event, "SignerDeregistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerDeregistered is a log parse operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 exitEpoch)
+func (_DASigners *DASignersFilterer) ParseSignerDeregistered(log types.Log) (*DASignersSignerDeregistered, error) {
+	event := new(DASignersSignerDeregistered)
+	if err := _DASigners.contract.UnpackLog(event, "SignerDeregistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DASignersSignerKeyRotatedIterator is returned from FilterSignerKeyRotated and is used to iterate over the raw logs and unpacked data for SignerKeyRotated events raised by the DASigners contract.
+type DASignersSignerKeyRotatedIterator struct {
+	Event *DASignersSignerKeyRotated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerKeyRotatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerKeyRotated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerKeyRotated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerKeyRotatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerKeyRotatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerKeyRotated represents a SignerKeyRotated event raised by the DASigners contract.
+type DASignersSignerKeyRotated struct {
+	Signer         common.Address
+	PkG1           BN254G1Point
+	PkG2           BN254G2Point
+	EffectiveEpoch *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerKeyRotated is a free log retrieval operation binding the contract event 0x2f1687bbc4900ff3e371502a329db7d6e0a2b58cc504819ce1849778634250b1.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2, uint256 effectiveEpoch)
+func (_DASigners *DASignersFilterer) FilterSignerKeyRotated(opts *bind.FilterOpts, signer []common.Address) (*DASignersSignerKeyRotatedIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerKeyRotated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerKeyRotatedIterator{contract: _DASigners.contract, event: "SignerKeyRotated", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerKeyRotated is a free log subscription operation binding the contract event 0x2f1687bbc4900ff3e371502a329db7d6e0a2b58cc504819ce1849778634250b1.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2, uint256 effectiveEpoch)
+func (_DASigners *DASignersFilterer) WatchSignerKeyRotated(opts *bind.WatchOpts, sink chan<- *DASignersSignerKeyRotated, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerKeyRotated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerKeyRotated)
+				if err := _DASigners.contract.UnpackLog(event, "SignerKeyRotated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerKeyRotated is a log parse operation binding the contract event 0x2f1687bbc4900ff3e371502a329db7d6e0a2b58cc504819ce1849778634250b1.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2, uint256 effectiveEpoch)
+func (_DASigners *DASignersFilterer) ParseSignerKeyRotated(log types.Log) (*DASignersSignerKeyRotated, error) {
+	event := new(DASignersSignerKeyRotated)
+	if err := _DASigners.contract.UnpackLog(event, "SignerKeyRotated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // DASignersSocketUpdatedIterator is returned from FilterSocketUpdated and is used to iterate over the raw logs and unpacked data for SocketUpdated events raised by the DASigners contract.
 type DASignersSocketUpdatedIterator struct {
 	Event *DASignersSocketUpdated // Event containing the contract specifics and raw log
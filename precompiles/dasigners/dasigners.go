@@ -1,26 +1,21 @@
 package dasigners
 
 import (
-	"fmt"
-	"strings"
-
 	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
 	dasignerskeeper "github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
-	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
 const (
 	PrecompileAddress = "0x0000000000000000000000000000000000001000"
 
-	RequiredGasMax uint64 = 1000_000_000
+	// MaxSignersLimit is the largest page of signers returned by getSigners
+	MaxSignersLimit uint64 = 256
 
 	DASignersFunctionEpochNumber       = "epochNumber"
 	DASignersFunctionQuorumCount       = "quorumCount"
 	DASignersFunctionGetSigner         = "getSigner"
+	DASignersFunctionGetSigners        = "getSigners"
 	DASignersFunctionGetQuorum         = "getQuorum"
 	DASignersFunctionGetQuorumRow      = "getQuorumRow"
 	DASignersFunctionRegisterSigner    = "registerSigner"
@@ -29,127 +24,49 @@ const (
 	DASignersFunctionGetAggPkG1        = "getAggPkG1"
 	DASignersFunctionIsSigner          = "isSigner"
 	DASignersFunctionRegisteredEpoch   = "registeredEpoch"
+	DASignersFunctionDeregisterSigner  = "deregisterSigner"
+	DASignersFunctionRotateSignerKey   = "rotateSignerKey"
+	DASignersFunctionGetSignerBallot   = "getSignerBallot"
+	DASignersFunctionVerifyAggSig      = "verifyAggregateSignature"
+	DASignersFunctionRegisterStanding  = "registerStanding"
+	DASignersFunctionCancelStanding    = "cancelStanding"
 )
 
-var RequiredGasBasic = map[string]uint64{
-	DASignersFunctionEpochNumber:       1000,
-	DASignersFunctionQuorumCount:       1000,
-	DASignersFunctionGetSigner:         100000,
-	DASignersFunctionGetQuorum:         100000,
-	DASignersFunctionGetQuorumRow:      10000,
-	DASignersFunctionRegisterSigner:    100000,
-	DASignersFunctionUpdateSocket:      50000,
-	DASignersFunctionRegisterNextEpoch: 100000,
-	DASignersFunctionGetAggPkG1:        1000000,
-	DASignersFunctionIsSigner:          10000,
-	DASignersFunctionRegisteredEpoch:   10000,
-}
-
-var KVGasConfig storetypes.GasConfig = storetypes.GasConfig{
-	HasCost:          0,
-	DeleteCost:       0,
-	ReadCostFlat:     0,
-	ReadCostPerByte:  0,
-	WriteCostFlat:    0,
-	WriteCostPerByte: 0,
-	IterNextCostFlat: 0,
-}
-
 var _ vm.PrecompiledContract = &DASignersPrecompile{}
 
 type DASignersPrecompile struct {
-	abi             abi.ABI
+	*precopmiles_common.Precompile
 	dasignersKeeper dasignerskeeper.Keeper
 }
 
 func NewDASignersPrecompile(dasignersKeeper dasignerskeeper.Keeper) (*DASignersPrecompile, error) {
-	abi, err := abi.JSON(strings.NewReader(DASignersABI))
+	precompile, err := precopmiles_common.NewPrecompile(PrecompileAddress, DASignersABI)
 	if err != nil {
 		return nil, err
 	}
-	return &DASignersPrecompile{
-		abi:             abi,
+	d := &DASignersPrecompile{
+		Precompile:      precompile,
 		dasignersKeeper: dasignersKeeper,
-	}, nil
-}
-
-// Address implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) Address() common.Address {
-	return common.HexToAddress(PrecompileAddress)
-}
-
-// RequiredGas implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) RequiredGas(input []byte) uint64 {
-	method, err := d.abi.MethodById(input[:4])
-	if err != nil {
-		return RequiredGasMax
 	}
-	if gas, ok := RequiredGasBasic[method.Name]; ok {
-		return gas
-	}
-	return RequiredGasMax
-}
-
-// Run implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
-	// parse input
-	if len(contract.Input) < 4 {
-		return nil, vm.ErrExecutionReverted
-	}
-	method, err := d.abi.MethodById(contract.Input[:4])
-	if err != nil {
-		return nil, vm.ErrExecutionReverted
-	}
-	args, err := method.Inputs.Unpack(contract.Input[4:])
-	if err != nil {
-		return nil, err
-	}
-	// get state db and context
-	stateDB, ok := evm.StateDB.(*statedb.StateDB)
-	if !ok {
-		return nil, fmt.Errorf(precopmiles_common.ErrGetStateDB)
-	}
-	ctx := stateDB.GetContext()
-	// reset gas config
-	ctx = ctx.WithKVGasConfig(KVGasConfig)
-	initialGas := ctx.GasMeter().GasConsumed()
-
-	var bz []byte
-	switch method.Name {
 	// queries
-	case DASignersFunctionEpochNumber:
-		bz, err = d.EpochNumber(ctx, evm, method, args)
-	case DASignersFunctionQuorumCount:
-		bz, err = d.QuorumCount(ctx, evm, method, args)
-	case DASignersFunctionGetSigner:
-		bz, err = d.GetSigner(ctx, evm, method, args)
-	case DASignersFunctionGetQuorum:
-		bz, err = d.GetQuorum(ctx, evm, method, args)
-	case DASignersFunctionGetQuorumRow:
-		bz, err = d.GetQuorumRow(ctx, evm, method, args)
-	case DASignersFunctionGetAggPkG1:
-		bz, err = d.GetAggPkG1(ctx, evm, method, args)
-	case DASignersFunctionIsSigner:
-		bz, err = d.IsSigner(ctx, evm, method, args)
-	case DASignersFunctionRegisteredEpoch:
-		bz, err = d.RegisteredEpoch(ctx, evm, method, args)
+	d.RegisterQuery(DASignersFunctionEpochNumber, 1000, meterReturnedBytes(d.EpochNumber))
+	d.RegisterQuery(DASignersFunctionQuorumCount, 1000, meterReturnedBytes(d.QuorumCount))
+	d.RegisterQuery(DASignersFunctionGetSigner, 5000, meterReturnedBytes(d.GetSigner))
+	d.RegisterQuery(DASignersFunctionGetSigners, 10000, meterReturnedBytes(d.GetSigners))
+	d.RegisterQuery(DASignersFunctionGetQuorum, 5000, meterReturnedBytes(d.GetQuorum))
+	d.RegisterQuery(DASignersFunctionGetQuorumRow, 5000, meterReturnedBytes(d.GetQuorumRow))
+	d.RegisterQuery(DASignersFunctionGetAggPkG1, 10000, meterReturnedBytes(d.GetAggPkG1))
+	d.RegisterQuery(DASignersFunctionIsSigner, 5000, meterReturnedBytes(d.IsSigner))
+	d.RegisterQuery(DASignersFunctionRegisteredEpoch, 5000, meterReturnedBytes(d.RegisteredEpoch))
+	d.RegisterQuery(DASignersFunctionGetSignerBallot, 5000, meterReturnedBytes(d.GetSignerBallot))
+	d.RegisterQuery(DASignersFunctionVerifyAggSig, 10000+GasPairingCheck, meterReturnedBytes(d.VerifyAggregateSignature))
 	// txs
-	case DASignersFunctionRegisterSigner:
-		bz, err = d.RegisterSigner(ctx, evm, stateDB, method, args)
-	case DASignersFunctionRegisterNextEpoch:
-		bz, err = d.RegisterNextEpoch(ctx, evm, stateDB, method, args)
-	case DASignersFunctionUpdateSocket:
-		bz, err = d.UpdateSocket(ctx, evm, stateDB, method, args)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-	return bz, nil
+	d.RegisterTx(DASignersFunctionRegisterSigner, 100000, d.RegisterSigner)
+	d.RegisterTx(DASignersFunctionRegisterNextEpoch, 100000, d.RegisterNextEpoch)
+	d.RegisterTx(DASignersFunctionUpdateSocket, 50000, d.UpdateSocket)
+	d.RegisterTx(DASignersFunctionDeregisterSigner, 50000, d.DeregisterSigner)
+	d.RegisterTx(DASignersFunctionRotateSignerKey, 100000, d.RotateSignerKey)
+	d.RegisterTx(DASignersFunctionRegisterStanding, 100000, d.RegisterStanding)
+	d.RegisterTx(DASignersFunctionCancelStanding, 50000, d.CancelStanding)
+	return d, nil
 }
@@ -0,0 +1,203 @@
+package dasigners_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	dasignerskeeper "github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// setupQuorum stores a quorum of n rows held by distinct signers for epoch 0 and returns the sum of the
+// secret keys of the signers, which signs for the full bitmap.
+func setupQuorum(t testing.TB, ctx sdk.Context, keeper dasignerskeeper.Keeper, n int) *big.Int {
+	quorum := types.Quorum{Signers: make([]string, n)}
+	var sum fr.Element
+	for i := 0; i < n; i += 1 {
+		var sk fr.Element
+		sk.SetUint64(uint64(1000 + i))
+		sum.Add(&sum, &sk)
+		quorum.Signers[i] = hex.EncodeToString(app.RandomAddress())
+		require.NoError(t, keeper.SetSigner(ctx, types.Signer{
+			Account:  quorum.Signers[i],
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: bn254util.SerializeG1(bn254util.MulByGeneratorG1(&sk)),
+			PubkeyG2: bn254util.SerializeG2(bn254util.MulByGeneratorG2(&sk)),
+		}))
+	}
+	keeper.SetEpochQuorums(ctx, 0, types.Quorums{Quorums: []*types.Quorum{&quorum}})
+	return sum.BigInt(new(big.Int))
+}
+
+// bitmap returns a bitmap of a quorum of n rows with the first hit rows set
+func bitmap(n int, hit int) []byte {
+	b := make([]byte, (n+7)/8)
+	for i := 0; i < hit; i += 1 {
+		b[i/8] |= 1 << (i % 8)
+	}
+	return b
+}
+
+func signature(secret *big.Int, messageHash [32]byte) dasignersprecompile.BN254G1Point {
+	sig := new(bn254.G1Affine).ScalarMultiplication(bn254util.MapToCurve(messageHash), secret)
+	return dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(sig))
+}
+
+type DASignersTestSuite struct {
+	testutil.PrecompileTestSuite
+}
+
+func (suite *DASignersTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+	suite.SetupPrecompile(dasignersprecompile.PrecompileAddress, dasignersprecompile.DASignersABI)
+}
+
+func (suite *DASignersTestSuite) gasUsed(method string, args ...interface{}) uint64 {
+	_, resp, err := suite.Call(method, args...)
+	suite.Require().NoError(err)
+	return resp.GasUsed
+}
+
+func (suite *DASignersTestSuite) TestGetAggPkG1_Gas() {
+	setupQuorum(suite.T(), suite.Ctx, suite.App.GetDASignersKeeper(), 256)
+
+	// the gas grows with the number of rows set in the bitmap
+	zero := suite.gasUsed(dasignersprecompile.DASignersFunctionGetAggPkG1, big.NewInt(0), big.NewInt(0), bitmap(256, 0))
+	half := suite.gasUsed(dasignersprecompile.DASignersFunctionGetAggPkG1, big.NewInt(0), big.NewInt(0), bitmap(256, 128))
+	full := suite.gasUsed(dasignersprecompile.DASignersFunctionGetAggPkG1, big.NewInt(0), big.NewInt(0), bitmap(256, 256))
+	suite.Require().Greater(half-zero, 128*dasignersprecompile.GasPerAggregatedSigner)
+	suite.Require().Greater(full-half, 128*dasignersprecompile.GasPerAggregatedSigner)
+
+	out := suite.MustCall(dasignersprecompile.DASignersFunctionGetAggPkG1, big.NewInt(0), big.NewInt(0), bitmap(256, 256))
+	suite.Require().Equal(big.NewInt(256), out[1])
+	suite.Require().Equal(big.NewInt(256), out[2])
+}
+
+func (suite *DASignersTestSuite) TestGetQuorum_Gas() {
+	keeper := suite.App.GetDASignersKeeper()
+	setupQuorum(suite.T(), suite.Ctx, keeper, 16)
+	small := suite.gasUsed(dasignersprecompile.DASignersFunctionGetQuorum, big.NewInt(0), big.NewInt(0))
+
+	// the gas grows with the size of the quorum read and returned
+	setupQuorum(suite.T(), suite.Ctx, keeper, 512)
+	large := suite.gasUsed(dasignersprecompile.DASignersFunctionGetQuorum, big.NewInt(0), big.NewInt(0))
+	suite.Require().Greater(large-small, 496*dasignersprecompile.GasPerReturnedWord)
+
+	out := suite.MustCall(dasignersprecompile.DASignersFunctionGetQuorum, big.NewInt(0), big.NewInt(0))
+	suite.Require().Len(out[0], 512)
+}
+
+func (suite *DASignersTestSuite) TestVerifyAggregateSignature_OutOfGas() {
+	secret := setupQuorum(suite.T(), suite.Ctx, suite.App.GetDASignersKeeper(), 64)
+	messageHash := [32]byte(crypto.Keccak256Hash([]byte("blob")))
+
+	out := suite.MustCall(dasignersprecompile.DASignersFunctionVerifyAggSig, big.NewInt(0), big.NewInt(0), bitmap(64, 64), messageHash, signature(secret, messageHash))
+	suite.Require().Equal(true, out[0])
+
+	// the call fails once the gas left is below the work to do, before the aggregation is done
+	precompile, err := dasignersprecompile.NewDASignersPrecompile(suite.App.GetDASignersKeeper())
+	suite.Require().NoError(err)
+	input, err := suite.Abi.Pack(dasignersprecompile.DASignersFunctionVerifyAggSig, big.NewInt(0), big.NewInt(0), bitmap(64, 64), messageHash, signature(secret, messageHash))
+	suite.Require().NoError(err)
+	stateDB := statedb.New(suite.Ctx, suite.App.GetEvmKeeper(), statedb.NewEmptyTxConfig(common.Hash{}))
+	evm := vm.NewEVM(vm.BlockContext{}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+	contract := vm.NewContract(vm.AccountRef(suite.Key1Addr.Address), vm.AccountRef(precompile.Address()), big.NewInt(0), 64*dasignersprecompile.GasPerAggregatedSigner)
+	contract.Input = input
+	_, err = precompile.Run(evm, contract, true)
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+}
+
+func (suite *DASignersTestSuite) TestGetSignerBallot() {
+	keeper := suite.App.GetDASignersKeeper()
+	setupQuorum(suite.T(), suite.Ctx, keeper, 4)
+	signer := common.BytesToAddress(app.RandomAddress())
+	suite.Require().NoError(keeper.SetEpochBallot(suite.Ctx, 0, types.SignerBallot{
+		Account: hex.EncodeToString(signer.Bytes()),
+		Bonded:  sdk.NewInt(5000),
+		Votes:   5,
+		Capped:  true,
+		Seats:   3,
+	}))
+
+	out := suite.MustCall(dasignersprecompile.DASignersFunctionGetSignerBallot, signer, big.NewInt(0))
+	suite.Require().Equal([]interface{}{big.NewInt(5000), big.NewInt(5), true, big.NewInt(3)}, out)
+
+	_, _, err := suite.Call(dasignersprecompile.DASignersFunctionGetSignerBallot, common.BytesToAddress(app.RandomAddress()), big.NewInt(0))
+	suite.Require().ErrorContains(err, types.ErrBallotNotFound.Error())
+	_, _, err = suite.Call(dasignersprecompile.DASignersFunctionGetSignerBallot, signer, big.NewInt(1))
+	suite.Require().ErrorContains(err, types.ErrQuorumNotFound.Error())
+}
+
+func TestDASignersTestSuite(t *testing.T) {
+	suite.Run(t, new(DASignersTestSuite))
+}
+
+// benchmarkQuery runs a query of the precompile on a quorum of every size and reports the gas it is charged
+// along with the nanoseconds it takes per gas, which should stay about the same whatever the size.
+func benchmarkQuery(b *testing.B, sizes []int, args func(n int, secret *big.Int) (string, []interface{})) {
+	for _, n := range sizes {
+		b.Run(fmt.Sprintf("quorum=%d", n), func(b *testing.B) {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+			secret := setupQuorum(b, ctx, tApp.GetDASignersKeeper(), n)
+			precompile, err := dasignersprecompile.NewDASignersPrecompile(tApp.GetDASignersKeeper())
+			require.NoError(b, err)
+			method, values := args(n, secret)
+			input, err := precompile.ABI().Pack(method, values...)
+			require.NoError(b, err)
+			stateDB := statedb.New(ctx, tApp.GetEvmKeeper(), statedb.NewEmptyTxConfig(common.Hash{}))
+			evm := vm.NewEVM(vm.BlockContext{}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+
+			var gas uint64
+			b.ResetTimer()
+			for i := 0; i < b.N; i += 1 {
+				contract := vm.NewContract(vm.AccountRef(common.Address{}), vm.AccountRef(precompile.Address()), big.NewInt(0), params.MaxGasLimit)
+				contract.Input = input
+				if _, err := precompile.Run(evm, contract, true); err != nil {
+					b.Fatal(err)
+				}
+				gas = precompile.RequiredGas(input) + params.MaxGasLimit - contract.Gas
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(gas), "gas/op")
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N)/float64(gas), "ns/gas")
+		})
+	}
+}
+
+func BenchmarkGetQuorum(b *testing.B) {
+	benchmarkQuery(b, []int{64, 256, 1024}, func(int, *big.Int) (string, []interface{}) {
+		return dasignersprecompile.DASignersFunctionGetQuorum, []interface{}{big.NewInt(0), big.NewInt(0)}
+	})
+}
+
+func BenchmarkGetAggPkG1(b *testing.B) {
+	benchmarkQuery(b, []int{64, 256, 1024}, func(n int, _ *big.Int) (string, []interface{}) {
+		return dasignersprecompile.DASignersFunctionGetAggPkG1, []interface{}{big.NewInt(0), big.NewInt(0), bitmap(n, n)}
+	})
+}
+
+func BenchmarkVerifyAggregateSignature(b *testing.B) {
+	messageHash := [32]byte(crypto.Keccak256Hash([]byte("blob")))
+	benchmarkQuery(b, []int{64, 256, 1024}, func(n int, secret *big.Int) (string, []interface{}) {
+		return dasignersprecompile.DASignersFunctionVerifyAggSig, []interface{}{big.NewInt(0), big.NewInt(0), bitmap(n, n), messageHash, signature(secret, messageHash)}
+	})
+}
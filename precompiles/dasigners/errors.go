@@ -2,4 +2,5 @@ package dasigners
 
 const (
 	ErrInvalidSender = "sender address %s is not the same as signer address %s"
+	ErrSignersLimit  = "signers limit %d exceeds maximum %d"
 )
@@ -1,60 +1,36 @@
 package dasigners
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
 const (
-	NewSignerEvent     = "NewSigner"
-	SocketUpdatedEvent = "SocketUpdated"
+	NewSignerEvent          = "NewSigner"
+	SocketUpdatedEvent      = "SocketUpdated"
+	SignerDeregisteredEvent = "SignerDeregistered"
+	SignerKeyRotatedEvent   = "SignerKeyRotated"
 )
 
 func (d *DASignersPrecompile) EmitNewSignerEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer IDASignersSignerDetail) error {
-	event := d.abi.Events[NewSignerEvent]
-	quries := make([]interface{}, 2)
-	quries[0] = event.ID
-	quries[1] = signer.Signer
-	topics, err := abi.MakeTopics(quries)
-	if err != nil {
-		return err
-	}
-	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
-	b, err := arguments.Pack(signer.PkG1, signer.PkG2)
+	err := d.EmitEvent(ctx, stateDB, NewSignerEvent, signer.Signer, signer.PkG1, signer.PkG2)
 	if err != nil {
 		return err
 	}
-	stateDB.AddLog(&types.Log{
-		Address:     d.Address(),
-		Topics:      topics[0],
-		Data:        b,
-		BlockNumber: uint64(ctx.BlockHeight()),
-	})
 	return d.EmitSocketUpdatedEvent(ctx, stateDB, signer.Signer, signer.Socket)
 }
 
 func (d *DASignersPrecompile) EmitSocketUpdatedEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, socket string) error {
-	event := d.abi.Events[SocketUpdatedEvent]
-	quries := make([]interface{}, 2)
-	quries[0] = event.ID
-	quries[1] = signer
-	topics, err := abi.MakeTopics(quries)
-	if err != nil {
-		return err
-	}
-	arguments := abi.Arguments{event.Inputs[1]}
-	b, err := arguments.Pack(socket)
-	if err != nil {
-		return err
-	}
-	stateDB.AddLog(&types.Log{
-		Address:     d.Address(),
-		Topics:      topics[0],
-		Data:        b,
-		BlockNumber: uint64(ctx.BlockHeight()),
-	})
-	return nil
+	return d.EmitEvent(ctx, stateDB, SocketUpdatedEvent, signer, socket)
+}
+
+func (d *DASignersPrecompile) EmitSignerDeregisteredEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, exitEpoch uint64) error {
+	return d.EmitEvent(ctx, stateDB, SignerDeregisteredEvent, signer, new(big.Int).SetUint64(exitEpoch))
+}
+
+func (d *DASignersPrecompile) EmitSignerKeyRotatedEvent(ctx sdk.Context, stateDB *statedb.StateDB, signer common.Address, pkG1 BN254G1Point, pkG2 BN254G2Point, effectiveEpoch uint64) error {
+	return d.EmitEvent(ctx, stateDB, SignerKeyRotatedEvent, signer, pkG1, pkG2, new(big.Int).SetUint64(effectiveEpoch))
 }
@@ -0,0 +1,47 @@
+package dasigners
+
+import (
+	"math/bits"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// The gas of the methods is the flat gas they are registered with, the store accesses charged with the
+// KV gas config of the cosmos transactions and the following work. The costs are calibrated to about 5ns
+// of execution per gas whatever the size of the quorum, see the benchmarks of the package.
+const (
+	// GasPerReturnedWord is charged for every 32 bytes returned by a query
+	GasPerReturnedWord uint64 = 30
+	// GasPerQuorumRow is charged for every row of the quorum scanned by an aggregation
+	GasPerQuorumRow uint64 = 20
+	// GasPerAggregatedSigner is charged for every row set in the bitmap of an aggregation, which loads the
+	// keys of the signer and adds them to the aggregate keys
+	GasPerAggregatedSigner uint64 = 2000
+	// GasPairingCheck is charged for the pairing check of verifyAggregateSignature
+	GasPairingCheck uint64 = 150000
+)
+
+// consumeBitmapGas charges an aggregation over the quorum rows selected by the bitmap before it is done.
+func consumeBitmapGas(ctx sdk.Context, quorumBitmap []byte) {
+	hit := 0
+	for _, b := range quorumBitmap {
+		hit += bits.OnesCount8(b)
+	}
+	ctx.GasMeter().ConsumeGas(uint64(len(quorumBitmap))*8*GasPerQuorumRow, "scan quorum rows")
+	ctx.GasMeter().ConsumeGas(uint64(hit)*GasPerAggregatedSigner, "aggregate signer keys")
+}
+
+// meterReturnedBytes charges the bytes returned by a query.
+func meterReturnedBytes(handler precopmiles_common.QueryHandler) precopmiles_common.QueryHandler {
+	return func(ctx sdk.Context, evm *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+		bz, err := handler(ctx, evm, method, args)
+		if err != nil {
+			return nil, err
+		}
+		ctx.GasMeter().ConsumeGas(uint64(len(bz)+31)/32*GasPerReturnedWord, "returned bytes")
+		return bz, nil
+	}
+}
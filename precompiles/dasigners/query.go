@@ -47,6 +47,22 @@ func (d *DASignersPrecompile) GetSigner(ctx sdk.Context, _ *vm.EVM, method *abi.
 	return method.Outputs.Pack(signers)
 }
 
+func (d *DASignersPrecompile) GetSigners(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQuerySignersRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.Signers(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	signers := make([]IDASignersSignerDetail, len(response.Signers))
+	for i, signer := range response.Signers {
+		signers[i] = NewIDASignersSignerDetail(signer)
+	}
+	return method.Outputs.Pack(signers, new(big.Int).SetUint64(response.Pagination.Total))
+}
+
 func (d *DASignersPrecompile) IsSigner(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
 	if len(args) != 1 {
 		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
@@ -105,9 +121,36 @@ func (d *DASignersPrecompile) GetAggPkG1(ctx sdk.Context, _ *vm.EVM, method *abi
 	if err != nil {
 		return nil, err
 	}
+	consumeBitmapGas(ctx, req.QuorumBitmap)
 	response, err := d.dasignersKeeper.AggregatePubkeyG1(sdk.WrapSDKContext(ctx), req)
 	if err != nil {
 		return nil, err
 	}
 	return method.Outputs.Pack(NewBN254G1Point(response.AggregatePubkeyG1), big.NewInt(int64(response.Total)), big.NewInt(int64(response.Hit)))
 }
+
+func (d *DASignersPrecompile) VerifyAggregateSignature(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryVerifyAggregateSignatureRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	consumeBitmapGas(ctx, req.QuorumBitmap)
+	response, err := d.dasignersKeeper.VerifyAggregateSignature(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(response.Valid, big.NewInt(int64(response.Total)), big.NewInt(int64(response.Hit)))
+}
+
+func (d *DASignersPrecompile) GetSignerBallot(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQuerySignerBallotRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.SignerBallot(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	ballot := response.Ballot
+	return method.Outputs.Pack(ballot.Bonded.BigInt(), new(big.Int).SetUint64(ballot.Votes), ballot.Capped, new(big.Int).SetUint64(ballot.Seats))
+}
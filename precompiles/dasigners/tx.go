@@ -9,7 +9,7 @@ import (
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
-func (d *DASignersPrecompile) RegisterSigner(ctx sdk.Context, evm *vm.EVM, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+func (d *DASignersPrecompile) RegisterSigner(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
 	msg, err := NewMsgRegisterSigner(args)
 	if err != nil {
 		return nil, err
@@ -32,7 +32,7 @@ func (d *DASignersPrecompile) RegisterSigner(ctx sdk.Context, evm *vm.EVM, state
 	return method.Outputs.Pack()
 }
 
-func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, evm *vm.EVM, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
 	msg, err := NewMsgRegisterNextEpoch(args, ToLowerHexWithoutPrefix(evm.Origin))
 	if err != nil {
 		return nil, err
@@ -45,7 +45,33 @@ func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, evm *vm.EVM, st
 	return method.Outputs.Pack()
 }
 
-func (d *DASignersPrecompile) UpdateSocket(ctx sdk.Context, evm *vm.EVM, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+func (d *DASignersPrecompile) RegisterStanding(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRegisterStanding(args, ToLowerHexWithoutPrefix(evm.Origin))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = d.dasignersKeeper.RegisterStanding(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) CancelStanding(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgCancelStanding(args, ToLowerHexWithoutPrefix(evm.Origin))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = d.dasignersKeeper.CancelStanding(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) UpdateSocket(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
 	msg, err := NewMsgUpdateSocket(args, ToLowerHexWithoutPrefix(evm.Origin))
 	if err != nil {
 		return nil, err
@@ -62,3 +88,39 @@ func (d *DASignersPrecompile) UpdateSocket(ctx sdk.Context, evm *vm.EVM, stateDB
 	}
 	return method.Outputs.Pack()
 }
+
+func (d *DASignersPrecompile) DeregisterSigner(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgDeregisterSigner(args, ToLowerHexWithoutPrefix(evm.Origin))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.dasignersKeeper.DeregisterSigner(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSignerDeregisteredEvent(ctx, stateDB, evm.Origin, response.ExitEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) RotateSignerKey(ctx sdk.Context, evm *vm.EVM, _ *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRotateSignerKey(args, ToLowerHexWithoutPrefix(evm.Origin))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.dasignersKeeper.RotateSignerKey(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSignerKeyRotatedEvent(ctx, stateDB, evm.Origin, args[0].(BN254G1Point), args[1].(BN254G2Point), response.EffectiveEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
@@ -7,6 +7,7 @@ import (
 
 	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
 	dasignerstypes "github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -87,6 +88,25 @@ func NewQuerySignerRequest(args []interface{}) (*dasignerstypes.QuerySignerReque
 	return &req, nil
 }
 
+func NewQuerySignersRequest(args []interface{}) (*dasignerstypes.QuerySignersRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+	limit := args[3].(*big.Int).Uint64()
+	if limit > MaxSignersLimit {
+		return nil, fmt.Errorf(ErrSignersLimit, limit, MaxSignersLimit)
+	}
+	return &dasignerstypes.QuerySignersRequest{
+		Filter:      dasignerstypes.SignersFilter(args[0].(uint8)),
+		EpochNumber: args[1].(*big.Int).Uint64(),
+		Pagination: &query.PageRequest{
+			Offset:     args[2].(*big.Int).Uint64(),
+			Limit:      limit,
+			CountTotal: true,
+		},
+	}, nil
+}
+
 func NewQueryEpochQuorumRequest(args []interface{}) (*dasignerstypes.QueryEpochQuorumRequest, error) {
 	if len(args) != 2 {
 		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
@@ -122,6 +142,32 @@ func NewQueryAggregatePubkeyG1Request(args []interface{}) (*dasignerstypes.Query
 	}, nil
 }
 
+func NewQueryVerifyAggregateSignatureRequest(args []interface{}) (*dasignerstypes.QueryVerifyAggregateSignatureRequest, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+	messageHash := args[3].([32]byte)
+
+	return &dasignerstypes.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:  args[0].(*big.Int).Uint64(),
+		QuorumId:     args[1].(*big.Int).Uint64(),
+		QuorumBitmap: args[2].([]byte),
+		MessageHash:  messageHash[:],
+		Signature:    SerializeG1(args[4].(BN254G1Point)),
+	}, nil
+}
+
+func NewQuerySignerBallotRequest(args []interface{}) (*dasignerstypes.QuerySignerBallotRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return &dasignerstypes.QuerySignerBallotRequest{
+		Account:     ToLowerHexWithoutPrefix(args[0].(common.Address)),
+		EpochNumber: args[1].(*big.Int).Uint64(),
+	}, nil
+}
+
 func NewIDASignersSignerDetail(signer *dasignerstypes.Signer) IDASignersSignerDetail {
 	return IDASignersSignerDetail{
 		Signer: common.HexToAddress(signer.Account),
@@ -163,6 +209,27 @@ func NewMsgRegisterNextEpoch(args []interface{}, account string) (*dasignerstype
 	}, nil
 }
 
+func NewMsgRegisterStanding(args []interface{}, account string) (*dasignerstypes.MsgRegisterStanding, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &dasignerstypes.MsgRegisterStanding{
+		Account:   account,
+		Signature: SerializeG1(args[0].(BN254G1Point)),
+	}, nil
+}
+
+func NewMsgCancelStanding(args []interface{}, account string) (*dasignerstypes.MsgCancelStanding, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return &dasignerstypes.MsgCancelStanding{
+		Account: account,
+	}, nil
+}
+
 func NewMsgUpdateSocket(args []interface{}, account string) (*dasignerstypes.MsgUpdateSocket, error) {
 	if len(args) != 1 {
 		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
@@ -173,3 +240,26 @@ func NewMsgUpdateSocket(args []interface{}, account string) (*dasignerstypes.Msg
 		Socket:  args[0].(string),
 	}, nil
 }
+
+func NewMsgDeregisterSigner(args []interface{}, account string) (*dasignerstypes.MsgDeregisterSigner, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return &dasignerstypes.MsgDeregisterSigner{
+		Account: account,
+	}, nil
+}
+
+func NewMsgRotateSignerKey(args []interface{}, account string) (*dasignerstypes.MsgRotateSignerKey, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	return &dasignerstypes.MsgRotateSignerKey{
+		Account:   account,
+		PubkeyG1:  SerializeG1(args[0].(BN254G1Point)),
+		PubkeyG2:  SerializeG2(args[1].(BN254G2Point)),
+		Signature: SerializeG1(args[2].(BN254G1Point)),
+	}, nil
+}
@@ -0,0 +1,829 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package gov
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// GovMetaData contains all meta data concerning the Gov contract.
+var GovMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"voteType\",\"type\":\"uint8\"}],\"name\":\"CommitteeVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"}],\"name\":\"Vote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"weight\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIGov.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"}],\"name\":\"VoteWeighted\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"voteType\",\"type\":\"uint8\"}],\"name\":\"committeeVote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getCommitteeProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"committeeId\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"}],\"internalType\":\"structIGov.CommitteeProposal\",\"name\":\"proposal\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getCommitteeTally\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"yesVotes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"noVotes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentVotes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"possibleVotes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"voteThreshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"quorum\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.CommitteeTally\",\"name\":\"tally\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"submitTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"depositEndTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"votingStartTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"votingEndTime\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"internalType\":\"structIGov.Proposal\",\"name\":\"proposal\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getTally\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"yes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"abstain\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"no\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"noWithVeto\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.TallyResult\",\"name\":\"tally\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"name\":\"vote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"weight\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"}],\"name\":\"voteWeighted\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// GovABI is the input ABI used to generate the binding from.
+// Deprecated: Use GovMetaData.ABI instead.
+var GovABI = GovMetaData.ABI
+
+// Gov is an auto generated Go binding around an Ethereum contract.
+type Gov struct {
+	GovCaller     // Read-only binding to the contract
+	GovTransactor // Write-only binding to the contract
+	GovFilterer   // Log filterer for contract events
+}
+
+// GovCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovSession struct {
+	Contract     *Gov              // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovCallerSession struct {
+	Contract *GovCaller    // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// GovTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovTransactorSession struct {
+	Contract     *GovTransactor    // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovRaw struct {
+	Contract *Gov // Generic contract binding to access the raw methods on
+}
+
+// GovCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovCallerRaw struct {
+	Contract *GovCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovTransactorRaw struct {
+	Contract *GovTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGov creates a new instance of Gov, bound to a specific deployed contract.
+func NewGov(address common.Address, backend bind.ContractBackend) (*Gov, error) {
+	contract, err := bindGov(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Gov{GovCaller: GovCaller{contract: contract}, GovTransactor: GovTransactor{contract: contract}, GovFilterer: GovFilterer{contract: contract}}, nil
+}
+
+// NewGovCaller creates a new read-only instance of Gov, bound to a specific deployed contract.
+func NewGovCaller(address common.Address, caller bind.ContractCaller) (*GovCaller, error) {
+	contract, err := bindGov(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovCaller{contract: contract}, nil
+}
+
+// NewGovTransactor creates a new write-only instance of Gov, bound to a specific deployed contract.
+func NewGovTransactor(address common.Address, transactor bind.ContractTransactor) (*GovTransactor, error) {
+	contract, err := bindGov(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovTransactor{contract: contract}, nil
+}
+
+// NewGovFilterer creates a new log filterer instance of Gov, bound to a specific deployed contract.
+func NewGovFilterer(address common.Address, filterer bind.ContractFilterer) (*GovFilterer, error) {
+	contract, err := bindGov(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovFilterer{contract: contract}, nil
+}
+
+// bindGov binds a generic wrapper to an already deployed contract.
+func bindGov(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gov *GovRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gov.Contract.GovCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gov *GovRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.Contract.GovTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gov *GovRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gov.Contract.GovTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gov *GovCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gov.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gov *GovTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gov *GovTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gov.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetCommitteeProposal is a free data retrieval call binding the contract method 0xc72f205b.
+//
+// Solidity: function getCommitteeProposal(uint64 proposalId) view returns((uint64,uint64,uint256,string) proposal)
+func (_Gov *GovCaller) GetCommitteeProposal(opts *bind.CallOpts, proposalId uint64) (IGovCommitteeProposal, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "getCommitteeProposal", proposalId)
+
+	if err != nil {
+		return *new(IGovCommitteeProposal), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovCommitteeProposal)).(*IGovCommitteeProposal)
+
+	return out0, err
+
+}
+
+// GetCommitteeProposal is a free data retrieval call binding the contract method 0xc72f205b.
+//
+// Solidity: function getCommitteeProposal(uint64 proposalId) view returns((uint64,uint64,uint256,string) proposal)
+func (_Gov *GovSession) GetCommitteeProposal(proposalId uint64) (IGovCommitteeProposal, error) {
+	return _Gov.Contract.GetCommitteeProposal(&_Gov.CallOpts, proposalId)
+}
+
+// GetCommitteeProposal is a free data retrieval call binding the contract method 0xc72f205b.
+//
+// Solidity: function getCommitteeProposal(uint64 proposalId) view returns((uint64,uint64,uint256,string) proposal)
+func (_Gov *GovCallerSession) GetCommitteeProposal(proposalId uint64) (IGovCommitteeProposal, error) {
+	return _Gov.Contract.GetCommitteeProposal(&_Gov.CallOpts, proposalId)
+}
+
+// GetCommitteeTally is a free data retrieval call binding the contract method 0xb9fa30cb.
+//
+// Solidity: function getCommitteeTally(uint64 proposalId) view returns((uint256,uint256,uint256,uint256,uint256,uint256) tally)
+func (_Gov *GovCaller) GetCommitteeTally(opts *bind.CallOpts, proposalId uint64) (IGovCommitteeTally, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "getCommitteeTally", proposalId)
+
+	if err != nil {
+		return *new(IGovCommitteeTally), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovCommitteeTally)).(*IGovCommitteeTally)
+
+	return out0, err
+
+}
+
+// GetCommitteeTally is a free data retrieval call binding the contract method 0xb9fa30cb.
+//
+// Solidity: function getCommitteeTally(uint64 proposalId) view returns((uint256,uint256,uint256,uint256,uint256,uint256) tally)
+func (_Gov *GovSession) GetCommitteeTally(proposalId uint64) (IGovCommitteeTally, error) {
+	return _Gov.Contract.GetCommitteeTally(&_Gov.CallOpts, proposalId)
+}
+
+// GetCommitteeTally is a free data retrieval call binding the contract method 0xb9fa30cb.
+//
+// Solidity: function getCommitteeTally(uint64 proposalId) view returns((uint256,uint256,uint256,uint256,uint256,uint256) tally)
+func (_Gov *GovCallerSession) GetCommitteeTally(proposalId uint64) (IGovCommitteeTally, error) {
+	return _Gov.Contract.GetCommitteeTally(&_Gov.CallOpts, proposalId)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 proposalId) view returns((uint64,uint8,uint256,uint256,uint256,uint256,string) proposal)
+func (_Gov *GovCaller) GetProposal(opts *bind.CallOpts, proposalId uint64) (IGovProposal, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "getProposal", proposalId)
+
+	if err != nil {
+		return *new(IGovProposal), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovProposal)).(*IGovProposal)
+
+	return out0, err
+
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 proposalId) view returns((uint64,uint8,uint256,uint256,uint256,uint256,string) proposal)
+func (_Gov *GovSession) GetProposal(proposalId uint64) (IGovProposal, error) {
+	return _Gov.Contract.GetProposal(&_Gov.CallOpts, proposalId)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 proposalId) view returns((uint64,uint8,uint256,uint256,uint256,uint256,string) proposal)
+func (_Gov *GovCallerSession) GetProposal(proposalId uint64) (IGovProposal, error) {
+	return _Gov.Contract.GetProposal(&_Gov.CallOpts, proposalId)
+}
+
+// GetTally is a free data retrieval call binding the contract method 0x525f1152.
+//
+// Solidity: function getTally(uint64 proposalId) view returns((uint256,uint256,uint256,uint256) tally)
+func (_Gov *GovCaller) GetTally(opts *bind.CallOpts, proposalId uint64) (IGovTallyResult, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "getTally", proposalId)
+
+	if err != nil {
+		return *new(IGovTallyResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovTallyResult)).(*IGovTallyResult)
+
+	return out0, err
+
+}
+
+// GetTally is a free data retrieval call binding the contract method 0x525f1152.
+//
+// Solidity: function getTally(uint64 proposalId) view returns((uint256,uint256,uint256,uint256) tally)
+func (_Gov *GovSession) GetTally(proposalId uint64) (IGovTallyResult, error) {
+	return _Gov.Contract.GetTally(&_Gov.CallOpts, proposalId)
+}
+
+// GetTally is a free data retrieval call binding the contract method 0x525f1152.
+//
+// Solidity: function getTally(uint64 proposalId) view returns((uint256,uint256,uint256,uint256) tally)
+func (_Gov *GovCallerSession) GetTally(proposalId uint64) (IGovTallyResult, error) {
+	return _Gov.Contract.GetTally(&_Gov.CallOpts, proposalId)
+}
+
+// CommitteeVote is a paid mutator transaction binding the contract method 0xd1e3170f.
+//
+// Solidity: function committeeVote(uint64 proposalId, uint8 voteType) returns()
+func (_Gov *GovTransactor) CommitteeVote(opts *bind.TransactOpts, proposalId uint64, voteType uint8) (*types.Transaction, error) {
+	return _Gov.contract.Transact(opts, "committeeVote", proposalId, voteType)
+}
+
+// CommitteeVote is a paid mutator transaction binding the contract method 0xd1e3170f.
+//
+// Solidity: function committeeVote(uint64 proposalId, uint8 voteType) returns()
+func (_Gov *GovSession) CommitteeVote(proposalId uint64, voteType uint8) (*types.Transaction, error) {
+	return _Gov.Contract.CommitteeVote(&_Gov.TransactOpts, proposalId, voteType)
+}
+
+// CommitteeVote is a paid mutator transaction binding the contract method 0xd1e3170f.
+//
+// Solidity: function committeeVote(uint64 proposalId, uint8 voteType) returns()
+func (_Gov *GovTransactorSession) CommitteeVote(proposalId uint64, voteType uint8) (*types.Transaction, error) {
+	return _Gov.Contract.CommitteeVote(&_Gov.TransactOpts, proposalId, voteType)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x528783d5.
+//
+// Solidity: function vote(uint64 proposalId, uint8 option, string metadata) returns()
+func (_Gov *GovTransactor) Vote(opts *bind.TransactOpts, proposalId uint64, option uint8, metadata string) (*types.Transaction, error) {
+	return _Gov.contract.Transact(opts, "vote", proposalId, option, metadata)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x528783d5.
+//
+// Solidity: function vote(uint64 proposalId, uint8 option, string metadata) returns()
+func (_Gov *GovSession) Vote(proposalId uint64, option uint8, metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.Vote(&_Gov.TransactOpts, proposalId, option, metadata)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x528783d5.
+//
+// Solidity: function vote(uint64 proposalId, uint8 option, string metadata) returns()
+func (_Gov *GovTransactorSession) Vote(proposalId uint64, option uint8, metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.Vote(&_Gov.TransactOpts, proposalId, option, metadata)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0x406d7224.
+//
+// Solidity: function voteWeighted(uint64 proposalId, (uint8,uint256)[] options, string metadata) returns()
+func (_Gov *GovTransactor) VoteWeighted(opts *bind.TransactOpts, proposalId uint64, options []IGovWeightedVoteOption, metadata string) (*types.Transaction, error) {
+	return _Gov.contract.Transact(opts, "voteWeighted", proposalId, options, metadata)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0x406d7224.
+//
+// Solidity: function voteWeighted(uint64 proposalId, (uint8,uint256)[] options, string metadata) returns()
+func (_Gov *GovSession) VoteWeighted(proposalId uint64, options []IGovWeightedVoteOption, metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.VoteWeighted(&_Gov.TransactOpts, proposalId, options, metadata)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0x406d7224.
+//
+// Solidity: function voteWeighted(uint64 proposalId, (uint8,uint256)[] options, string metadata) returns()
+func (_Gov *GovTransactorSession) VoteWeighted(proposalId uint64, options []IGovWeightedVoteOption, metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.VoteWeighted(&_Gov.TransactOpts, proposalId, options, metadata)
+}
+
+// GovCommitteeVoteIterator is returned from FilterCommitteeVote and is used to iterate over the raw logs and unpacked data for CommitteeVote events raised by the Gov contract.
+type GovCommitteeVoteIterator struct {
+	Event *GovCommitteeVote // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovCommitteeVoteIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovCommitteeVote)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovCommitteeVote)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovCommitteeVoteIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovCommitteeVoteIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovCommitteeVote represents a CommitteeVote event raised by the Gov contract.
+type GovCommitteeVote struct {
+	Voter      common.Address
+	ProposalId uint64
+	VoteType   uint8
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCommitteeVote is a free log retrieval operation binding the contract event 0x718ae28033d199774844f8299fb15740476d0edca70b7e205671c8d9bdcf697b.
+//
+// Solidity: event CommitteeVote(address indexed voter, uint64 indexed proposalId, uint8 voteType)
+func (_Gov *GovFilterer) FilterCommitteeVote(opts *bind.FilterOpts, voter []common.Address, proposalId []uint64) (*GovCommitteeVoteIterator, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "CommitteeVote", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovCommitteeVoteIterator{contract: _Gov.contract, event: "CommitteeVote", logs: logs, sub: sub}, nil
+}
+
+// WatchCommitteeVote is a free log subscription operation binding the contract event 0x718ae28033d199774844f8299fb15740476d0edca70b7e205671c8d9bdcf697b.
+//
+// Solidity: event CommitteeVote(address indexed voter, uint64 indexed proposalId, uint8 voteType)
+func (_Gov *GovFilterer) WatchCommitteeVote(opts *bind.WatchOpts, sink chan<- *GovCommitteeVote, voter []common.Address, proposalId []uint64) (event.Subscription, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "CommitteeVote", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovCommitteeVote)
+				if err := _Gov.contract.UnpackLog(event, "CommitteeVote", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCommitteeVote is a log parse operation binding the contract event 0x718ae28033d199774844f8299fb15740476d0edca70b7e205671c8d9bdcf697b.
+//
+// Solidity: event CommitteeVote(address indexed voter, uint64 indexed proposalId, uint8 voteType)
+func (_Gov *GovFilterer) ParseCommitteeVote(log types.Log) (*GovCommitteeVote, error) {
+	event := new(GovCommitteeVote)
+	if err := _Gov.contract.UnpackLog(event, "CommitteeVote", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovVoteIterator is returned from FilterVote and is used to iterate over the raw logs and unpacked data for Vote events raised by the Gov contract.
+type GovVoteIterator struct {
+	Event *GovVote // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovVoteIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovVote)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovVote)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovVoteIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovVoteIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovVote represents a Vote event raised by the Gov contract.
+type GovVote struct {
+	Voter      common.Address
+	ProposalId uint64
+	Option     uint8
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterVote is a free log retrieval operation binding the contract event 0x71c096cfbbce3e73fe1d1e5943da8fcbdcd2ba95519bfa456d51c282c575c64a.
+//
+// Solidity: event Vote(address indexed voter, uint64 indexed proposalId, uint8 option)
+func (_Gov *GovFilterer) FilterVote(opts *bind.FilterOpts, voter []common.Address, proposalId []uint64) (*GovVoteIterator, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "Vote", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovVoteIterator{contract: _Gov.contract, event: "Vote", logs: logs, sub: sub}, nil
+}
+
+// WatchVote is a free log subscription operation binding the contract event 0x71c096cfbbce3e73fe1d1e5943da8fcbdcd2ba95519bfa456d51c282c575c64a.
+//
+// Solidity: event Vote(address indexed voter, uint64 indexed proposalId, uint8 option)
+func (_Gov *GovFilterer) WatchVote(opts *bind.WatchOpts, sink chan<- *GovVote, voter []common.Address, proposalId []uint64) (event.Subscription, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "Vote", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovVote)
+				if err := _Gov.contract.UnpackLog(event, "Vote", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVote is a log parse operation binding the contract event 0x71c096cfbbce3e73fe1d1e5943da8fcbdcd2ba95519bfa456d51c282c575c64a.
+//
+// Solidity: event Vote(address indexed voter, uint64 indexed proposalId, uint8 option)
+func (_Gov *GovFilterer) ParseVote(log types.Log) (*GovVote, error) {
+	event := new(GovVote)
+	if err := _Gov.contract.UnpackLog(event, "Vote", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovVoteWeightedIterator is returned from FilterVoteWeighted and is used to iterate over the raw logs and unpacked data for VoteWeighted events raised by the Gov contract.
+type GovVoteWeightedIterator struct {
+	Event *GovVoteWeighted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovVoteWeightedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovVoteWeighted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovVoteWeighted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovVoteWeightedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovVoteWeightedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovVoteWeighted represents a VoteWeighted event raised by the Gov contract.
+type GovVoteWeighted struct {
+	Voter      common.Address
+	ProposalId uint64
+	Options    []IGovWeightedVoteOption
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoteWeighted is a free log retrieval operation binding the contract event 0x86f93692c6759a384dc43eda8d01f7f8a1556bbb7d5351afc39e61710bca7a63.
+//
+// Solidity: event VoteWeighted(address indexed voter, uint64 indexed proposalId, (uint8,uint256)[] options)
+func (_Gov *GovFilterer) FilterVoteWeighted(opts *bind.FilterOpts, voter []common.Address, proposalId []uint64) (*GovVoteWeightedIterator, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "VoteWeighted", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovVoteWeightedIterator{contract: _Gov.contract, event: "VoteWeighted", logs: logs, sub: sub}, nil
+}
+
+// WatchVoteWeighted is a free log subscription operation binding the contract event 0x86f93692c6759a384dc43eda8d01f7f8a1556bbb7d5351afc39e61710bca7a63.
+//
+// Solidity: event VoteWeighted(address indexed voter, uint64 indexed proposalId, (uint8,uint256)[] options)
+func (_Gov *GovFilterer) WatchVoteWeighted(opts *bind.WatchOpts, sink chan<- *GovVoteWeighted, voter []common.Address, proposalId []uint64) (event.Subscription, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "VoteWeighted", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovVoteWeighted)
+				if err := _Gov.contract.UnpackLog(event, "VoteWeighted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVoteWeighted is a log parse operation binding the contract event 0x86f93692c6759a384dc43eda8d01f7f8a1556bbb7d5351afc39e61710bca7a63.
+//
+// Solidity: event VoteWeighted(address indexed voter, uint64 indexed proposalId, (uint8,uint256)[] options)
+func (_Gov *GovFilterer) ParseVoteWeighted(log types.Log) (*GovVoteWeighted, error) {
+	event := new(GovVoteWeighted)
+	if err := _Gov.contract.UnpackLog(event, "VoteWeighted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
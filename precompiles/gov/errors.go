@@ -0,0 +1,7 @@
+package gov
+
+const (
+	ErrProposalNotFound          = "proposal %d not found"
+	ErrCommitteeProposalNotFound = "committee proposal %d not found"
+	ErrInvalidTallyCount         = "invalid tally count %s"
+)
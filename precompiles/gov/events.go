@@ -0,0 +1,25 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	VoteEvent          = "Vote"
+	VoteWeightedEvent  = "VoteWeighted"
+	CommitteeVoteEvent = "CommitteeVote"
+)
+
+func (g *GovPrecompile) EmitVoteEvent(ctx sdk.Context, stateDB *statedb.StateDB, voter common.Address, proposalId uint64, option uint8) error {
+	return g.EmitEvent(ctx, stateDB, VoteEvent, voter, proposalId, option)
+}
+
+func (g *GovPrecompile) EmitVoteWeightedEvent(ctx sdk.Context, stateDB *statedb.StateDB, voter common.Address, proposalId uint64, options interface{}) error {
+	return g.EmitEvent(ctx, stateDB, VoteWeightedEvent, voter, proposalId, options)
+}
+
+func (g *GovPrecompile) EmitCommitteeVoteEvent(ctx sdk.Context, stateDB *statedb.StateDB, voter common.Address, proposalId uint64, voteType uint8) error {
+	return g.EmitEvent(ctx, stateDB, CommitteeVoteEvent, voter, proposalId, voteType)
+}
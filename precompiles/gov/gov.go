@@ -0,0 +1,52 @@
+package gov
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	committeekeeper "github.com/0glabs/0g-chain/x/committee/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001004"
+
+	GovFunctionVote                 = "vote"
+	GovFunctionVoteWeighted         = "voteWeighted"
+	GovFunctionCommitteeVote        = "committeeVote"
+	GovFunctionGetProposal          = "getProposal"
+	GovFunctionGetTally             = "getTally"
+	GovFunctionGetCommitteeProposal = "getCommitteeProposal"
+	GovFunctionGetCommitteeTally    = "getCommitteeTally"
+)
+
+var _ vm.PrecompiledContract = &GovPrecompile{}
+
+type GovPrecompile struct {
+	*precopmiles_common.Precompile
+	// the keepers are references as the app creates them after the precompiles
+	govKeeper       *govkeeper.Keeper
+	committeeKeeper *committeekeeper.Keeper
+}
+
+func NewGovPrecompile(govKeeper *govkeeper.Keeper, committeeKeeper *committeekeeper.Keeper) (*GovPrecompile, error) {
+	precompile, err := precopmiles_common.NewPrecompile(PrecompileAddress, GovABI)
+	if err != nil {
+		return nil, err
+	}
+	g := &GovPrecompile{
+		Precompile:      precompile,
+		govKeeper:       govKeeper,
+		committeeKeeper: committeeKeeper,
+	}
+	// queries, the tallies iterate over the votes and the delegations of the voters
+	g.RegisterQuery(GovFunctionGetProposal, 10000, g.GetProposal)
+	g.RegisterQuery(GovFunctionGetTally, 500000, g.GetTally)
+	g.RegisterQuery(GovFunctionGetCommitteeProposal, 10000, g.GetCommitteeProposal)
+	g.RegisterQuery(GovFunctionGetCommitteeTally, 200000, g.GetCommitteeTally)
+	// txs, the votes are written through the keepers and would outlive a reverted calling frame, so voters
+	// vote directly
+	g.RegisterTx(GovFunctionVote, 50000, g.Vote).DirectCallOnly()
+	g.RegisterTx(GovFunctionVoteWeighted, 60000, g.VoteWeighted).DirectCallOnly()
+	g.RegisterTx(GovFunctionCommitteeVote, 50000, g.CommitteeVote).DirectCallOnly()
+	return g, nil
+}
@@ -0,0 +1,229 @@
+package gov_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/chaincfg"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	govprecompile "github.com/0glabs/0g-chain/precompiles/gov"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	committeetypes "github.com/0glabs/0g-chain/x/committee/types"
+)
+
+type GovTestSuite struct {
+	testutil.PrecompileTestSuite
+}
+
+func (suite *GovTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+	suite.SetupPrecompile(govprecompile.PrecompileAddress, govprecompile.GovABI)
+}
+
+// bondTokens delegates tokens of the caller to a new bonded validator
+func (suite *GovTestSuite) bondTokens(amount int64) {
+	operator := suite.Addrs[0]
+	suite.FundAccountWithZgChain(operator, sdk.NewCoins(sdk.NewInt64Coin(chaincfg.GasDenom, 1e6)))
+	valAddr := sdk.ValAddress(operator)
+	err := suite.App.CreateNewUnbondedValidator(suite.Ctx, valAddr, sdkmath.NewInt(1e6))
+	suite.Require().NoError(err)
+
+	stakingKeeper := suite.App.GetStakingKeeper()
+	validator, found := stakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	_, err = stakingKeeper.Delegate(suite.Ctx, suite.Key1Addr.Bytes(), sdkmath.NewInt(amount), 1, validator, true)
+	suite.Require().NoError(err)
+	_, err = stakingKeeper.ApplyAndReturnValidatorSetUpdates(suite.Ctx)
+	suite.Require().NoError(err)
+}
+
+func (suite *GovTestSuite) submitProposal() govv1.Proposal {
+	govKeeper := suite.App.GetGovKeeper()
+	proposal, err := govKeeper.SubmitProposal(suite.Ctx, []sdk.Msg{}, "ipfs://proposal")
+	suite.Require().NoError(err)
+	govKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+	proposal, found := govKeeper.GetProposal(suite.Ctx, proposal.Id)
+	suite.Require().True(found)
+	return proposal
+}
+
+func (suite *GovTestSuite) TestVote() {
+	suite.bondTokens(1e6)
+	proposal := suite.submitProposal()
+
+	_, resp, err := suite.Call(govprecompile.GovFunctionVote, proposal.Id, uint8(govv1.OptionYes), "")
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Logs, 1)
+	suite.Require().Len(resp.Logs[0].Topics, 3)
+
+	vote, found := suite.App.GetGovKeeper().GetVote(suite.Ctx, proposal.Id, suite.Key1Addr.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal([]*govv1.WeightedVoteOption(govv1.NewNonSplitVoteOption(govv1.OptionYes)), vote.Options)
+
+	out := suite.MustCall(govprecompile.GovFunctionGetProposal, proposal.Id)
+	expected := govprecompile.IGovProposal{
+		Id:              proposal.Id,
+		Status:          uint8(govv1.StatusVotingPeriod),
+		SubmitTime:      big.NewInt(proposal.SubmitTime.Unix()),
+		DepositEndTime:  big.NewInt(proposal.DepositEndTime.Unix()),
+		VotingStartTime: big.NewInt(proposal.VotingStartTime.Unix()),
+		VotingEndTime:   big.NewInt(proposal.VotingEndTime.Unix()),
+		Metadata:        "ipfs://proposal",
+	}
+	suite.Require().Equal(expected, out[0])
+
+	// the caller votes with its delegation
+	out = suite.MustCall(govprecompile.GovFunctionGetTally, proposal.Id)
+	tally := out[0].(govprecompile.IGovTallyResult)
+	suite.Require().Equal(big.NewInt(1e6), tally.Yes)
+	suite.Require().Zero(tally.No.Sign())
+
+	// invalid options are rejected
+	_, _, err = suite.Call(govprecompile.GovFunctionVote, proposal.Id, uint8(9), "")
+	suite.Require().Error(err)
+	_, _, err = suite.Call(govprecompile.GovFunctionVote, proposal.Id+1, uint8(govv1.OptionYes), "")
+	suite.Require().Error(err)
+}
+
+func (suite *GovTestSuite) TestVote_FromContract() {
+	proposal := suite.submitProposal()
+	committeeKeeper := suite.App.GetCommitteeKeeper()
+	proxy := suite.DeployProxy(suite.Precompile, false)
+	committee, err := committeetypes.NewMemberCommittee(
+		1,
+		"committee",
+		[]sdk.AccAddress{suite.Key1Addr.Bytes(), proxy.Bytes()},
+		[]committeetypes.Permission{&committeetypes.GodPermission{}},
+		sdk.MustNewDecFromStr("0.75"),
+		time.Hour,
+		committeetypes.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Require().NoError(err)
+	committeeKeeper.SetCommittee(suite.Ctx, committee)
+	committeeProposalID, err := committeeKeeper.StoreNewProposal(suite.Ctx, govv1beta1.NewTextProposal("A Title", "A description"), committee.ID, suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	// the calling frame reverts, a vote cast through it would be kept, so contracts cannot vote
+	testCases := []struct {
+		method string
+		args   []interface{}
+	}{
+		{govprecompile.GovFunctionVote, []interface{}{proposal.Id, uint8(govv1.OptionNo), ""}},
+		{govprecompile.GovFunctionVoteWeighted, []interface{}{proposal.Id, []govprecompile.IGovWeightedVoteOption{
+			{Option: uint8(govv1.OptionYes), Weight: sdk.OneDec().BigInt()},
+		}, ""}},
+		{govprecompile.GovFunctionCommitteeVote, []interface{}{committeeProposalID, uint8(committeetypes.VOTE_TYPE_YES)}},
+	}
+	for _, tc := range testCases {
+		_, _, err := suite.CallFrom(suite.Key1Addr.Address, proxy, tc.method, tc.args...)
+		suite.Require().EqualError(err, fmt.Sprintf(precopmiles_common.ErrDirectCallOnly, tc.method), tc.method)
+	}
+
+	for _, voter := range []sdk.AccAddress{proxy.Bytes(), suite.Key1Addr.Bytes()} {
+		_, found := suite.App.GetGovKeeper().GetVote(suite.Ctx, proposal.Id, voter)
+		suite.Require().False(found)
+		_, found = committeeKeeper.GetVote(suite.Ctx, committeeProposalID, voter)
+		suite.Require().False(found)
+	}
+}
+
+func (suite *GovTestSuite) TestVoteWeighted() {
+	suite.bondTokens(1e6)
+	proposal := suite.submitProposal()
+
+	options := []govprecompile.IGovWeightedVoteOption{
+		{Option: uint8(govv1.OptionYes), Weight: big.NewInt(75e16)},
+		{Option: uint8(govv1.OptionNo), Weight: big.NewInt(25e16)},
+	}
+	suite.MustCall(govprecompile.GovFunctionVoteWeighted, proposal.Id, options, "")
+
+	vote, found := suite.App.GetGovKeeper().GetVote(suite.Ctx, proposal.Id, suite.Key1Addr.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal([]*govv1.WeightedVoteOption{
+		govv1.NewWeightedVoteOption(govv1.OptionYes, sdk.MustNewDecFromStr("0.75")),
+		govv1.NewWeightedVoteOption(govv1.OptionNo, sdk.MustNewDecFromStr("0.25")),
+	}, vote.Options)
+
+	out := suite.MustCall(govprecompile.GovFunctionGetTally, proposal.Id)
+	tally := out[0].(govprecompile.IGovTallyResult)
+	suite.Require().Equal(big.NewInt(75e4), tally.Yes)
+	suite.Require().Equal(big.NewInt(25e4), tally.No)
+
+	// the weights must add up to 1
+	options[1].Weight = big.NewInt(5e16)
+	_, _, err := suite.Call(govprecompile.GovFunctionVoteWeighted, proposal.Id, options, "")
+	suite.Require().Error(err)
+}
+
+func (suite *GovTestSuite) TestGetTally_Closed() {
+	proposal := suite.submitProposal()
+	finalTally := govv1.NewTallyResult(sdkmath.NewInt(1), sdkmath.NewInt(2), sdkmath.NewInt(3), sdkmath.NewInt(4))
+	proposal.Status = govv1.StatusRejected
+	proposal.FinalTallyResult = &finalTally
+	suite.App.GetGovKeeper().SetProposal(suite.Ctx, proposal)
+
+	out := suite.MustCall(govprecompile.GovFunctionGetTally, proposal.Id)
+	suite.Require().Equal(govprecompile.IGovTallyResult{
+		Yes:        big.NewInt(1),
+		Abstain:    big.NewInt(2),
+		No:         big.NewInt(3),
+		NoWithVeto: big.NewInt(4),
+	}, out[0])
+}
+
+func (suite *GovTestSuite) TestCommitteeVote() {
+	committeeKeeper := suite.App.GetCommitteeKeeper()
+	committee, err := committeetypes.NewMemberCommittee(
+		1,
+		"committee",
+		[]sdk.AccAddress{suite.Key1Addr.Bytes(), suite.Addrs[0]},
+		[]committeetypes.Permission{&committeetypes.GodPermission{}},
+		sdk.MustNewDecFromStr("0.75"),
+		time.Hour,
+		committeetypes.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Require().NoError(err)
+	committeeKeeper.SetCommittee(suite.Ctx, committee)
+	deadline := suite.Ctx.BlockTime().Add(time.Hour)
+	proposalID, err := committeeKeeper.StoreNewProposal(suite.Ctx, govv1beta1.NewTextProposal("A Title", "A description"), committee.ID, deadline)
+	suite.Require().NoError(err)
+
+	suite.MustCall(govprecompile.GovFunctionCommitteeVote, proposalID, uint8(committeetypes.VOTE_TYPE_YES))
+	vote, found := committeeKeeper.GetVote(suite.Ctx, proposalID, suite.Key1Addr.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal(committeetypes.VOTE_TYPE_YES, vote.VoteType)
+
+	out := suite.MustCall(govprecompile.GovFunctionGetCommitteeProposal, proposalID)
+	suite.Require().Equal(govprecompile.IGovCommitteeProposal{
+		Id:          proposalID,
+		CommitteeId: committee.ID,
+		Deadline:    big.NewInt(deadline.Unix()),
+		Title:       "A Title",
+	}, out[0])
+
+	out = suite.MustCall(govprecompile.GovFunctionGetCommitteeTally, proposalID)
+	tally := out[0].(govprecompile.IGovCommitteeTally)
+	suite.Require().Equal(sdk.OneDec().BigInt(), tally.YesVotes)
+	suite.Require().Equal(sdk.NewDec(2).BigInt(), tally.PossibleVotes)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.75").BigInt(), tally.VoteThreshold)
+
+	// only members can vote
+	suite.FundAccountWithZgChain(suite.Addrs[1], sdk.NewCoins(sdk.NewInt64Coin(chaincfg.GasDenom, 1)))
+	_, _, err = suite.CallFrom(common.BytesToAddress(suite.Addrs[1]), suite.Precompile, govprecompile.GovFunctionCommitteeVote, proposalID, uint8(committeetypes.VOTE_TYPE_YES))
+	suite.Require().Error(err)
+	_, _, err = suite.Call(govprecompile.GovFunctionGetCommitteeTally, proposalID+1)
+	suite.Require().EqualError(err, fmt.Sprintf(govprecompile.ErrCommitteeProposalNotFound, proposalID+1))
+}
+
+func TestGovTestSuite(t *testing.T) {
+	suite.Run(t, new(GovTestSuite))
+}
@@ -0,0 +1,65 @@
+package gov
+
+import (
+	"fmt"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (g *GovPrecompile) GetProposal(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	proposal, found := g.govKeeper.GetProposal(ctx, args[0].(uint64))
+	if !found {
+		return nil, fmt.Errorf(ErrProposalNotFound, args[0].(uint64))
+	}
+	return method.Outputs.Pack(NewIGovProposal(proposal))
+}
+
+// GetTally returns the final tally of closed proposals and the current tally of proposals in voting period.
+func (g *GovPrecompile) GetTally(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	proposal, found := g.govKeeper.GetProposal(ctx, args[0].(uint64))
+	if !found {
+		return nil, fmt.Errorf(ErrProposalNotFound, args[0].(uint64))
+	}
+	response, err := g.govKeeper.TallyResult(sdk.WrapSDKContext(ctx), &govv1.QueryTallyResultRequest{ProposalId: proposal.Id})
+	if err != nil {
+		return nil, err
+	}
+	tally, err := NewIGovTallyResult(*response.Tally)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(tally)
+}
+
+// GetCommitteeProposal returns an open committee proposal, committee proposals are deleted once closed.
+func (g *GovPrecompile) GetCommitteeProposal(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	proposal, found := g.committeeKeeper.GetProposal(ctx, args[0].(uint64))
+	if !found {
+		return nil, fmt.Errorf(ErrCommitteeProposalNotFound, args[0].(uint64))
+	}
+	return method.Outputs.Pack(NewIGovCommitteeProposal(proposal))
+}
+
+func (g *GovPrecompile) GetCommitteeTally(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	tally, found := g.committeeKeeper.GetProposalTallyResponse(ctx, args[0].(uint64))
+	if !found {
+		return nil, fmt.Errorf(ErrCommitteeProposalNotFound, args[0].(uint64))
+	}
+	return method.Outputs.Pack(NewIGovCommitteeTally(*tally))
+}
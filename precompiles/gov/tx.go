@@ -0,0 +1,77 @@
+package gov
+
+import (
+	committeekeeper "github.com/0glabs/0g-chain/x/committee/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// Vote votes on a gov proposal on behalf of the transaction sender.
+func (g *GovPrecompile) Vote(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgVote(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = govkeeper.NewMsgServerImpl(*g.govKeeper).Vote(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = g.EmitVoteEvent(ctx, stateDB, contract.Caller(), msg.ProposalId, uint8(msg.Option))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// VoteWeighted splits the vote of the transaction sender on a gov proposal over several options.
+func (g *GovPrecompile) VoteWeighted(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgVoteWeighted(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = govkeeper.NewMsgServerImpl(*g.govKeeper).VoteWeighted(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = g.EmitVoteWeightedEvent(ctx, stateDB, contract.Caller(), msg.ProposalId, args[1])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// CommitteeVote votes on a committee proposal on behalf of the transaction sender, which must be a member of
+// the committee or hold the tally denom of a token committee.
+func (g *GovPrecompile) CommitteeVote(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgCommitteeVote(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = committeekeeper.NewMsgServerImpl(*g.committeeKeeper).Vote(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = g.EmitCommitteeVoteEvent(ctx, stateDB, contract.Caller(), msg.ProposalID, uint8(msg.VoteType))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
@@ -0,0 +1,156 @@
+package gov
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	committeetypes "github.com/0glabs/0g-chain/x/committee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IGovWeightedVoteOption = struct {
+	Option uint8    "json:\"option\""
+	Weight *big.Int "json:\"weight\""
+}
+
+type IGovProposal = struct {
+	Id              uint64   "json:\"id\""
+	Status          uint8    "json:\"status\""
+	SubmitTime      *big.Int "json:\"submitTime\""
+	DepositEndTime  *big.Int "json:\"depositEndTime\""
+	VotingStartTime *big.Int "json:\"votingStartTime\""
+	VotingEndTime   *big.Int "json:\"votingEndTime\""
+	Metadata        string   "json:\"metadata\""
+}
+
+type IGovTallyResult = struct {
+	Yes        *big.Int "json:\"yes\""
+	Abstain    *big.Int "json:\"abstain\""
+	No         *big.Int "json:\"no\""
+	NoWithVeto *big.Int "json:\"noWithVeto\""
+}
+
+type IGovCommitteeProposal = struct {
+	Id          uint64   "json:\"id\""
+	CommitteeId uint64   "json:\"committeeId\""
+	Deadline    *big.Int "json:\"deadline\""
+	Title       string   "json:\"title\""
+}
+
+type IGovCommitteeTally = struct {
+	YesVotes      *big.Int "json:\"yesVotes\""
+	NoVotes       *big.Int "json:\"noVotes\""
+	CurrentVotes  *big.Int "json:\"currentVotes\""
+	PossibleVotes *big.Int "json:\"possibleVotes\""
+	VoteThreshold *big.Int "json:\"voteThreshold\""
+	Quorum        *big.Int "json:\"quorum\""
+}
+
+// unixTime returns the unix timestamp of t in seconds, or 0 if it is not set
+func unixTime(t *time.Time) *big.Int {
+	if t == nil {
+		return new(big.Int)
+	}
+	return big.NewInt(t.Unix())
+}
+
+func NewIGovProposal(proposal govv1.Proposal) IGovProposal {
+	return IGovProposal{
+		Id:              proposal.Id,
+		Status:          uint8(proposal.Status),
+		SubmitTime:      unixTime(proposal.SubmitTime),
+		DepositEndTime:  unixTime(proposal.DepositEndTime),
+		VotingStartTime: unixTime(proposal.VotingStartTime),
+		VotingEndTime:   unixTime(proposal.VotingEndTime),
+		Metadata:        proposal.Metadata,
+	}
+}
+
+func NewIGovTallyResult(tally govv1.TallyResult) (IGovTallyResult, error) {
+	counts := []string{tally.YesCount, tally.AbstainCount, tally.NoCount, tally.NoWithVetoCount}
+	result := make([]*big.Int, len(counts))
+	for i, count := range counts {
+		amount, ok := sdk.NewIntFromString(count)
+		if !ok {
+			return IGovTallyResult{}, fmt.Errorf(ErrInvalidTallyCount, count)
+		}
+		result[i] = amount.BigInt()
+	}
+	return IGovTallyResult{
+		Yes:        result[0],
+		Abstain:    result[1],
+		No:         result[2],
+		NoWithVeto: result[3],
+	}, nil
+}
+
+func NewIGovCommitteeProposal(proposal committeetypes.Proposal) IGovCommitteeProposal {
+	return IGovCommitteeProposal{
+		Id:          proposal.ID,
+		CommitteeId: proposal.CommitteeID,
+		Deadline:    big.NewInt(proposal.Deadline.Unix()),
+		Title:       proposal.GetTitle(),
+	}
+}
+
+// NewIGovCommitteeTally converts a committee tally, all values are fixed point numbers with 18 decimals.
+func NewIGovCommitteeTally(tally committeetypes.QueryTallyResponse) IGovCommitteeTally {
+	return IGovCommitteeTally{
+		YesVotes:      tally.YesVotes.BigInt(),
+		NoVotes:       tally.NoVotes.BigInt(),
+		CurrentVotes:  tally.CurrentVotes.BigInt(),
+		PossibleVotes: tally.PossibleVotes.BigInt(),
+		VoteThreshold: tally.VoteThreshold.BigInt(),
+		Quorum:        tally.Quorum.BigInt(),
+	}
+}
+
+func NewMsgVote(args []interface{}, voter common.Address) (*govv1.MsgVote, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	return govv1.NewMsgVote(
+		sdk.AccAddress(voter.Bytes()),
+		args[0].(uint64),
+		govv1.VoteOption(args[1].(uint8)),
+		args[2].(string),
+	), nil
+}
+
+// NewMsgVoteWeighted converts the voteWeighted arguments, weights are fixed point numbers with 18 decimals.
+func NewMsgVoteWeighted(args []interface{}, voter common.Address) (*govv1.MsgVoteWeighted, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	var options []IGovWeightedVoteOption
+	options = *abi.ConvertType(args[1], &options).(*[]IGovWeightedVoteOption)
+	weightedOptions := make(govv1.WeightedVoteOptions, len(options))
+	for i, option := range options {
+		weightedOptions[i] = govv1.NewWeightedVoteOption(
+			govv1.VoteOption(option.Option),
+			sdk.NewDecFromBigIntWithPrec(option.Weight, sdk.Precision),
+		)
+	}
+	return govv1.NewMsgVoteWeighted(
+		sdk.AccAddress(voter.Bytes()),
+		args[0].(uint64),
+		weightedOptions,
+		args[2].(string),
+	), nil
+}
+
+func NewMsgCommitteeVote(args []interface{}, voter common.Address) (*committeetypes.MsgVote, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	return committeetypes.NewMsgVote(
+		sdk.AccAddress(voter.Bytes()),
+		args[0].(uint64),
+		committeetypes.VoteType(args[1].(uint8)),
+	), nil
+}
@@ -0,0 +1,441 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package pricefeed
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// PriceFeedMetaData contains all meta data concerning the PriceFeed contract.
+var PriceFeedMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oracle\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"marketId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"name\":\"PricePosted\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"getMarkets\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"marketId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseAsset\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"quoteAsset\",\"type\":\"string\"},{\"internalType\":\"address[]\",\"name\":\"oracles\",\"type\":\"address[]\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"},{\"internalType\":\"uint32\",\"name\":\"maxPriceDeviationBps\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"minOracles\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"resumeAfterUpdates\",\"type\":\"uint32\"}],\"internalType\":\"structIPriceFeed.Market[]\",\"name\":\"markets\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"marketId\",\"type\":\"string\"}],\"name\":\"getPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"marketId\",\"type\":\"string\"}],\"name\":\"getRawPrices\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"oracle\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"internalType\":\"structIPriceFeed.PostedPrice[]\",\"name\":\"prices\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"marketId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"name\":\"postPrice\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// PriceFeedABI is the input ABI used to generate the binding from.
+// Deprecated: Use PriceFeedMetaData.ABI instead.
+var PriceFeedABI = PriceFeedMetaData.ABI
+
+// PriceFeed is an auto generated Go binding around an Ethereum contract.
+type PriceFeed struct {
+	PriceFeedCaller     // Read-only binding to the contract
+	PriceFeedTransactor // Write-only binding to the contract
+	PriceFeedFilterer   // Log filterer for contract events
+}
+
+// PriceFeedCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PriceFeedCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceFeedTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PriceFeedTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceFeedFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PriceFeedFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceFeedSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PriceFeedSession struct {
+	Contract     *PriceFeed        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PriceFeedCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PriceFeedCallerSession struct {
+	Contract *PriceFeedCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// PriceFeedTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PriceFeedTransactorSession struct {
+	Contract     *PriceFeedTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// PriceFeedRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PriceFeedRaw struct {
+	Contract *PriceFeed // Generic contract binding to access the raw methods on
+}
+
+// PriceFeedCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PriceFeedCallerRaw struct {
+	Contract *PriceFeedCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PriceFeedTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PriceFeedTransactorRaw struct {
+	Contract *PriceFeedTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPriceFeed creates a new instance of PriceFeed, bound to a specific deployed contract.
+func NewPriceFeed(address common.Address, backend bind.ContractBackend) (*PriceFeed, error) {
+	contract, err := bindPriceFeed(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceFeed{PriceFeedCaller: PriceFeedCaller{contract: contract}, PriceFeedTransactor: PriceFeedTransactor{contract: contract}, PriceFeedFilterer: PriceFeedFilterer{contract: contract}}, nil
+}
+
+// NewPriceFeedCaller creates a new read-only instance of PriceFeed, bound to a specific deployed contract.
+func NewPriceFeedCaller(address common.Address, caller bind.ContractCaller) (*PriceFeedCaller, error) {
+	contract, err := bindPriceFeed(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceFeedCaller{contract: contract}, nil
+}
+
+// NewPriceFeedTransactor creates a new write-only instance of PriceFeed, bound to a specific deployed contract.
+func NewPriceFeedTransactor(address common.Address, transactor bind.ContractTransactor) (*PriceFeedTransactor, error) {
+	contract, err := bindPriceFeed(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceFeedTransactor{contract: contract}, nil
+}
+
+// NewPriceFeedFilterer creates a new log filterer instance of PriceFeed, bound to a specific deployed contract.
+func NewPriceFeedFilterer(address common.Address, filterer bind.ContractFilterer) (*PriceFeedFilterer, error) {
+	contract, err := bindPriceFeed(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceFeedFilterer{contract: contract}, nil
+}
+
+// bindPriceFeed binds a generic wrapper to an already deployed contract.
+func bindPriceFeed(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(PriceFeedABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceFeed *PriceFeedRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PriceFeed.Contract.PriceFeedCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceFeed *PriceFeedRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceFeed.Contract.PriceFeedTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceFeed *PriceFeedRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceFeed.Contract.PriceFeedTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceFeed *PriceFeedCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PriceFeed.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceFeed *PriceFeedTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceFeed.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceFeed *PriceFeedTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceFeed.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetMarkets is a free data retrieval call binding the contract method 0xec2c9016.
+//
+// Solidity: function getMarkets() view returns((string,string,string,address[],bool,uint32,uint32,uint32)[] markets)
+func (_PriceFeed *PriceFeedCaller) GetMarkets(opts *bind.CallOpts) ([]IPriceFeedMarket, error) {
+	var out []interface{}
+	err := _PriceFeed.contract.Call(opts, &out, "getMarkets")
+
+	if err != nil {
+		return *new([]IPriceFeedMarket), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IPriceFeedMarket)).(*[]IPriceFeedMarket)
+
+	return out0, err
+
+}
+
+// GetMarkets is a free data retrieval call binding the contract method 0xec2c9016.
+//
+// Solidity: function getMarkets() view returns((string,string,string,address[],bool,uint32,uint32,uint32)[] markets)
+func (_PriceFeed *PriceFeedSession) GetMarkets() ([]IPriceFeedMarket, error) {
+	return _PriceFeed.Contract.GetMarkets(&_PriceFeed.CallOpts)
+}
+
+// GetMarkets is a free data retrieval call binding the contract method 0xec2c9016.
+//
+// Solidity: function getMarkets() view returns((string,string,string,address[],bool,uint32,uint32,uint32)[] markets)
+func (_PriceFeed *PriceFeedCallerSession) GetMarkets() ([]IPriceFeedMarket, error) {
+	return _PriceFeed.Contract.GetMarkets(&_PriceFeed.CallOpts)
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x524f3889.
+//
+// Solidity: function getPrice(string marketId) view returns(uint256 price)
+func (_PriceFeed *PriceFeedCaller) GetPrice(opts *bind.CallOpts, marketId string) (*big.Int, error) {
+	var out []interface{}
+	err := _PriceFeed.contract.Call(opts, &out, "getPrice", marketId)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x524f3889.
+//
+// Solidity: function getPrice(string marketId) view returns(uint256 price)
+func (_PriceFeed *PriceFeedSession) GetPrice(marketId string) (*big.Int, error) {
+	return _PriceFeed.Contract.GetPrice(&_PriceFeed.CallOpts, marketId)
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x524f3889.
+//
+// Solidity: function getPrice(string marketId) view returns(uint256 price)
+func (_PriceFeed *PriceFeedCallerSession) GetPrice(marketId string) (*big.Int, error) {
+	return _PriceFeed.Contract.GetPrice(&_PriceFeed.CallOpts, marketId)
+}
+
+// GetRawPrices is a free data retrieval call binding the contract method 0x03fbe58d.
+//
+// Solidity: function getRawPrices(string marketId) view returns((address,uint256,uint256)[] prices)
+func (_PriceFeed *PriceFeedCaller) GetRawPrices(opts *bind.CallOpts, marketId string) ([]IPriceFeedPostedPrice, error) {
+	var out []interface{}
+	err := _PriceFeed.contract.Call(opts, &out, "getRawPrices", marketId)
+
+	if err != nil {
+		return *new([]IPriceFeedPostedPrice), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IPriceFeedPostedPrice)).(*[]IPriceFeedPostedPrice)
+
+	return out0, err
+
+}
+
+// GetRawPrices is a free data retrieval call binding the contract method 0x03fbe58d.
+//
+// Solidity: function getRawPrices(string marketId) view returns((address,uint256,uint256)[] prices)
+func (_PriceFeed *PriceFeedSession) GetRawPrices(marketId string) ([]IPriceFeedPostedPrice, error) {
+	return _PriceFeed.Contract.GetRawPrices(&_PriceFeed.CallOpts, marketId)
+}
+
+// GetRawPrices is a free data retrieval call binding the contract method 0x03fbe58d.
+//
+// Solidity: function getRawPrices(string marketId) view returns((address,uint256,uint256)[] prices)
+func (_PriceFeed *PriceFeedCallerSession) GetRawPrices(marketId string) ([]IPriceFeedPostedPrice, error) {
+	return _PriceFeed.Contract.GetRawPrices(&_PriceFeed.CallOpts, marketId)
+}
+
+// PostPrice is a paid mutator transaction binding the contract method 0x00248aae.
+//
+// Solidity: function postPrice(string marketId, uint256 price, uint256 expiry) returns()
+func (_PriceFeed *PriceFeedTransactor) PostPrice(opts *bind.TransactOpts, marketId string, price *big.Int, expiry *big.Int) (*types.Transaction, error) {
+	return _PriceFeed.contract.Transact(opts, "postPrice", marketId, price, expiry)
+}
+
+// PostPrice is a paid mutator transaction binding the contract method 0x00248aae.
+//
+// Solidity: function postPrice(string marketId, uint256 price, uint256 expiry) returns()
+func (_PriceFeed *PriceFeedSession) PostPrice(marketId string, price *big.Int, expiry *big.Int) (*types.Transaction, error) {
+	return _PriceFeed.Contract.PostPrice(&_PriceFeed.TransactOpts, marketId, price, expiry)
+}
+
+// PostPrice is a paid mutator transaction binding the contract method 0x00248aae.
+//
+// Solidity: function postPrice(string marketId, uint256 price, uint256 expiry) returns()
+func (_PriceFeed *PriceFeedTransactorSession) PostPrice(marketId string, price *big.Int, expiry *big.Int) (*types.Transaction, error) {
+	return _PriceFeed.Contract.PostPrice(&_PriceFeed.TransactOpts, marketId, price, expiry)
+}
+
+// PriceFeedPricePostedIterator is returned from FilterPricePosted and is used to iterate over the raw logs and unpacked data for PricePosted events raised by the PriceFeed contract.
+type PriceFeedPricePostedIterator struct {
+	Event *PriceFeedPricePosted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PriceFeedPricePostedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PriceFeedPricePosted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PriceFeedPricePosted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PriceFeedPricePostedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PriceFeedPricePostedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PriceFeedPricePosted represents a PricePosted event raised by the PriceFeed contract.
+type PriceFeedPricePosted struct {
+	Oracle   common.Address
+	MarketId string
+	Price    *big.Int
+	Expiry   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPricePosted is a free log retrieval operation binding the contract event 0x2ae09809c4019a72708ca65e108d872da513f7413bf7cf509950c8d47f0e0140.
+//
+// Solidity: event PricePosted(address indexed oracle, string marketId, uint256 price, uint256 expiry)
+func (_PriceFeed *PriceFeedFilterer) FilterPricePosted(opts *bind.FilterOpts, oracle []common.Address) (*PriceFeedPricePostedIterator, error) {
+
+	var oracleRule []interface{}
+	for _, oracleItem := range oracle {
+		oracleRule = append(oracleRule, oracleItem)
+	}
+
+	logs, sub, err := _PriceFeed.contract.FilterLogs(opts, "PricePosted", oracleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceFeedPricePostedIterator{contract: _PriceFeed.contract, event: "PricePosted", logs: logs, sub: sub}, nil
+}
+
+// WatchPricePosted is a free log subscription operation binding the contract event 0x2ae09809c4019a72708ca65e108d872da513f7413bf7cf509950c8d47f0e0140.
+//
+// Solidity: event PricePosted(address indexed oracle, string marketId, uint256 price, uint256 expiry)
+func (_PriceFeed *PriceFeedFilterer) WatchPricePosted(opts *bind.WatchOpts, sink chan<- *PriceFeedPricePosted, oracle []common.Address) (event.Subscription, error) {
+
+	var oracleRule []interface{}
+	for _, oracleItem := range oracle {
+		oracleRule = append(oracleRule, oracleItem)
+	}
+
+	logs, sub, err := _PriceFeed.contract.WatchLogs(opts, "PricePosted", oracleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PriceFeedPricePosted)
+				if err := _PriceFeed.contract.UnpackLog(event, "PricePosted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePricePosted is a log parse operation binding the contract event 0x2ae09809c4019a72708ca65e108d872da513f7413bf7cf509950c8d47f0e0140.
+//
+// Solidity: event PricePosted(address indexed oracle, string marketId, uint256 price, uint256 expiry)
+func (_PriceFeed *PriceFeedFilterer) ParsePricePosted(log types.Log) (*PriceFeedPricePosted, error) {
+	event := new(PriceFeedPricePosted)
+	if err := _PriceFeed.contract.UnpackLog(event, "PricePosted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
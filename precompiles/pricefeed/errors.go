@@ -0,0 +1,5 @@
+package pricefeed
+
+const (
+	ErrInvalidExpiry = "expiry %s is not a unix timestamp between 1 and %d"
+)
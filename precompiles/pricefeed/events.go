@@ -0,0 +1,17 @@
+package pricefeed
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	PricePostedEvent = "PricePosted"
+)
+
+func (p *PriceFeedPrecompile) EmitPricePostedEvent(ctx sdk.Context, stateDB *statedb.StateDB, oracle common.Address, marketID string, price, expiry *big.Int) error {
+	return p.EmitEvent(ctx, stateDB, PricePostedEvent, oracle, marketID, price, expiry)
+}
@@ -0,0 +1,42 @@
+package pricefeed
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	pricefeedkeeper "github.com/0glabs/0g-chain/x/pricefeed/keeper"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001001"
+
+	PriceFeedFunctionGetMarkets   = "getMarkets"
+	PriceFeedFunctionGetPrice     = "getPrice"
+	PriceFeedFunctionGetRawPrices = "getRawPrices"
+	PriceFeedFunctionPostPrice    = "postPrice"
+)
+
+var _ vm.PrecompiledContract = &PriceFeedPrecompile{}
+
+type PriceFeedPrecompile struct {
+	*precopmiles_common.Precompile
+	pricefeedKeeper pricefeedkeeper.Keeper
+}
+
+func NewPriceFeedPrecompile(pricefeedKeeper pricefeedkeeper.Keeper) (*PriceFeedPrecompile, error) {
+	precompile, err := precopmiles_common.NewPrecompile(PrecompileAddress, PriceFeedABI)
+	if err != nil {
+		return nil, err
+	}
+	p := &PriceFeedPrecompile{
+		Precompile:      precompile,
+		pricefeedKeeper: pricefeedKeeper,
+	}
+	// queries
+	p.RegisterQuery(PriceFeedFunctionGetMarkets, 50000, p.GetMarkets)
+	p.RegisterQuery(PriceFeedFunctionGetPrice, 10000, p.GetPrice)
+	p.RegisterQuery(PriceFeedFunctionGetRawPrices, 50000, p.GetRawPrices)
+	// txs, the posted price is written through the keeper and would outlive a reverted calling frame, so
+	// oracles post their prices directly
+	p.RegisterTx(PriceFeedFunctionPostPrice, 50000, p.PostPrice).DirectCallOnly()
+	return p, nil
+}
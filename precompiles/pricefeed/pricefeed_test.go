@@ -0,0 +1,191 @@
+package pricefeed_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	pricefeedprecompile "github.com/0glabs/0g-chain/precompiles/pricefeed"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	pricefeedtypes "github.com/0glabs/0g-chain/x/pricefeed/types"
+)
+
+type PriceFeedTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	oracle common.Address
+	market pricefeedtypes.Market
+}
+
+func (suite *PriceFeedTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+	suite.SetupPrecompile(pricefeedprecompile.PrecompileAddress, pricefeedprecompile.PriceFeedABI)
+
+	suite.oracle = suite.Key1Addr.Address
+	suite.market = pricefeedtypes.Market{
+		MarketID:             "tstusd",
+		BaseAsset:            "tst",
+		QuoteAsset:           "usd",
+		Oracles:              []sdk.AccAddress{suite.oracle.Bytes(), suite.Addrs[0]},
+		Active:               true,
+		MaxPriceDeviationBps: 1000,
+		MinOracles:           1,
+		ResumeAfterUpdates:   2,
+	}
+	suite.App.GetPriceFeedKeeper().SetParams(suite.Ctx, pricefeedtypes.Params{
+		Markets: []pricefeedtypes.Market{suite.market},
+	})
+}
+
+// price returns a price as a fixed point number with 18 decimals
+func price(value string) *big.Int {
+	return sdk.MustNewDecFromStr(value).BigInt()
+}
+
+func (suite *PriceFeedTestSuite) expiry() *big.Int {
+	return big.NewInt(suite.Ctx.BlockTime().Add(time.Hour).Unix())
+}
+
+// updatePrice posts a price from the oracle and updates the current price of the market
+func (suite *PriceFeedTestSuite) updatePrice(value string) error {
+	suite.MustCall(pricefeedprecompile.PriceFeedFunctionPostPrice, suite.market.MarketID, price(value), suite.expiry())
+	return suite.App.GetPriceFeedKeeper().SetCurrentPrices(suite.Ctx, suite.market.MarketID)
+}
+
+func (suite *PriceFeedTestSuite) TestPostPrice() {
+	expiry := suite.expiry()
+	_, resp, err := suite.Call(pricefeedprecompile.PriceFeedFunctionPostPrice, suite.market.MarketID, price("1.5"), expiry)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Logs, 1)
+	suite.Require().Equal([]string{
+		suite.Abi.Events[pricefeedprecompile.PricePostedEvent].ID.Hex(),
+		common.BytesToHash(suite.oracle.Bytes()).Hex(),
+	}, resp.Logs[0].Topics)
+
+	prices := suite.App.GetPriceFeedKeeper().GetRawPrices(suite.Ctx, suite.market.MarketID)
+	suite.Require().Len(prices, 1)
+	suite.Require().Equal(sdk.AccAddress(suite.oracle.Bytes()), prices[0].OracleAddress)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.5"), prices[0].Price)
+	suite.Require().Equal(expiry.Int64(), prices[0].Expiry.Unix())
+}
+
+func (suite *PriceFeedTestSuite) TestPostPrice_NotOracle() {
+	other := common.BytesToAddress(suite.Key2.PubKey().Address())
+	_, _, err := suite.CallFrom(other, suite.Precompile, pricefeedprecompile.PriceFeedFunctionPostPrice, suite.market.MarketID, price("1.5"), suite.expiry())
+	suite.Require().ErrorContains(err, pricefeedtypes.ErrInvalidOracle.Error())
+
+	_, _, err = suite.Call(pricefeedprecompile.PriceFeedFunctionPostPrice, "unknown", price("1.5"), suite.expiry())
+	suite.Require().Error(err)
+
+	suite.Require().Empty(suite.App.GetPriceFeedKeeper().GetRawPrices(suite.Ctx, suite.market.MarketID))
+}
+
+func (suite *PriceFeedTestSuite) TestPostPrice_FromContract() {
+	// the calling frame reverts, a price posted through it would be kept, so contracts cannot post, even
+	// when they are oracles themselves
+	proxy := suite.DeployProxy(suite.Precompile, false)
+	suite.market.Oracles = append(suite.market.Oracles, proxy.Bytes())
+	suite.App.GetPriceFeedKeeper().SetParams(suite.Ctx, pricefeedtypes.Params{
+		Markets: []pricefeedtypes.Market{suite.market},
+	})
+	for _, from := range []common.Address{suite.oracle, common.BytesToAddress(suite.Key2.PubKey().Address())} {
+		_, _, err := suite.CallFrom(from, proxy, pricefeedprecompile.PriceFeedFunctionPostPrice, suite.market.MarketID, price("1.5"), suite.expiry())
+		suite.Require().EqualError(err, fmt.Sprintf(precopmiles_common.ErrDirectCallOnly, pricefeedprecompile.PriceFeedFunctionPostPrice))
+	}
+	suite.Require().Empty(suite.App.GetPriceFeedKeeper().GetRawPrices(suite.Ctx, suite.market.MarketID))
+}
+
+func (suite *PriceFeedTestSuite) TestPostPrice_Expiry() {
+	invalidExpiry := func(expiry *big.Int) string {
+		return fmt.Sprintf(pricefeedprecompile.ErrInvalidExpiry, expiry, pricefeedprecompile.MaxExpiry)
+	}
+	testCases := []struct {
+		name   string
+		expiry *big.Int
+		err    string
+	}{
+		{"zero", big.NewInt(0), invalidExpiry(big.NewInt(0))},
+		{"beyond the max expiry", big.NewInt(pricefeedprecompile.MaxExpiry + 1), invalidExpiry(big.NewInt(pricefeedprecompile.MaxExpiry + 1))},
+		{"beyond int64", new(big.Int).Lsh(big.NewInt(1), 64), invalidExpiry(new(big.Int).Lsh(big.NewInt(1), 64))},
+		{"expired", big.NewInt(suite.Ctx.BlockTime().Unix()), pricefeedtypes.ErrExpired.Error()},
+		{"max expiry", big.NewInt(pricefeedprecompile.MaxExpiry), ""},
+		{"in an hour", suite.expiry(), ""},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, _, err := suite.Call(pricefeedprecompile.PriceFeedFunctionPostPrice, suite.market.MarketID, price("1.5"), tc.expiry)
+			if tc.err != "" {
+				suite.Require().ErrorContains(err, tc.err)
+				return
+			}
+			suite.Require().NoError(err)
+			prices := suite.App.GetPriceFeedKeeper().GetRawPrices(suite.Ctx, suite.market.MarketID)
+			suite.Require().Len(prices, 1)
+			suite.Require().Equal(tc.expiry.Int64(), prices[0].Expiry.Unix())
+		})
+	}
+}
+
+func (suite *PriceFeedTestSuite) TestGetPrice() {
+	_, _, err := suite.Call(pricefeedprecompile.PriceFeedFunctionGetPrice, suite.market.MarketID)
+	suite.Require().ErrorContains(err, pricefeedtypes.ErrNoValidPrice.Error())
+
+	suite.Require().NoError(suite.updatePrice("1.5"))
+	out := suite.MustCall(pricefeedprecompile.PriceFeedFunctionGetPrice, suite.market.MarketID)
+	suite.Require().Equal(price("1.5"), out[0])
+}
+
+func (suite *PriceFeedTestSuite) TestGetPrice_HaltedMarket() {
+	suite.Require().NoError(suite.updatePrice("1.5"))
+
+	// a jump beyond the max deviation halts the market, the price query reverts rather than return it
+	suite.Require().ErrorIs(suite.updatePrice("3"), pricefeedtypes.ErrMarketHalted)
+	_, _, err := suite.Call(pricefeedprecompile.PriceFeedFunctionGetPrice, suite.market.MarketID)
+	suite.Require().ErrorContains(err, pricefeedtypes.ErrMarketHalted.Error())
+
+	// the market resumes after two updates within the max deviation of the last good price
+	suite.Require().ErrorIs(suite.updatePrice("1.55"), pricefeedtypes.ErrMarketHalted)
+	_, _, err = suite.Call(pricefeedprecompile.PriceFeedFunctionGetPrice, suite.market.MarketID)
+	suite.Require().ErrorContains(err, pricefeedtypes.ErrMarketHalted.Error())
+	suite.Require().NoError(suite.updatePrice("1.6"))
+	out := suite.MustCall(pricefeedprecompile.PriceFeedFunctionGetPrice, suite.market.MarketID)
+	suite.Require().Equal(price("1.6"), out[0])
+}
+
+func (suite *PriceFeedTestSuite) TestGetRawPrices() {
+	out := suite.MustCall(pricefeedprecompile.PriceFeedFunctionGetRawPrices, suite.market.MarketID)
+	suite.Require().Empty(out[0])
+
+	expiry := suite.expiry()
+	suite.MustCall(pricefeedprecompile.PriceFeedFunctionPostPrice, suite.market.MarketID, price("1.5"), expiry)
+	out = suite.MustCall(pricefeedprecompile.PriceFeedFunctionGetRawPrices, suite.market.MarketID)
+	suite.Require().Equal([]pricefeedprecompile.IPriceFeedPostedPrice{
+		{Oracle: suite.oracle, Price: price("1.5"), Expiry: expiry},
+	}, out[0])
+}
+
+func (suite *PriceFeedTestSuite) TestGetMarkets() {
+	out := suite.MustCall(pricefeedprecompile.PriceFeedFunctionGetMarkets)
+	suite.Require().Equal([]pricefeedprecompile.IPriceFeedMarket{
+		{
+			MarketId:             "tstusd",
+			BaseAsset:            "tst",
+			QuoteAsset:           "usd",
+			Oracles:              []common.Address{suite.oracle, common.BytesToAddress(suite.Addrs[0])},
+			Active:               true,
+			MaxPriceDeviationBps: 1000,
+			MinOracles:           1,
+			ResumeAfterUpdates:   2,
+		},
+	}, out[0])
+}
+
+func TestPriceFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(PriceFeedTestSuite))
+}
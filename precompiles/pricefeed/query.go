@@ -0,0 +1,43 @@
+package pricefeed
+
+import (
+	"fmt"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (p *PriceFeedPrecompile) GetMarkets(ctx sdk.Context, _ *vm.EVM, method *abi.Method, _ []interface{}) ([]byte, error) {
+	markets := p.pricefeedKeeper.GetMarkets(ctx)
+	result := make([]IPriceFeedMarket, len(markets))
+	for i, market := range markets {
+		result[i] = NewIPriceFeedMarket(market)
+	}
+	return method.Outputs.Pack(result)
+}
+
+func (p *PriceFeedPrecompile) GetPrice(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	// halted markets and markets without a valid price revert
+	price, err := p.pricefeedKeeper.GetCurrentPrice(ctx, args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(price.Price.BigInt())
+}
+
+func (p *PriceFeedPrecompile) GetRawPrices(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	prices := p.pricefeedKeeper.GetRawPrices(ctx, args[0].(string))
+	result := make([]IPriceFeedPostedPrice, len(prices))
+	for i, price := range prices {
+		result[i] = NewIPriceFeedPostedPrice(price)
+	}
+	return method.Outputs.Pack(result)
+}
@@ -0,0 +1,33 @@
+package pricefeed
+
+import (
+	"math/big"
+
+	pricefeedkeeper "github.com/0glabs/0g-chain/x/pricefeed/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// PostPrice posts a price on behalf of the transaction sender, which must be an oracle of the market.
+func (p *PriceFeedPrecompile) PostPrice(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgPostPrice(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = pricefeedkeeper.NewMsgServerImpl(p.pricefeedKeeper).PostPrice(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = p.EmitPricePostedEvent(ctx, stateDB, contract.Caller(), msg.MarketID, args[1].(*big.Int), args[2].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
@@ -0,0 +1,77 @@
+package pricefeed
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	pricefeedtypes "github.com/0glabs/0g-chain/x/pricefeed/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IPriceFeedMarket = struct {
+	MarketId             string           "json:\"marketId\""
+	BaseAsset            string           "json:\"baseAsset\""
+	QuoteAsset           string           "json:\"quoteAsset\""
+	Oracles              []common.Address "json:\"oracles\""
+	Active               bool             "json:\"active\""
+	MaxPriceDeviationBps uint32           "json:\"maxPriceDeviationBps\""
+	MinOracles           uint32           "json:\"minOracles\""
+	ResumeAfterUpdates   uint32           "json:\"resumeAfterUpdates\""
+}
+
+type IPriceFeedPostedPrice = struct {
+	Oracle common.Address "json:\"oracle\""
+	Price  *big.Int       "json:\"price\""
+	Expiry *big.Int       "json:\"expiry\""
+}
+
+func NewIPriceFeedMarket(market pricefeedtypes.Market) IPriceFeedMarket {
+	oracles := make([]common.Address, len(market.Oracles))
+	for i, oracle := range market.Oracles {
+		oracles[i] = common.BytesToAddress(oracle)
+	}
+	return IPriceFeedMarket{
+		MarketId:             market.MarketID,
+		BaseAsset:            market.BaseAsset,
+		QuoteAsset:           market.QuoteAsset,
+		Oracles:              oracles,
+		Active:               market.Active,
+		MaxPriceDeviationBps: market.MaxPriceDeviationBps,
+		MinOracles:           market.MinOracles,
+		ResumeAfterUpdates:   market.ResumeAfterUpdates,
+	}
+}
+
+func NewIPriceFeedPostedPrice(price pricefeedtypes.PostedPrice) IPriceFeedPostedPrice {
+	return IPriceFeedPostedPrice{
+		Oracle: common.BytesToAddress(price.OracleAddress),
+		Price:  price.Price.BigInt(),
+		Expiry: big.NewInt(price.Expiry.Unix()),
+	}
+}
+
+// MaxExpiry is the latest expiry that can be posted, 9999-12-31T23:59:59Z, the upper bound of protobuf
+// timestamps.
+const MaxExpiry int64 = 253402300799
+
+// NewMsgPostPrice converts the postPrice arguments, prices are fixed point numbers with 18 decimals and
+// expiries unix timestamps in seconds.
+func NewMsgPostPrice(args []interface{}, sender common.Address) (*pricefeedtypes.MsgPostPrice, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	expiry := args[2].(*big.Int)
+	if !expiry.IsInt64() || expiry.Int64() <= 0 || expiry.Int64() > MaxExpiry {
+		return nil, fmt.Errorf(ErrInvalidExpiry, expiry, MaxExpiry)
+	}
+
+	return pricefeedtypes.NewMsgPostPrice(
+		sdk.AccAddress(sender.Bytes()).String(),
+		args[0].(string),
+		sdk.NewDecFromBigIntWithPrec(args[1].(*big.Int), sdk.Precision),
+		time.Unix(expiry.Int64(), 0).UTC(),
+	), nil
+}
@@ -0,0 +1,32 @@
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	DelegateEvent                 = "Delegate"
+	UndelegateEvent               = "Undelegate"
+	RedelegateEvent               = "Redelegate"
+	WithdrawDelegatorRewardsEvent = "WithdrawDelegatorRewards"
+)
+
+func (s *StakingPrecompile) EmitDelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator string, amount *big.Int) error {
+	return s.EmitEvent(ctx, stateDB, DelegateEvent, delegator, validator, amount)
+}
+
+func (s *StakingPrecompile) EmitUndelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator string, amount, completionTime *big.Int) error {
+	return s.EmitEvent(ctx, stateDB, UndelegateEvent, delegator, validator, amount, completionTime)
+}
+
+func (s *StakingPrecompile) EmitRedelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validatorSrc, validatorDst string, amount, completionTime *big.Int) error {
+	return s.EmitEvent(ctx, stateDB, RedelegateEvent, delegator, validatorSrc, validatorDst, amount, completionTime)
+}
+
+func (s *StakingPrecompile) EmitWithdrawDelegatorRewardsEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator string, amount []IStakingCoin) error {
+	return s.EmitEvent(ctx, stateDB, WithdrawDelegatorRewardsEvent, delegator, validator, amount)
+}
@@ -0,0 +1,46 @@
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (s *StakingPrecompile) Delegation(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	delegator, valAddr, err := NewDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	validator, found := s.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	delegation, found := s.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return method.Outputs.Pack(new(big.Int), new(big.Int))
+	}
+	balance := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance.BigInt())
+}
+
+func (s *StakingPrecompile) DelegationRewards(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	delegator, valAddr, err := NewDelegationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	validator := s.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	delegation := s.stakingKeeper.Delegation(ctx, delegator, valAddr)
+	if delegation == nil {
+		return nil, stakingtypes.ErrNoDelegation
+	}
+	// calculating the rewards writes to the store, the writes of queries are discarded
+	endingPeriod := s.distrKeeper.IncrementValidatorPeriod(ctx, validator)
+	rewards, _ := s.distrKeeper.CalculateDelegationRewards(ctx, validator, delegation, endingPeriod).TruncateDecimal()
+	return method.Outputs.Pack(NewIStakingCoins(rewards))
+}
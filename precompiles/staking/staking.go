@@ -0,0 +1,49 @@
+package staking
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001002"
+
+	StakingFunctionDelegate                 = "delegate"
+	StakingFunctionUndelegate               = "undelegate"
+	StakingFunctionRedelegate               = "redelegate"
+	StakingFunctionWithdrawDelegatorRewards = "withdrawDelegatorRewards"
+	StakingFunctionDelegation               = "delegation"
+	StakingFunctionDelegationRewards        = "delegationRewards"
+)
+
+var _ vm.PrecompiledContract = &StakingPrecompile{}
+
+type StakingPrecompile struct {
+	*precopmiles_common.Precompile
+	// stakingKeeper is a reference as the app sets the staking hooks after the precompiles are created
+	stakingKeeper *stakingkeeper.Keeper
+	distrKeeper   distrkeeper.Keeper
+}
+
+func NewStakingPrecompile(stakingKeeper *stakingkeeper.Keeper, distrKeeper distrkeeper.Keeper) (*StakingPrecompile, error) {
+	precompile, err := precopmiles_common.NewPrecompile(PrecompileAddress, StakingABI)
+	if err != nil {
+		return nil, err
+	}
+	s := &StakingPrecompile{
+		Precompile:    precompile,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+	}
+	// queries
+	s.RegisterQuery(StakingFunctionDelegation, 10000, s.Delegation)
+	s.RegisterQuery(StakingFunctionDelegationRewards, 50000, s.DelegationRewards)
+	// txs move the funds of the delegator, which is the caller and must be the transaction sender
+	s.RegisterTx(StakingFunctionDelegate, 200000, s.Delegate).DirectCallOnly()
+	s.RegisterTx(StakingFunctionUndelegate, 200000, s.Undelegate).DirectCallOnly()
+	s.RegisterTx(StakingFunctionRedelegate, 250000, s.Redelegate).DirectCallOnly()
+	s.RegisterTx(StakingFunctionWithdrawDelegatorRewards, 150000, s.WithdrawDelegatorRewards).DirectCallOnly()
+	return s, nil
+}
@@ -0,0 +1,188 @@
+package staking_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/chaincfg"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	stakingprecompile "github.com/0glabs/0g-chain/precompiles/staking"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+)
+
+type StakingTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	validator  sdk.ValAddress
+	validator2 sdk.ValAddress
+}
+
+func (suite *StakingTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+	suite.SetupPrecompile(stakingprecompile.PrecompileAddress, stakingprecompile.StakingABI)
+
+	suite.validator = suite.createValidator(suite.Addrs[0])
+	suite.validator2 = suite.createValidator(suite.Addrs[1])
+}
+
+func (suite *StakingTestSuite) createValidator(operator sdk.AccAddress) sdk.ValAddress {
+	selfDelegation := sdkmath.NewInt(1e6)
+	suite.FundAccountWithZgChain(operator, sdk.NewCoins(sdk.NewCoin(chaincfg.GasDenom, selfDelegation)))
+	valAddr := sdk.ValAddress(operator)
+	err := suite.App.CreateNewUnbondedValidator(suite.Ctx, valAddr, selfDelegation)
+	suite.Require().NoError(err)
+	return valAddr
+}
+
+func (suite *StakingTestSuite) bondBalance() sdkmath.Int {
+	return suite.BankKeeper.GetBalance(suite.Ctx, suite.Key1Addr.Bytes(), chaincfg.GasDenom).Amount
+}
+
+func (suite *StakingTestSuite) TestDelegate() {
+	before := suite.bondBalance()
+
+	amount := big.NewInt(1000)
+	_, resp, err := suite.Call(stakingprecompile.StakingFunctionDelegate, suite.validator.String(), amount)
+	suite.Require().NoError(err)
+
+	// the bank change made by the precompile is not overwritten by the StateDB
+	suite.Require().Equal(before.SubRaw(1000), suite.bondBalance())
+
+	delegation, found := suite.App.GetStakingKeeper().GetDelegation(suite.Ctx, suite.Key1Addr.Bytes(), suite.validator)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1000), delegation.Shares)
+
+	suite.Require().Len(resp.Logs, 1)
+	event := suite.Abi.Events[stakingprecompile.DelegateEvent]
+	suite.Require().Equal(event.ID.String(), resp.Logs[0].Topics[0])
+
+	out := suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().Equal(sdk.NewDec(1000).BigInt(), out[0])
+	suite.Require().Equal(amount, out[1])
+}
+
+func (suite *StakingTestSuite) TestDelegate_InvalidValidator() {
+	before := suite.bondBalance()
+
+	_, _, err := suite.Call(stakingprecompile.StakingFunctionDelegate, sdk.ValAddress(suite.Addrs[2]).String(), big.NewInt(1000))
+	suite.Require().EqualError(err, stakingtypes.ErrNoValidatorFound.Error())
+	suite.Require().Equal(before, suite.bondBalance())
+}
+
+// TestDelegate_FromContract checks an intermediate contract called by the user cannot move the stake of the
+// transaction sender through the precompile.
+func (suite *StakingTestSuite) TestDelegate_FromContract() {
+	suite.MustCall(stakingprecompile.StakingFunctionDelegate, suite.validator.String(), big.NewInt(1000))
+	before := suite.bondBalance()
+	proxy := suite.DeployProxy(suite.Precompile, false)
+
+	testCases := []struct {
+		method string
+		args   []interface{}
+	}{
+		{stakingprecompile.StakingFunctionDelegate, []interface{}{suite.validator.String(), big.NewInt(1000)}},
+		{stakingprecompile.StakingFunctionUndelegate, []interface{}{suite.validator.String(), big.NewInt(400)}},
+		{stakingprecompile.StakingFunctionRedelegate, []interface{}{suite.validator.String(), suite.validator2.String(), big.NewInt(400)}},
+		{stakingprecompile.StakingFunctionWithdrawDelegatorRewards, []interface{}{suite.validator.String()}},
+	}
+	for _, tc := range testCases {
+		_, _, err := suite.CallFrom(suite.Key1Addr.Address, proxy, tc.method, tc.args...)
+		suite.Require().EqualError(err, fmt.Sprintf(precopmiles_common.ErrDirectCallOnly, tc.method), tc.method)
+	}
+
+	suite.Require().Equal(before, suite.bondBalance())
+	out := suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().Equal(big.NewInt(1000), out[1])
+	out = suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator2.String())
+	suite.Require().Zero(out[1].(*big.Int).Sign())
+	_, found := suite.App.GetStakingKeeper().GetUnbondingDelegation(suite.Ctx, suite.Key1Addr.Bytes(), suite.validator)
+	suite.Require().False(found)
+}
+
+func (suite *StakingTestSuite) TestDelegate_StaticCall() {
+	proxy := suite.DeployProxy(suite.Precompile, true)
+
+	// the write protection error of the precompile reverts the proxy without a reason
+	_, _, err := suite.CallFrom(suite.Key1Addr.Address, proxy, stakingprecompile.StakingFunctionDelegate, suite.validator.String(), big.NewInt(1000))
+	suite.Require().EqualError(err, vm.ErrExecutionReverted.Error())
+
+	// queries can be called by contracts
+	out, _, err := suite.CallFrom(suite.Key1Addr.Address, proxy, stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().NoError(err)
+	suite.Require().Zero(out[1].(*big.Int).Sign())
+}
+
+func (suite *StakingTestSuite) TestUndelegate() {
+	suite.MustCall(stakingprecompile.StakingFunctionDelegate, suite.validator.String(), big.NewInt(1000))
+
+	out := suite.MustCall(stakingprecompile.StakingFunctionUndelegate, suite.validator.String(), big.NewInt(400))
+	completionTime := suite.Ctx.BlockTime().Add(suite.App.GetStakingKeeper().UnbondingTime(suite.Ctx))
+	suite.Require().Equal(completionTime.Unix(), out[0].(*big.Int).Int64())
+
+	unbonding, found := suite.App.GetStakingKeeper().GetUnbondingDelegation(suite.Ctx, suite.Key1Addr.Bytes(), suite.validator)
+	suite.Require().True(found)
+	suite.Require().Len(unbonding.Entries, 1)
+	suite.Require().Equal(sdkmath.NewInt(400), unbonding.Entries[0].Balance)
+
+	out = suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().Equal(big.NewInt(600), out[1])
+}
+
+func (suite *StakingTestSuite) TestRedelegate() {
+	suite.MustCall(stakingprecompile.StakingFunctionDelegate, suite.validator.String(), big.NewInt(1000))
+	before := suite.bondBalance()
+
+	suite.MustCall(stakingprecompile.StakingFunctionRedelegate, suite.validator.String(), suite.validator2.String(), big.NewInt(300))
+	suite.Require().Equal(before, suite.bondBalance())
+
+	out := suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().Equal(big.NewInt(700), out[1])
+	out = suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator2.String())
+	suite.Require().Equal(big.NewInt(300), out[1])
+}
+
+func (suite *StakingTestSuite) TestWithdrawDelegatorRewards() {
+	suite.MustCall(stakingprecompile.StakingFunctionDelegate, suite.validator.String(), big.NewInt(1e6))
+	// delegations do not earn rewards in the block they start
+	suite.Commit()
+
+	// the delegator holds half of the shares of the validator
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(chaincfg.GasDenom, 2000))
+	err := suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, rewards)
+	suite.Require().NoError(err)
+	validator := suite.App.GetStakingKeeper().Validator(suite.Ctx, suite.validator)
+	suite.App.GetDistrKeeper().AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+	out := suite.MustCall(stakingprecompile.StakingFunctionDelegationRewards, suite.Key1Addr.Address, suite.validator.String())
+	expected := []stakingprecompile.IStakingCoin{{Denom: chaincfg.GasDenom, Amount: big.NewInt(1000)}}
+	suite.Require().Equal(expected, out[0])
+
+	before := suite.bondBalance()
+	out = suite.MustCall(stakingprecompile.StakingFunctionWithdrawDelegatorRewards, suite.validator.String())
+	suite.Require().Equal(expected, out[0])
+	suite.Require().Equal(before.AddRaw(1000), suite.bondBalance())
+
+	out = suite.MustCall(stakingprecompile.StakingFunctionDelegationRewards, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().Empty(out[0])
+}
+
+func (suite *StakingTestSuite) TestDelegation_NotFound() {
+	out := suite.MustCall(stakingprecompile.StakingFunctionDelegation, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().Zero(out[0].(*big.Int).Sign())
+	suite.Require().Zero(out[1].(*big.Int).Sign())
+
+	_, _, err := suite.Call(stakingprecompile.StakingFunctionDelegationRewards, suite.Key1Addr.Address, suite.validator.String())
+	suite.Require().EqualError(err, stakingtypes.ErrNoDelegation.Error())
+}
+
+func TestStakingTestSuite(t *testing.T) {
+	suite.Run(t, new(StakingTestSuite))
+}
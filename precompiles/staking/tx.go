@@ -0,0 +1,132 @@
+package staking
+
+import (
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+func (s *StakingPrecompile) Delegate(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgDelegate(args, contract.Caller(), s.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	err = precopmiles_common.SyncBalances(ctx, stateDB, []common.Address{contract.Caller()}, func() error {
+		_, err := stakingkeeper.NewMsgServerImpl(*s.stakingKeeper).Delegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = s.EmitDelegateEvent(ctx, stateDB, contract.Caller(), msg.ValidatorAddress, msg.Amount.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (s *StakingPrecompile) Undelegate(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgUndelegate(args, contract.Caller(), s.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute, undelegating withdraws the pending rewards
+	var completionTime *big.Int
+	err = precopmiles_common.SyncBalances(ctx, stateDB, s.rewardRecipients(ctx, contract.Caller()), func() error {
+		response, err := stakingkeeper.NewMsgServerImpl(*s.stakingKeeper).Undelegate(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		completionTime = big.NewInt(response.CompletionTime.Unix())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = s.EmitUndelegateEvent(ctx, stateDB, contract.Caller(), msg.ValidatorAddress, msg.Amount.Amount.BigInt(), completionTime)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+func (s *StakingPrecompile) Redelegate(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgBeginRedelegate(args, contract.Caller(), s.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute, redelegating withdraws the pending rewards of both validators
+	var completionTime *big.Int
+	err = precopmiles_common.SyncBalances(ctx, stateDB, s.rewardRecipients(ctx, contract.Caller()), func() error {
+		response, err := stakingkeeper.NewMsgServerImpl(*s.stakingKeeper).BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		completionTime = big.NewInt(response.CompletionTime.Unix())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = s.EmitRedelegateEvent(ctx, stateDB, contract.Caller(), msg.ValidatorSrcAddress, msg.ValidatorDstAddress, msg.Amount.Amount.BigInt(), completionTime)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+func (s *StakingPrecompile) WithdrawDelegatorRewards(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgWithdrawDelegatorReward(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// execute
+	var amount sdk.Coins
+	err = precopmiles_common.SyncBalances(ctx, stateDB, s.rewardRecipients(ctx, contract.Caller()), func() error {
+		response, err := distrkeeper.NewMsgServerImpl(s.distrKeeper).WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		amount = response.Amount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	rewards := NewIStakingCoins(amount)
+	err = s.EmitWithdrawDelegatorRewardsEvent(ctx, stateDB, contract.Caller(), msg.ValidatorAddress, rewards)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(rewards)
+}
+
+// rewardRecipients returns the delegator and the address its rewards are withdrawn to
+func (s *StakingPrecompile) rewardRecipients(ctx sdk.Context, delegator common.Address) []common.Address {
+	withdrawAddr := s.distrKeeper.GetDelegatorWithdrawAddr(ctx, ToAccAddress(delegator))
+	return []common.Address{delegator, common.BytesToAddress(withdrawAddr)}
+}
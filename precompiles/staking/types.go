@@ -0,0 +1,88 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IStakingCoin = struct {
+	Denom  string   "json:\"denom\""
+	Amount *big.Int "json:\"amount\""
+}
+
+func NewIStakingCoins(coins sdk.Coins) []IStakingCoin {
+	result := make([]IStakingCoin, len(coins))
+	for i, coin := range coins {
+		result[i] = IStakingCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		}
+	}
+	return result
+}
+
+// ToAccAddress returns the cosmos address of an EVM address
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+func NewMsgDelegate(args []interface{}, delegator common.Address, denom string) (*stakingtypes.MsgDelegate, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	return &stakingtypes.MsgDelegate{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: args[0].(string),
+		Amount:           sdk.NewCoin(denom, sdk.NewIntFromBigInt(args[1].(*big.Int))),
+	}, nil
+}
+
+func NewMsgUndelegate(args []interface{}, delegator common.Address, denom string) (*stakingtypes.MsgUndelegate, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	return &stakingtypes.MsgUndelegate{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: args[0].(string),
+		Amount:           sdk.NewCoin(denom, sdk.NewIntFromBigInt(args[1].(*big.Int))),
+	}, nil
+}
+
+func NewMsgBeginRedelegate(args []interface{}, delegator common.Address, denom string) (*stakingtypes.MsgBeginRedelegate, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	return &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    ToAccAddress(delegator).String(),
+		ValidatorSrcAddress: args[0].(string),
+		ValidatorDstAddress: args[1].(string),
+		Amount:              sdk.NewCoin(denom, sdk.NewIntFromBigInt(args[2].(*big.Int))),
+	}, nil
+}
+
+func NewMsgWithdrawDelegatorReward(args []interface{}, delegator common.Address) (*distrtypes.MsgWithdrawDelegatorReward, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	return &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: args[0].(string),
+	}, nil
+}
+
+func NewDelegationArgs(args []interface{}) (sdk.AccAddress, sdk.ValAddress, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	valAddr, err := sdk.ValAddressFromBech32(args[1].(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	return ToAccAddress(args[0].(common.Address)), valAddr, nil
+}
@@ -0,0 +1,152 @@
+package testutil
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	evmutiltestutil "github.com/0glabs/0g-chain/x/evmutil/testutil"
+)
+
+// CallGasLimit is the gas limit of the transactions sent by the suite
+const CallGasLimit uint64 = 10_000_000
+
+// PrecompileTestSuite calls a precompile through the EVM of the test app, either directly from an account or
+// through a proxy contract.
+type PrecompileTestSuite struct {
+	evmutiltestutil.Suite
+
+	Abi        abi.ABI
+	Precompile common.Address
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	// report the gas actually used by the calls rather than the minimum share of the gas limit
+	feeMarketKeeper := suite.App.GetFeeMarketKeeper()
+	params := feeMarketKeeper.GetParams(suite.Ctx)
+	params.MinGasMultiplier = sdk.ZeroDec()
+	suite.Require().NoError(feeMarketKeeper.SetParams(suite.Ctx, params))
+}
+
+// SetupPrecompile sets the address and the ABI of the precompile under test, it is called after SetupTest.
+func (suite *PrecompileTestSuite) SetupPrecompile(address string, abiJSON string) {
+	var err error
+	suite.Abi, err = abi.JSON(strings.NewReader(abiJSON))
+	suite.Require().NoError(err)
+	suite.Precompile = common.HexToAddress(address)
+}
+
+// ApplyMessage executes data against the contract to, or creates a contract if to is nil. Unlike a
+// transaction it does not charge fees or check the nonce, the nonce only sets the address of created contracts.
+func (suite *PrecompileTestSuite) ApplyMessage(from common.Address, to *common.Address, data []byte) *evmtypes.MsgEthereumTxResponse {
+	nonce, err := suite.AccountKeeper.GetSequence(suite.Ctx, from.Bytes())
+	suite.Require().NoError(err)
+	msg := ethtypes.NewMessage(
+		from,
+		to,
+		nonce,
+		big.NewInt(0), // amount
+		CallGasLimit,  // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{},
+		false, // checkNonce
+	)
+	res, err := suite.App.GetEvmKeeper().ApplyMessage(suite.Ctx, msg, evmtypes.NewNoOpTracer(), true)
+	suite.Require().NoError(err)
+	return res
+}
+
+// CallFrom calls a method of the precompile ABI on the contract to, which is the precompile or a proxy of it.
+// It returns the unpacked outputs, or the revert reason as the error if the call fails.
+func (suite *PrecompileTestSuite) CallFrom(from common.Address, to common.Address, method string, args ...interface{}) ([]interface{}, *evmtypes.MsgEthereumTxResponse, error) {
+	data, err := suite.Abi.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	res := suite.ApplyMessage(from, &to, data)
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+				return nil, res, errors.New(reason)
+			}
+		}
+		return nil, res, errors.New(res.VmError)
+	}
+	out, err := suite.Abi.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out, res, nil
+}
+
+// Call calls a method of the precompile from Key1Addr.
+func (suite *PrecompileTestSuite) Call(method string, args ...interface{}) ([]interface{}, *evmtypes.MsgEthereumTxResponse, error) {
+	return suite.CallFrom(suite.Key1Addr.Address, suite.Precompile, method, args...)
+}
+
+// MustCall calls a method of the precompile from Key1Addr and requires it to succeed.
+func (suite *PrecompileTestSuite) MustCall(method string, args ...interface{}) []interface{} {
+	out, _, err := suite.Call(method, args...)
+	suite.Require().NoError(err)
+	return out
+}
+
+// DeployProxy deploys a contract which forwards its calldata to target and returns or reverts with the
+// result of the call. With static set the call is a STATICCALL.
+func (suite *PrecompileTestSuite) DeployProxy(target common.Address, static bool) common.Address {
+	from := suite.Key1Addr.Address
+	nonce, err := suite.AccountKeeper.GetSequence(suite.Ctx, from.Bytes())
+	suite.Require().NoError(err)
+
+	res := suite.ApplyMessage(from, nil, proxyInitCode(target, static))
+	suite.Require().False(res.Failed(), res.VmError)
+	return crypto.CreateAddress(from, nonce)
+}
+
+// proxyInitCode returns the creation code of a proxy contract, the runtime code is
+//
+//	calldatacopy(0, 0, calldatasize())
+//	success := call(gas(), target, 0, 0, calldatasize(), 0, 0) // staticcall without the value
+//	returndatacopy(0, 0, returndatasize())
+//	if success { return(0, returndatasize()) }
+//	revert(0, returndatasize())
+func proxyInitCode(target common.Address, static bool) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0,
+	}
+	if !static {
+		code = append(code, byte(vm.PUSH1), 0)
+	}
+	code = append(code, byte(vm.PUSH20))
+	code = append(code, target.Bytes()...)
+	code = append(code, byte(vm.GAS))
+	if static {
+		code = append(code, byte(vm.STATICCALL))
+	} else {
+		code = append(code, byte(vm.CALL))
+	}
+	code = append(code, byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY))
+	// jump over the revert when the call succeeded
+	dest := len(code) + 7
+	code = append(code, byte(vm.PUSH1), byte(dest), byte(vm.JUMPI))
+	code = append(code, byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT))
+	code = append(code, byte(vm.JUMPDEST), byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN))
+
+	// copy the runtime code which follows the init code to memory and return it
+	initCode := []byte{
+		byte(vm.PUSH1), byte(len(code)), byte(vm.DUP1), byte(vm.PUSH1), 11, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	return append(initCode, code...)
+}
@@ -16,5 +16,6 @@ import (
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	k.SyncCouncilCommittees(ctx)
 	k.ProcessProposals(ctx)
 }
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/committee/testutil"
+	"github.com/0glabs/0g-chain/x/committee/types"
+	counciltypes "github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+// setCouncil stores a new current council with the given members
+func (suite *keeperTestSuite) setCouncil(id uint64, members ...sdk.AccAddress) {
+	councilKeeper := suite.App.CouncilKeeper
+	council := counciltypes.Council{ID: id, Members: make([]sdk.ValAddress, len(members))}
+	for i, member := range members {
+		council.Members[i] = sdk.ValAddress(member)
+	}
+	councilKeeper.SetCouncil(suite.Ctx, council)
+	councilKeeper.SetCurrentCouncilID(suite.Ctx, id)
+}
+
+func (suite *keeperTestSuite) TestSyncCouncilCommittees() {
+	addrs := suite.Addresses
+	councilCom := types.MustNewCouncilCommittee(
+		1,
+		"This council committee is for testing.",
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+	)
+	memberCom := mustNewTestMemberCommittee(addrs[5:7])
+	suite.Keeper.SetCommittee(suite.Ctx, councilCom)
+	suite.Keeper.SetCommittee(suite.Ctx, memberCom)
+	suite.Keeper.SetNextProposalID(suite.Ctx, types.DefaultNextProposalID)
+
+	// no council elected yet
+	suite.Keeper.SyncCouncilCommittees(suite.Ctx)
+	com, found := suite.Keeper.GetCommittee(suite.Ctx, councilCom.ID)
+	suite.Require().True(found)
+	suite.Require().Empty(com.GetMembers())
+	_, err := suite.Keeper.SubmitProposal(suite.Ctx, addrs[0], councilCom.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.setCouncil(1, addrs[0], addrs[1])
+	suite.Keeper.SyncCouncilCommittees(suite.Ctx)
+	com, found = suite.Keeper.GetCommittee(suite.Ctx, councilCom.ID)
+	suite.Require().True(found)
+	suite.Require().Equal([]sdk.AccAddress{addrs[0], addrs[1]}, com.GetMembers())
+
+	proposalID, err := suite.Keeper.SubmitProposal(suite.Ctx, addrs[0], councilCom.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[1], types.VOTE_TYPE_YES))
+	suite.Require().ErrorIs(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[1], types.VOTE_TYPE_NO), types.ErrInvalidVoteType)
+	suite.Require().ErrorIs(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[2], types.VOTE_TYPE_YES), sdkerrors.ErrUnauthorized)
+
+	// the council rotates, the votes of the former member no longer count
+	suite.setCouncil(2, addrs[1], addrs[2], addrs[3])
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Keeper.SyncCouncilCommittees(suite.Ctx)
+	com, found = suite.Keeper.GetCommittee(suite.Ctx, councilCom.ID)
+	suite.Require().True(found)
+	suite.Require().Equal([]sdk.AccAddress{addrs[1], addrs[2], addrs[3]}, com.GetMembers())
+	suite.Require().NoError(app.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCommitteeMembersUpdate,
+		sdk.NewAttribute(types.AttributeKeyCommitteeID, "1"),
+		sdk.NewAttribute(types.AttributeKeyMembers, addrs[1].String()+","+addrs[2].String()+","+addrs[3].String()),
+	)))
+
+	_, found = suite.Keeper.GetVote(suite.Ctx, proposalID, addrs[0])
+	suite.Require().False(found)
+	tally, found := suite.Keeper.GetProposalTallyResponse(suite.Ctx, proposalID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1), tally.CurrentVotes)
+	suite.Require().Equal(sdk.NewDec(3), tally.PossibleVotes)
+	suite.Require().False(suite.Keeper.GetProposalResult(suite.Ctx, proposalID, com))
+
+	suite.Require().ErrorIs(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[0], types.VOTE_TYPE_YES), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[2], types.VOTE_TYPE_YES))
+	suite.Require().True(suite.Keeper.GetProposalResult(suite.Ctx, proposalID, com))
+
+	// other committees keep their members, and unchanged councils emit no update
+	other, found := suite.Keeper.GetCommittee(suite.Ctx, memberCom.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(memberCom.GetMembers(), other.GetMembers())
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Keeper.SyncCouncilCommittees(suite.Ctx)
+	suite.Require().Empty(suite.Ctx.EventManager().Events())
+}
+
+func (suite *keeperTestSuite) TestSyncCouncilCommittees_EmptyCouncil() {
+	addrs := suite.Addresses
+	councilCom := types.MustNewCouncilCommittee(
+		1,
+		"This council committee is for testing.",
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	suite.Keeper.SetCommittee(suite.Ctx, councilCom)
+	suite.Keeper.SetNextProposalID(suite.Ctx, types.DefaultNextProposalID)
+
+	suite.setCouncil(1, addrs[0], addrs[1])
+	suite.Keeper.SyncCouncilCommittees(suite.Ctx)
+	proposalID, err := suite.Keeper.SubmitProposal(suite.Ctx, addrs[0], councilCom.ID, govv1beta1.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.AddVote(suite.Ctx, proposalID, addrs[0], types.VOTE_TYPE_YES))
+
+	// the council is left without members, the open proposal cannot pass without votes
+	suite.setCouncil(2)
+	suite.Keeper.SyncCouncilCommittees(suite.Ctx)
+	com, found := suite.Keeper.GetCommittee(suite.Ctx, councilCom.ID)
+	suite.Require().True(found)
+	suite.Require().Empty(com.GetMembers())
+	suite.Require().Empty(suite.Keeper.GetVotesByProposal(suite.Ctx, proposalID))
+	suite.Require().False(suite.Keeper.GetProposalResult(suite.Ctx, proposalID, com))
+
+	// nor is it enacted when the proposals are processed
+	suite.Keeper.ProcessProposals(suite.Ctx)
+	_, found = suite.Keeper.GetProposal(suite.Ctx, proposalID)
+	suite.Require().True(found)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -20,13 +22,14 @@ type Keeper struct {
 	paramKeeper   types.ParamKeeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	councilKeeper types.CouncilKeeper
 
 	// Proposal router
 	router govv1beta1.Router
 }
 
 func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router,
-	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper,
+	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper, ck types.CouncilKeeper,
 ) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
@@ -38,6 +41,7 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.
 		paramKeeper:   paramKeeper,
 		accountKeeper: ak,
 		bankKeeper:    sk,
+		councilKeeper: ck,
 		router:        router,
 	}
 }
@@ -104,6 +108,59 @@ func (k Keeper) GetCommittees(ctx sdk.Context) types.Committees {
 	return results
 }
 
+// SyncCouncilCommittees sets the members of council committees to the members of the current
+// council. Votes of former members on open proposals are removed, as they no longer hold the
+// powers of the committee.
+func (k Keeper) SyncCouncilCommittees(ctx sdk.Context) {
+	councilMembers := k.councilKeeper.GetCurrentMembers(ctx)
+	members := make([]sdk.AccAddress, len(councilMembers))
+	current := make(map[string]bool, len(councilMembers))
+	for i, member := range councilMembers {
+		members[i] = sdk.AccAddress(member)
+		current[members[i].String()] = true
+	}
+
+	for _, committee := range k.GetCommittees(ctx) {
+		com, ok := committee.(*types.CouncilCommittee)
+		if !ok || equalMembers(com.GetMembers(), members) {
+			continue
+		}
+		for _, proposal := range k.GetProposalsByCommittee(ctx, com.ID) {
+			for _, vote := range k.GetVotesByProposal(ctx, proposal.ID) {
+				if !current[vote.Voter.String()] {
+					k.DeleteVote(ctx, proposal.ID, vote.Voter)
+				}
+			}
+		}
+		com.SetMembers(members)
+		k.SetCommittee(ctx, com)
+
+		addresses := make([]string, len(members))
+		for i, member := range members {
+			addresses[i] = member.String()
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommitteeMembersUpdate,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID)),
+				sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(addresses, ",")),
+			),
+		)
+	}
+}
+
+func equalMembers(a, b []sdk.AccAddress) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // ------------------------------------------
 //				Proposals
 // ------------------------------------------
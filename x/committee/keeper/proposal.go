@@ -64,7 +64,8 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
 
-	if _, ok := com.(*types.MemberCommittee); ok {
+	switch com.(type) {
+	case *types.MemberCommittee, *types.CouncilCommittee:
 		if !com.HasMember(voter) {
 			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
 		}
@@ -151,7 +152,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	switch com := committee.(type) {
-	case *types.MemberCommittee:
+	case *types.MemberCommittee, *types.CouncilCommittee:
 		return k.GetMemberCommitteeProposalResult(ctx, proposalID, com)
 	case *types.TokenCommittee:
 		return k.GetTokenCommitteeProposalResult(ctx, proposalID, com)
@@ -162,6 +163,10 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
 func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
+	// a committee without members, such as a council committee synced to an empty council, passes nothing
+	if len(committee.GetMembers()) == 0 {
+		return false
+	}
 	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
 	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
 	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
@@ -259,16 +264,16 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 	}
 	var proposalTally types.QueryTallyResponse
 	switch com := committee.(type) {
-	case *types.MemberCommittee:
+	case *types.MemberCommittee, *types.CouncilCommittee:
 		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
-		possibleVotes := sdk.NewDec(int64(len(com.Members)))
+		possibleVotes := sdk.NewDec(int64(len(com.GetMembers())))
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
 			YesVotes:      currVotes,
 			NoVotes:       sdk.ZeroDec(),
 			CurrentVotes:  currVotes,
 			PossibleVotes: possibleVotes,
-			VoteThreshold: com.VoteThreshold,
+			VoteThreshold: com.GetVoteThreshold(),
 			Quorum:        sdk.ZeroDec(),
 		}
 	case *types.TokenCommittee:
@@ -60,6 +60,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(BaseCommittee{}, "0g/BaseCommittee", nil)
 	cdc.RegisterConcrete(MemberCommittee{}, "0g/MemberCommittee", nil)
 	cdc.RegisterConcrete(TokenCommittee{}, "0g/TokenCommittee", nil)
+	cdc.RegisterConcrete(CouncilCommittee{}, "0g/CouncilCommittee", nil)
 
 	// Permissions
 	cdc.RegisterInterface((*Permission)(nil), nil)
@@ -93,6 +94,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BaseCommittee{},
 		&TokenCommittee{},
 		&MemberCommittee{},
+		&CouncilCommittee{},
 	)
 
 	registry.RegisterInterface(
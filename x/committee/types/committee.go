@@ -16,10 +16,11 @@ import (
 const MaxCommitteeDescriptionLength int = 512
 
 const (
-	BaseCommitteeType   = "0g/BaseCommittee"
-	MemberCommitteeType = "0g/MemberCommittee" // Committee is composed of member addresses that vote to enact proposals within their permissions
-	TokenCommitteeType  = "0g/TokenCommittee"  // Committee is composed of token holders with voting power determined by total token balance
-	BondDenom           = chaincfg.BondDenom
+	BaseCommitteeType    = "0g/BaseCommittee"
+	MemberCommitteeType  = "0g/MemberCommittee"  // Committee is composed of member addresses that vote to enact proposals within their permissions
+	TokenCommitteeType   = "0g/TokenCommittee"   // Committee is composed of token holders with voting power determined by total token balance
+	CouncilCommitteeType = "0g/CouncilCommittee" // Committee is composed of the members of the current x/council council
+	BondDenom            = chaincfg.BondDenom
 )
 
 // Marshal needed for protobuf compatibility.
@@ -184,11 +185,15 @@ func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // Validate validates BaseCommittee fields
 func (c BaseCommittee) Validate() error {
+	return c.validate(true)
+}
+
+func (c BaseCommittee) validate(requireMembers bool) error {
 	if len(c.Description) > MaxCommitteeDescriptionLength {
 		return fmt.Errorf("description length %d longer than max allowed %d", len(c.Description), MaxCommitteeDescriptionLength)
 	}
 
-	if len(c.Members) <= 0 {
+	if requireMembers && len(c.Members) <= 0 {
 		return fmt.Errorf("committee must have members")
 	}
 
@@ -328,6 +333,48 @@ func (c TokenCommittee) Validate() error {
 	return c.BaseCommittee.Validate()
 }
 
+// NewCouncilCommittee instantiates a new instance of CouncilCommittee, its members are set from the
+// current council
+func NewCouncilCommittee(id uint64, description string, permissions []Permission,
+	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption,
+) (*CouncilCommittee, error) {
+	permissionsAny, err := PackPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	return &CouncilCommittee{
+		BaseCommittee: &BaseCommittee{
+			ID:               id,
+			Description:      description,
+			Members:          []sdk.AccAddress{},
+			Permissions:      permissionsAny,
+			VoteThreshold:    threshold,
+			ProposalDuration: duration,
+			TallyOption:      tallyOption,
+		},
+	}, nil
+}
+
+// MustNewCouncilCommittee instantiates a new instance of CouncilCommittee and panics on error
+func MustNewCouncilCommittee(id uint64, description string, permissions []Permission,
+	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption,
+) *CouncilCommittee {
+	committee, err := NewCouncilCommittee(id, description, permissions, threshold, duration, tallyOption)
+	if err != nil {
+		panic(err)
+	}
+	return committee
+}
+
+// GetType is a getter for committee type
+func (c CouncilCommittee) GetType() string { return CouncilCommitteeType }
+
+// Validate validates the committee's fields, the council may not have been elected yet so the
+// committee can be empty
+func (c CouncilCommittee) Validate() error {
+	return c.BaseCommittee.validate(false)
+}
+
 // ------------------------------------------
 //				Proposals
 // ------------------------------------------
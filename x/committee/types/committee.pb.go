@@ -183,11 +183,50 @@ func (m *TokenCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenCommittee proto.InternalMessageInfo
 
+// CouncilCommittee is a member committee whose members are the current x/council council members,
+// they rotate with each council election
+type CouncilCommittee struct {
+	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
+}
+
+func (m *CouncilCommittee) Reset()      { *m = CouncilCommittee{} }
+func (*CouncilCommittee) ProtoMessage() {}
+func (*CouncilCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e3f5a94075c4544, []int{3}
+}
+func (m *CouncilCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CouncilCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CouncilCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CouncilCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouncilCommittee.Merge(m, src)
+}
+func (m *CouncilCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CouncilCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouncilCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CouncilCommittee proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("zgc.committee.v1beta1.TallyOption", TallyOption_name, TallyOption_value)
 	proto.RegisterType((*BaseCommittee)(nil), "zgc.committee.v1beta1.BaseCommittee")
 	proto.RegisterType((*MemberCommittee)(nil), "zgc.committee.v1beta1.MemberCommittee")
 	proto.RegisterType((*TokenCommittee)(nil), "zgc.committee.v1beta1.TokenCommittee")
+	proto.RegisterType((*CouncilCommittee)(nil), "zgc.committee.v1beta1.CouncilCommittee")
 }
 
 func init() {
@@ -195,49 +234,49 @@ func init() {
 }
 
 var fileDescriptor_8e3f5a94075c4544 = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcb, 0x6e, 0xd3, 0x5a,
-	0x14, 0xb5, 0x93, 0xdc, 0xf4, 0xf6, 0xb8, 0xcd, 0x4d, 0xcf, 0x6d, 0xaf, 0x9c, 0xea, 0xca, 0xb6,
-	0xaa, 0x82, 0x22, 0x44, 0xec, 0x36, 0xcc, 0x98, 0xc5, 0x75, 0xa2, 0x06, 0x95, 0x26, 0x38, 0xee,
-	0x00, 0x26, 0x96, 0x1f, 0x07, 0xc7, 0x6a, 0xec, 0x13, 0x7c, 0x9c, 0x42, 0xfa, 0x05, 0x0c, 0x19,
-	0x76, 0x88, 0xc4, 0x2f, 0xf4, 0x23, 0xaa, 0x8e, 0x2a, 0x46, 0x88, 0x41, 0x0a, 0xe9, 0x4f, 0x20,
-	0x46, 0xc8, 0xaf, 0x26, 0x85, 0x22, 0xc1, 0x80, 0x51, 0x72, 0xd6, 0x5e, 0xfb, 0xb1, 0xf6, 0x5e,
-	0x32, 0xb8, 0x73, 0xec, 0x58, 0x92, 0x85, 0x3d, 0xcf, 0x0d, 0x43, 0x84, 0xa4, 0xa3, 0x6d, 0x13,
-	0x85, 0xc6, 0xf6, 0x0c, 0x11, 0x87, 0x01, 0x0e, 0x31, 0x5c, 0x3b, 0x76, 0x2c, 0x71, 0x06, 0xa6,
-	0xb4, 0xf5, 0x8a, 0x85, 0x89, 0x87, 0x89, 0x1e, 0x93, 0xa4, 0xe4, 0x91, 0x64, 0xac, 0xaf, 0x3a,
-	0xd8, 0xc1, 0x09, 0x1e, 0xfd, 0x4b, 0xd1, 0x8a, 0x83, 0xb1, 0x33, 0x40, 0x52, 0xfc, 0x32, 0x47,
-	0xcf, 0x25, 0xc3, 0x1f, 0xa7, 0x21, 0xee, 0xfb, 0x90, 0x3d, 0x0a, 0x8c, 0xd0, 0xc5, 0x7e, 0x12,
-	0xdf, 0xf8, 0x92, 0x07, 0xcb, 0xb2, 0x41, 0xd0, 0x4e, 0x36, 0x05, 0xfc, 0x0f, 0xe4, 0x5c, 0x9b,
-	0xa5, 0x05, 0xba, 0x5a, 0x90, 0x8b, 0xd3, 0x09, 0x9f, 0x6b, 0x2b, 0x6a, 0xce, 0xb5, 0xa1, 0x00,
-	0x18, 0x1b, 0x11, 0x2b, 0x70, 0x87, 0x51, 0x3a, 0x9b, 0x13, 0xe8, 0xea, 0xa2, 0x3a, 0x0f, 0x41,
-	0x13, 0x2c, 0x78, 0xc8, 0x33, 0x51, 0x40, 0xd8, 0xbc, 0x90, 0xaf, 0x2e, 0xc9, 0xbb, 0x5f, 0x27,
-	0x7c, 0xcd, 0x71, 0xc3, 0xfe, 0xc8, 0x8c, 0x64, 0xa6, 0x52, 0xd2, 0x9f, 0x1a, 0xb1, 0x0f, 0xa5,
-	0x70, 0x3c, 0x44, 0x44, 0x6c, 0x58, 0x56, 0xc3, 0xb6, 0x03, 0x44, 0xc8, 0xfb, 0xd3, 0xda, 0xbf,
-	0xa9, 0xe0, 0x14, 0x91, 0xc7, 0x21, 0x22, 0x6a, 0x56, 0x18, 0xb6, 0x00, 0x33, 0x44, 0x81, 0xe7,
-	0x12, 0xe2, 0x62, 0x9f, 0xb0, 0x05, 0x21, 0x5f, 0x65, 0xea, 0xab, 0x62, 0xa2, 0x52, 0xcc, 0x54,
-	0x8a, 0x0d, 0x7f, 0x2c, 0x97, 0xce, 0x4f, 0x6b, 0xa0, 0x7b, 0x4d, 0x56, 0xe7, 0x13, 0xe1, 0x01,
-	0x28, 0x1d, 0xe1, 0x10, 0xe9, 0x61, 0x3f, 0x40, 0xa4, 0x8f, 0x07, 0x36, 0xfb, 0x57, 0x24, 0x48,
-	0x16, 0xcf, 0x26, 0x3c, 0xf5, 0x71, 0xc2, 0xdf, 0xfd, 0x85, 0xb1, 0x15, 0x64, 0xa9, 0xcb, 0x51,
-	0x15, 0x2d, 0x2b, 0x02, 0xbb, 0x60, 0x65, 0x18, 0xe0, 0x21, 0x26, 0xc6, 0x40, 0xcf, 0x36, 0xcd,
-	0x16, 0x05, 0xba, 0xca, 0xd4, 0x2b, 0x3f, 0x0c, 0xa9, 0xa4, 0x04, 0xf9, 0xef, 0xa8, 0xe9, 0xc9,
-	0x25, 0x4f, 0xab, 0xe5, 0x2c, 0x3b, 0x8b, 0xc1, 0x26, 0x58, 0x0a, 0x8d, 0xc1, 0x60, 0xac, 0xe3,
-	0x64, 0xef, 0x0b, 0x02, 0x5d, 0x2d, 0xd5, 0x37, 0xc4, 0x5b, 0xad, 0x23, 0x6a, 0x11, 0xb5, 0x13,
-	0x33, 0x55, 0x26, 0x9c, 0x3d, 0x1e, 0xae, 0x9c, 0xbc, 0xe5, 0xa9, 0xf3, 0xd3, 0xda, 0xe2, 0xf5,
-	0xa1, 0x37, 0x5e, 0x82, 0x7f, 0x1e, 0xc7, 0x5b, 0x9d, 0xdd, 0xfe, 0x09, 0x28, 0x99, 0x06, 0x41,
-	0xfa, 0x75, 0xe1, 0xd8, 0x07, 0x4c, 0x7d, 0xf3, 0x27, 0xed, 0x6e, 0x38, 0x47, 0x2e, 0x5c, 0x4c,
-	0x78, 0x5a, 0x5d, 0x36, 0xe7, 0xc1, 0xdb, 0x1a, 0x5f, 0xd2, 0xa0, 0xa4, 0xe1, 0x43, 0xe4, 0xff,
-	0xc9, 0xc6, 0xb0, 0x05, 0x8a, 0x2f, 0x46, 0x38, 0x18, 0x79, 0x89, 0x55, 0x7f, 0xfb, 0xb2, 0x69,
-	0x36, 0xe4, 0x41, 0xb2, 0x48, 0xdd, 0x46, 0x3e, 0xf6, 0xd8, 0x7c, 0xec, 0x7b, 0x10, 0x43, 0x4a,
-	0x84, 0xdc, 0xa2, 0xf0, 0x5e, 0x00, 0x98, 0xb9, 0x4b, 0xc0, 0xff, 0x01, 0xab, 0x35, 0xf6, 0xf6,
-	0x9e, 0xea, 0x9d, 0xae, 0xd6, 0xee, 0xec, 0xeb, 0x07, 0xfb, 0xbd, 0x6e, 0x73, 0xa7, 0xdd, 0x6a,
-	0x37, 0x95, 0x32, 0x05, 0x37, 0x81, 0x70, 0x23, 0xda, 0x6a, 0xab, 0x3d, 0x4d, 0xef, 0x36, 0x7a,
-	0x9a, 0xae, 0xed, 0x36, 0xf5, 0x6e, 0xa7, 0xa7, 0x95, 0x69, 0x58, 0x01, 0x6b, 0x37, 0x58, 0x4a,
-	0xb3, 0xa1, 0xec, 0xb5, 0xf7, 0x9b, 0xe5, 0xdc, 0x7a, 0xe1, 0xf5, 0x3b, 0x8e, 0x92, 0x1f, 0x9d,
-	0x7d, 0xe6, 0xa8, 0xb3, 0x29, 0x47, 0x5f, 0x4c, 0x39, 0xfa, 0xd3, 0x94, 0xa3, 0xdf, 0x5c, 0x71,
-	0xd4, 0xc5, 0x15, 0x47, 0x7d, 0xb8, 0xe2, 0xa8, 0x67, 0xf7, 0xe7, 0x54, 0x6f, 0x39, 0x03, 0xc3,
-	0x24, 0xd2, 0x96, 0x53, 0xb3, 0xfa, 0x86, 0xeb, 0x4b, 0xaf, 0xe6, 0x3e, 0x55, 0xb1, 0x7e, 0xb3,
-	0x18, 0x7b, 0xf4, 0xc1, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa2, 0xc0, 0xfd, 0xc8, 0x04,
-	0x00, 0x00,
+	// 666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb5, 0x93, 0x7c, 0xe9, 0xd7, 0x71, 0x1b, 0x52, 0xd3, 0x22, 0xa7, 0x42, 0xb6, 0x55, 0x15,
+	0x14, 0x21, 0x62, 0xb7, 0x61, 0xc7, 0x2e, 0xae, 0x13, 0x35, 0xa8, 0x34, 0xc1, 0x71, 0x17, 0xb0,
+	0xb1, 0xfc, 0x33, 0x38, 0x56, 0x6d, 0x4f, 0xf0, 0x38, 0xa5, 0xe9, 0x13, 0xb0, 0x64, 0xd9, 0x25,
+	0x12, 0xaf, 0xd0, 0x87, 0xa8, 0xba, 0xaa, 0x58, 0x21, 0x16, 0x29, 0xa4, 0x2f, 0x81, 0x58, 0x21,
+	0xff, 0x35, 0x29, 0x14, 0x09, 0x21, 0xc1, 0xca, 0x9e, 0x73, 0xcf, 0xbd, 0x77, 0xce, 0xb9, 0xd7,
+	0x06, 0xf7, 0x8e, 0x6c, 0x53, 0x34, 0x91, 0xe7, 0x39, 0x61, 0x08, 0xa1, 0x78, 0xb0, 0x69, 0xc0,
+	0x50, 0xdf, 0x9c, 0x22, 0xc2, 0x20, 0x40, 0x21, 0xa2, 0x57, 0x8e, 0x6c, 0x53, 0x98, 0x82, 0x29,
+	0x6d, 0xb5, 0x62, 0x22, 0xec, 0x21, 0xac, 0xc5, 0x24, 0x31, 0x39, 0x24, 0x19, 0xab, 0xcb, 0x36,
+	0xb2, 0x51, 0x82, 0x47, 0x6f, 0x29, 0x5a, 0xb1, 0x11, 0xb2, 0x5d, 0x28, 0xc6, 0x27, 0x63, 0xf8,
+	0x52, 0xd4, 0xfd, 0x51, 0x1a, 0x62, 0x7f, 0x0c, 0x59, 0xc3, 0x40, 0x0f, 0x1d, 0xe4, 0x27, 0xf1,
+	0xb5, 0xaf, 0x79, 0xb0, 0x28, 0xe9, 0x18, 0x6e, 0x65, 0xb7, 0xa0, 0xef, 0x80, 0x9c, 0x63, 0x31,
+	0x24, 0x4f, 0x56, 0x0b, 0x52, 0x71, 0x32, 0xe6, 0x72, 0x6d, 0x59, 0xc9, 0x39, 0x16, 0xcd, 0x03,
+	0xca, 0x82, 0xd8, 0x0c, 0x9c, 0x41, 0x94, 0xce, 0xe4, 0x78, 0xb2, 0x3a, 0xaf, 0xcc, 0x42, 0xb4,
+	0x01, 0xe6, 0x3c, 0xe8, 0x19, 0x30, 0xc0, 0x4c, 0x9e, 0xcf, 0x57, 0x17, 0xa4, 0xed, 0x6f, 0x63,
+	0xae, 0x66, 0x3b, 0x61, 0x7f, 0x68, 0x44, 0x32, 0x53, 0x29, 0xe9, 0xa3, 0x86, 0xad, 0x7d, 0x31,
+	0x1c, 0x0d, 0x20, 0x16, 0x1a, 0xa6, 0xd9, 0xb0, 0xac, 0x00, 0x62, 0xfc, 0xe1, 0xa4, 0x76, 0x3b,
+	0x15, 0x9c, 0x22, 0xd2, 0x28, 0x84, 0x58, 0xc9, 0x0a, 0xd3, 0x2d, 0x40, 0x0d, 0x60, 0xe0, 0x39,
+	0x18, 0x3b, 0xc8, 0xc7, 0x4c, 0x81, 0xcf, 0x57, 0xa9, 0xfa, 0xb2, 0x90, 0xa8, 0x14, 0x32, 0x95,
+	0x42, 0xc3, 0x1f, 0x49, 0xa5, 0xb3, 0x93, 0x1a, 0xe8, 0x5e, 0x91, 0x95, 0xd9, 0x44, 0x7a, 0x0f,
+	0x94, 0x0e, 0x50, 0x08, 0xb5, 0xb0, 0x1f, 0x40, 0xdc, 0x47, 0xae, 0xc5, 0xfc, 0x17, 0x09, 0x92,
+	0x84, 0xd3, 0x31, 0x47, 0x7c, 0x1a, 0x73, 0xf7, 0x7f, 0xe3, 0xda, 0x32, 0x34, 0x95, 0xc5, 0xa8,
+	0x8a, 0x9a, 0x15, 0xa1, 0xbb, 0x60, 0x69, 0x10, 0xa0, 0x01, 0xc2, 0xba, 0xab, 0x65, 0x4e, 0x33,
+	0x45, 0x9e, 0xac, 0x52, 0xf5, 0xca, 0x4f, 0x97, 0x94, 0x53, 0x82, 0xf4, 0x7f, 0xd4, 0xf4, 0xf8,
+	0x82, 0x23, 0x95, 0x72, 0x96, 0x9d, 0xc5, 0xe8, 0x26, 0x58, 0x08, 0x75, 0xd7, 0x1d, 0x69, 0x28,
+	0xf1, 0x7d, 0x8e, 0x27, 0xab, 0xa5, 0xfa, 0x9a, 0x70, 0xe3, 0xea, 0x08, 0x6a, 0x44, 0xed, 0xc4,
+	0x4c, 0x85, 0x0a, 0xa7, 0x87, 0xc7, 0x4b, 0xc7, 0xef, 0x38, 0xe2, 0xec, 0xa4, 0x36, 0x7f, 0x35,
+	0xe8, 0xb5, 0xd7, 0xe0, 0xd6, 0xd3, 0xd8, 0xd5, 0xe9, 0xec, 0x9f, 0x81, 0x92, 0xa1, 0x63, 0xa8,
+	0x5d, 0x15, 0x8e, 0xf7, 0x80, 0xaa, 0xaf, 0xff, 0xa2, 0xdd, 0xb5, 0xcd, 0x91, 0x0a, 0xe7, 0x63,
+	0x8e, 0x54, 0x16, 0x8d, 0x59, 0xf0, 0xa6, 0xc6, 0x17, 0x24, 0x28, 0xa9, 0x68, 0x1f, 0xfa, 0x7f,
+	0xb3, 0x31, 0xdd, 0x02, 0xc5, 0x57, 0x43, 0x14, 0x0c, 0x3d, 0x26, 0xf7, 0x47, 0x93, 0x4d, 0xb3,
+	0x69, 0x0e, 0x24, 0x46, 0x6a, 0x16, 0xf4, 0x91, 0xc7, 0xe4, 0xe3, 0xbd, 0x07, 0x31, 0x24, 0x47,
+	0xc8, 0x4d, 0x0a, 0x0f, 0x41, 0x79, 0x0b, 0x0d, 0x7d, 0xd3, 0x71, 0xff, 0xb1, 0xb7, 0x0f, 0x02,
+	0x40, 0xcd, 0xec, 0x00, 0x7d, 0x17, 0x30, 0x6a, 0x63, 0x67, 0xe7, 0xb9, 0xd6, 0xe9, 0xaa, 0xed,
+	0xce, 0xae, 0xb6, 0xb7, 0xdb, 0xeb, 0x36, 0xb7, 0xda, 0xad, 0x76, 0x53, 0x2e, 0x13, 0xf4, 0x3a,
+	0xe0, 0xaf, 0x45, 0x5b, 0x6d, 0xa5, 0xa7, 0x6a, 0xdd, 0x46, 0x4f, 0xd5, 0xd4, 0xed, 0xa6, 0xd6,
+	0xed, 0xf4, 0xd4, 0x32, 0x49, 0x57, 0xc0, 0xca, 0x35, 0x96, 0xdc, 0x6c, 0xc8, 0x3b, 0xed, 0xdd,
+	0x66, 0x39, 0xb7, 0x5a, 0x78, 0xf3, 0x9e, 0x25, 0xa4, 0x27, 0xa7, 0x5f, 0x58, 0xe2, 0x74, 0xc2,
+	0x92, 0xe7, 0x13, 0x96, 0xfc, 0x3c, 0x61, 0xc9, 0xb7, 0x97, 0x2c, 0x71, 0x7e, 0xc9, 0x12, 0x1f,
+	0x2f, 0x59, 0xe2, 0xc5, 0xc3, 0x19, 0xbf, 0x37, 0x6c, 0x57, 0x37, 0xb0, 0xb8, 0x61, 0xd7, 0xcc,
+	0xbe, 0xee, 0xf8, 0xe2, 0xe1, 0xcc, 0x4f, 0x32, 0x76, 0xde, 0x28, 0xc6, 0x5f, 0xc7, 0xa3, 0xef,
+	0x03, 0x00, 0x72, 0xbc, 0x03, 0x28, 0x42, 0x05, 0x00, 0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -408,6 +447,41 @@ func (m *TokenCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CouncilCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CouncilCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CouncilCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseCommittee != nil {
+		{
+			size, err := m.BaseCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommittee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommittee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommittee(v)
 	base := offset
@@ -486,6 +560,19 @@ func (m *TokenCommittee) Size() (n int) {
 	return n
 }
 
+func (m *CouncilCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseCommittee != nil {
+		l = m.BaseCommittee.Size()
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	return n
+}
+
 func sovCommittee(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -983,6 +1070,92 @@ func (m *TokenCommittee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CouncilCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CouncilCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CouncilCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseCommittee == nil {
+				m.BaseCommittee = &BaseCommittee{}
+			}
+			if err := m.BaseCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommittee(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -248,6 +248,70 @@ func TestMemberCommittee(t *testing.T) {
 	}
 }
 
+// TestCouncilCommittee tests unique CouncilCommittee functionality
+func TestCouncilCommittee(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("0gChainTest2"))),
+	}
+
+	testCases := []struct {
+		name       string
+		members    []sdk.AccAddress
+		threshold  sdk.Dec
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			members:    addresses,
+			threshold:  testutil.D("0.667"),
+			expectPass: true,
+		},
+		{
+			name:       "council not elected yet",
+			members:    []sdk.AccAddress{},
+			threshold:  testutil.D("0.667"),
+			expectPass: true,
+		},
+		{
+			name:       "duplicate members",
+			members:    []sdk.AccAddress{addresses[0], addresses[0]},
+			threshold:  testutil.D("0.667"),
+			expectPass: false,
+		},
+		{
+			name:       "invalid threshold",
+			members:    addresses,
+			threshold:  testutil.D("1.1"),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee, err := types.NewCouncilCommittee(
+				1,
+				"This council committee is for testing.",
+				[]types.Permission{&types.GodPermission{}},
+				tc.threshold,
+				time.Hour*24*7,
+				types.TALLY_OPTION_FIRST_PAST_THE_POST,
+			)
+			require.NoError(t, err)
+			require.Equal(t, types.CouncilCommitteeType, committee.GetType())
+			require.Empty(t, committee.GetMembers())
+			committee.SetMembers(tc.members)
+
+			err = committee.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 // TestTokenCommittee tests unique TokenCommittee functionality
 func TestTokenCommittee(t *testing.T) {
 	addresses := []sdk.AccAddress{
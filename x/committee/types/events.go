@@ -6,6 +6,8 @@ const (
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
 
+	EventTypeCommitteeMembersUpdate = "committee_members_update"
+
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
@@ -15,4 +17,5 @@ const (
 	AttributeKeyVote                = "vote"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyMembers             = "members"
 )
@@ -20,3 +20,8 @@ type BankKeeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// CouncilKeeper defines the expected council keeper
+type CouncilKeeper interface {
+	GetCurrentMembers(ctx sdk.Context) []sdk.ValAddress
+}
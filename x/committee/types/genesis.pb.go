@@ -199,7 +199,7 @@ func init() {
 }
 
 var fileDescriptor_dc916f377aadb716 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
+	// 651 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
 	0x10, 0xb5, 0x1d, 0xff, 0xf8, 0x25, 0x9b, 0x90, 0x86, 0x2d, 0x54, 0x21, 0x95, 0x6c, 0x44, 0x2f,
 	0xa8, 0x6a, 0x6c, 0xa0, 0x87, 0x4a, 0x88, 0x43, 0xe3, 0x24, 0xb4, 0xbe, 0x84, 0xc8, 0x49, 0x91,
@@ -240,7 +240,7 @@ var fileDescriptor_dc916f377aadb716 = []byte{
 	0xcb, 0x40, 0xe2, 0xaf, 0x03, 0x89, 0xff, 0x19, 0x48, 0xfc, 0xf9, 0xad, 0xc4, 0x5d, 0xdf, 0x4a,
 	0xdc, 0xf7, 0x5b, 0x89, 0x7b, 0xfb, 0x6c, 0xce, 0x93, 0x4d, 0x67, 0x60, 0xf6, 0x89, 0xba, 0xe9,
 	0x54, 0xad, 0x23, 0xd3, 0xf5, 0xd4, 0x93, 0xb9, 0x9f, 0x07, 0x73, 0xa7, 0xbf, 0xc0, 0x06, 0xf8,
-	0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa9, 0xa7, 0x8e, 0x5a, 0x04, 0x00, 0x00,
+	0xfc, 0xcf, 0x00, 0xfb, 0xa9, 0xa7, 0x8e, 0x5a, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
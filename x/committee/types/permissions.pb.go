@@ -447,7 +447,7 @@ func init() {
 }
 
 var fileDescriptor_57b97afa685555be = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
+	// 512 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
 	0x18, 0xc7, 0x9b, 0x5f, 0xa6, 0x9f, 0x98, 0x11, 0xd3, 0x94, 0x95, 0x29, 0x8b, 0x46, 0x5a, 0x95,
 	0x03, 0x15, 0xb0, 0x64, 0x05, 0x71, 0xd9, 0xad, 0xed, 0x04, 0x17, 0x0e, 0x55, 0x06, 0x42, 0xe2,
@@ -479,8 +479,7 @@ var fileDescriptor_57b97afa685555be = []byte{
 	0x6b, 0x53, 0xb9, 0x59, 0x9b, 0xca, 0xef, 0xb5, 0xa9, 0x7c, 0xde, 0x98, 0x9d, 0x9b, 0x8d, 0xd9,
 	0xf9, 0xb1, 0x31, 0x3b, 0x6f, 0x1f, 0x63, 0x22, 0xe7, 0x89, 0x97, 0x7a, 0xda, 0xa7, 0x98, 0x42,
 	0x4f, 0xd8, 0xa7, 0xf8, 0xc4, 0x9f, 0x43, 0x12, 0xd9, 0x97, 0xb5, 0xf7, 0x2d, 0x57, 0x31, 0x12,
-	0xde, 0xff, 0xd9, 0x53, 0x7c, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x11, 0x7e, 0x2f, 0x02, 0xfd,
-	0x03, 0x00, 0x00,
+	0xde, 0xff, 0xd9, 0x53, 0x7c, 0xfa, 0x77, 0x00, 0x11, 0x7e, 0x2f, 0x02, 0xfd, 0x03, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -115,7 +115,7 @@ func init() {
 }
 
 var fileDescriptor_120f043c81d2fa1b = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
+	// 348 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3f, 0x6e, 0xc2, 0x30,
 	0x14, 0xc6, 0xe3, 0xfe, 0x93, 0x48, 0x40, 0x95, 0x22, 0xaa, 0x02, 0x83, 0x8b, 0x50, 0x07, 0x86,
 	0x62, 0x03, 0xdd, 0xba, 0x15, 0x18, 0x4a, 0xa7, 0x8a, 0xb1, 0x0b, 0x4a, 0x82, 0x6b, 0x2c, 0x05,
@@ -137,7 +137,7 @@ var fileDescriptor_120f043c81d2fa1b = []byte{
 	0x8c, 0x36, 0x11, 0x46, 0xdf, 0x11, 0x46, 0x6f, 0x5b, 0x6c, 0x6c, 0xb6, 0xd8, 0xf8, 0xdc, 0x62,
 	0xe3, 0xf9, 0x86, 0x0b, 0x35, 0x9c, 0xb8, 0xbb, 0x9f, 0xa6, 0x75, 0xee, 0x3b, 0x6e, 0x48, 0xeb,
 	0xbc, 0xe6, 0x0d, 0x1d, 0x21, 0xe9, 0xeb, 0xc1, 0x96, 0xa8, 0x79, 0xc0, 0x42, 0xf7, 0x2c, 0x1e,
-	0xdf, 0xed, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x1b, 0x11, 0x1c, 0x43, 0x02, 0x00, 0x00,
+	0xdf, 0xed, 0xef, 0x00, 0xdb, 0x1b, 0x11, 0x1c, 0x43, 0x02, 0x00, 0x00,
 }
 
 func (m *CommitteeChangeProposal) Marshal() (dAtA []byte, err error) {
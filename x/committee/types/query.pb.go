@@ -760,84 +760,83 @@ func init() {
 func init() { proto.RegisterFile("zgc/committee/v1beta1/query.proto", fileDescriptor_32c24238147f1ffb) }
 
 var fileDescriptor_32c24238147f1ffb = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0x6d, 0x7f, 0xfd, 0x8d, 0xba, 0x4b, 0x1a, 0x56, 0x49,
-	0x6b, 0xa4, 0xb6, 0x40, 0x63, 0xb7, 0x29, 0xab, 0x95, 0xd8, 0x45, 0x62, 0xd3, 0xb0, 0x28, 0x20,
-	0xa1, 0x62, 0x0a, 0x07, 0x56, 0x22, 0x9a, 0xc4, 0xb3, 0xae, 0xd9, 0xc4, 0x76, 0x3d, 0x4e, 0xdb,
-	0x6c, 0xe9, 0x85, 0x3b, 0x68, 0x01, 0x21, 0x81, 0x84, 0x90, 0x10, 0x07, 0xf8, 0x03, 0xf6, 0x8f,
-	0xa8, 0xf6, 0xb4, 0x12, 0x17, 0xc4, 0x21, 0x40, 0xca, 0x1f, 0x82, 0x3c, 0x1e, 0x4f, 0xdc, 0x24,
-	0x24, 0x69, 0x4e, 0x89, 0xed, 0xf7, 0xbe, 0xf3, 0x79, 0x6f, 0x66, 0xde, 0x7b, 0xb0, 0xfa, 0xd8,
-	0xa8, 0xa9, 0x35, 0xbb, 0xd1, 0x30, 0x3d, 0x8f, 0x10, 0xf5, 0x68, 0xbb, 0x4a, 0x3c, 0xbc, 0xad,
-	0x1e, 0x36, 0x89, 0xdb, 0x52, 0x1c, 0xd7, 0xf6, 0x6c, 0x74, 0xfd, 0xb1, 0x51, 0x53, 0x84, 0x89,
-	0xc2, 0x4d, 0x32, 0xaf, 0xd4, 0x6c, 0xda, 0xb0, 0xa9, 0x5a, 0xc5, 0x94, 0x04, 0xf6, 0xc2, 0xdb,
-	0xc1, 0x86, 0x69, 0x61, 0xcf, 0xb4, 0xad, 0x40, 0x22, 0xb3, 0x1c, 0xd8, 0x56, 0xd8, 0x93, 0x1a,
-	0x3c, 0xf0, 0x4f, 0x4b, 0x86, 0x6d, 0xd8, 0xc1, 0x7b, 0xff, 0x1f, 0x7f, 0x7b, 0xd3, 0xb0, 0x6d,
-	0xa3, 0x4e, 0x54, 0xec, 0x98, 0x2a, 0xb6, 0x2c, 0xdb, 0x63, 0x6a, 0xa1, 0xcf, 0x32, 0xff, 0xca,
-	0x9e, 0xaa, 0xcd, 0x87, 0x2a, 0xb6, 0x38, 0x6c, 0x26, 0xd7, 0xfb, 0xc9, 0x33, 0x1b, 0x84, 0x7a,
-	0xb8, 0xe1, 0x70, 0x83, 0x97, 0x06, 0x07, 0x6c, 0x10, 0x8b, 0x50, 0x93, 0x2f, 0x20, 0xa7, 0xe1,
-	0xc6, 0xfb, 0x7e, 0x44, 0xbb, 0xa1, 0x1d, 0xd5, 0xc8, 0x61, 0x93, 0x50, 0x4f, 0xfe, 0x04, 0x5e,
-	0xe8, 0xfb, 0x42, 0x1d, 0xdb, 0xa2, 0x04, 0xed, 0x02, 0x08, 0x5d, 0x9a, 0x96, 0x56, 0xa6, 0x37,
-	0x52, 0x85, 0x25, 0x25, 0xe0, 0x51, 0x42, 0x1e, 0xe5, 0x9e, 0xd5, 0x2a, 0xce, 0x3f, 0x7b, 0x9a,
-	0x4f, 0x0a, 0x05, 0x2d, 0xe2, 0x26, 0xbf, 0x0e, 0xd7, 0x2f, 0xeb, 0xf3, 0x85, 0xd1, 0x2a, 0xcc,
-	0x09, 0xb3, 0x8a, 0xa9, 0xa7, 0xa5, 0x15, 0x69, 0x23, 0xae, 0xa5, 0xc4, 0xbb, 0xb2, 0x2e, 0x3f,
-	0xe8, 0xa5, 0x16, 0x68, 0xf7, 0x20, 0x29, 0x0c, 0x99, 0xe7, 0x98, 0x64, 0x5d, 0x2f, 0x01, 0xb6,
-	0xe7, 0xda, 0x8e, 0x4d, 0x71, 0x9d, 0x5e, 0x01, 0xec, 0x53, 0x0e, 0x16, 0xf1, 0xe5, 0x60, 0x7b,
-	0x90, 0x74, 0xc2, 0x97, 0x3c, 0x65, 0x9b, 0xca, 0xc0, 0xf3, 0xa6, 0x5c, 0x52, 0x08, 0x05, 0x8a,
-	0xf1, 0xf3, 0x76, 0x6e, 0x4a, 0xeb, 0x8a, 0xc8, 0xb7, 0x61, 0xa9, 0xc7, 0x32, 0xc0, 0xcc, 0x41,
-	0x2a, 0x34, 0xea, 0x52, 0x42, 0xf8, 0xaa, 0xac, 0xcb, 0x5f, 0xc4, 0x7a, 0x22, 0x14, 0x90, 0x0f,
-	0x61, 0xce, 0x69, 0x56, 0x2b, 0xa1, 0xed, 0xd0, 0x04, 0xe6, 0x3b, 0xed, 0x5c, 0x6a, 0xaf, 0x59,
-	0x0d, 0x45, 0x9e, 0x3d, 0xcd, 0x67, 0xf8, 0x79, 0x37, 0xec, 0x23, 0x11, 0xcc, 0xae, 0x6d, 0x79,
-	0xc4, 0xf2, 0xb4, 0x94, 0xd3, 0x35, 0x45, 0x37, 0x20, 0x66, 0xea, 0xe9, 0x98, 0x4f, 0x56, 0x9c,
-	0xed, 0xb4, 0x73, 0xb1, 0x72, 0x49, 0x8b, 0x99, 0x3a, 0x2a, 0xf4, 0x64, 0x78, 0x9a, 0x59, 0xfc,
-	0xcf, 0x5f, 0x49, 0x6c, 0x55, 0xb9, 0x74, 0x29, 0xe5, 0xe8, 0x4d, 0x48, 0xe8, 0x04, 0xeb, 0x75,
-	0xd3, 0x22, 0xe9, 0x38, 0xe3, 0xcd, 0xf4, 0xf1, 0xee, 0x87, 0x57, 0xa3, 0x98, 0xf0, 0xb3, 0xf8,
-	0xe4, 0xcf, 0x9c, 0xa4, 0x09, 0x2f, 0xf9, 0x26, 0x64, 0x58, 0x3a, 0xde, 0x23, 0x27, 0x5e, 0x88,
-	0x58, 0x2e, 0x85, 0xf7, 0xe0, 0x01, 0xbc, 0x38, 0xf0, 0x2b, 0x4f, 0xd9, 0x5d, 0x58, 0xb4, 0xc8,
-	0x89, 0x57, 0xe9, 0x4b, 0x79, 0x11, 0x75, 0xda, 0xb9, 0x85, 0x1e, 0xaf, 0x05, 0x2b, 0xfa, 0xac,
-	0xcb, 0x9f, 0xc1, 0xff, 0x99, 0xf8, 0x47, 0xb6, 0x27, 0x6e, 0xde, 0xc8, 0x0d, 0x44, 0xf7, 0x01,
-	0xba, 0x85, 0x87, 0xa5, 0x31, 0x55, 0x58, 0x53, 0x78, 0xf2, 0xfd, 0x2a, 0xa5, 0x04, 0x55, 0x2d,
-	0xdc, 0x83, 0x3d, 0x6c, 0x84, 0xb7, 0x4b, 0x8b, 0x78, 0xca, 0x3f, 0x4b, 0x80, 0xa2, 0xcb, 0xf3,
-	0x90, 0x4a, 0x30, 0x73, 0xe4, 0xbf, 0xe0, 0xc7, 0x74, 0x63, 0xd8, 0x31, 0xf5, 0x3d, 0x7b, 0x8e,
-	0x68, 0xe0, 0x8c, 0xde, 0x1e, 0x00, 0xb9, 0x3e, 0x12, 0x32, 0x50, 0xba, 0x44, 0x59, 0x86, 0xc5,
-	0xc8, 0x52, 0x63, 0xa6, 0x68, 0x29, 0x88, 0xc1, 0x65, 0x0b, 0x27, 0x03, 0x26, 0x57, 0xfe, 0x4e,
-	0x8a, 0xe4, 0x5b, 0xc4, 0xab, 0x0e, 0x10, 0x2b, 0x2e, 0x74, 0xda, 0x39, 0x88, 0xec, 0xdc, 0x48,
-	0x71, 0x74, 0x17, 0x92, 0xfe, 0x9f, 0x8a, 0xd7, 0x72, 0x08, 0x3b, 0xb9, 0x0b, 0x85, 0xdc, 0x7f,
-	0xa4, 0xce, 0x5f, 0x7e, 0xbf, 0xe5, 0x10, 0x2d, 0x71, 0xc4, 0xff, 0xc9, 0xaf, 0x71, 0xb2, 0x7d,
-	0x5c, 0xaf, 0xb7, 0xc6, 0xbe, 0xca, 0xbf, 0xc6, 0xf9, 0x0e, 0x72, 0xb7, 0x49, 0x23, 0x7a, 0x17,
-	0x92, 0x2d, 0x42, 0x2b, 0xc1, 0xb6, 0xb3, 0xa8, 0x8a, 0x8a, 0xbf, 0x99, 0x7f, 0xb4, 0x73, 0x6b,
-	0x86, 0xe9, 0x1d, 0x34, 0xab, 0x7e, 0x14, 0xbc, 0x9f, 0xf1, 0x9f, 0x3c, 0xd5, 0x1f, 0xa9, 0x7e,
-	0xb0, 0x54, 0x29, 0x91, 0x9a, 0x96, 0x68, 0x11, 0xca, 0xce, 0x11, 0x2a, 0x43, 0xc2, 0xb2, 0xb9,
-	0xd6, 0xf4, 0x44, 0x5a, 0xd7, 0x2c, 0x3b, 0x90, 0xfa, 0x00, 0xe6, 0x6b, 0x4d, 0xd7, 0x25, 0x96,
-	0xc7, 0xf5, 0xe2, 0x13, 0xe9, 0xcd, 0x71, 0x91, 0x40, 0xf4, 0x43, 0x58, 0x70, 0x6c, 0x4a, 0xcd,
-	0x6a, 0x9d, 0x70, 0xd5, 0x99, 0x89, 0x54, 0xe7, 0x43, 0x15, 0x21, 0x1b, 0xec, 0xff, 0x81, 0x4b,
-	0xe8, 0x81, 0x5d, 0xd7, 0xd3, 0xb3, 0x93, 0xc9, 0xb2, 0x33, 0x11, 0x8a, 0xa0, 0xfb, 0x30, 0x7b,
-	0xd8, 0xb4, 0xdd, 0x66, 0x23, 0x7d, 0x6d, 0x22, 0x39, 0xee, 0x2d, 0xbf, 0xc5, 0x8b, 0xbe, 0x86,
-	0x8f, 0xf7, 0xb0, 0x8b, 0x1b, 0xa2, 0xdc, 0x64, 0x20, 0x41, 0x9b, 0x55, 0xea, 0xe0, 0x5a, 0xd0,
-	0x31, 0x93, 0x9a, 0x78, 0x46, 0x8b, 0x30, 0xfd, 0x88, 0xb4, 0xf8, 0x39, 0xf7, 0xff, 0xca, 0x3b,
-	0xbc, 0xc3, 0x45, 0x64, 0xf8, 0xa1, 0x5b, 0x86, 0x84, 0x8b, 0x8f, 0x2b, 0x3a, 0xf6, 0x30, 0xd7,
-	0xb9, 0xe6, 0xe2, 0xe3, 0x12, 0xf6, 0x70, 0xe1, 0xcb, 0x14, 0xcc, 0x30, 0x2f, 0xf4, 0xad, 0x04,
-	0xd0, 0x9d, 0x28, 0x50, 0x7e, 0x58, 0x6d, 0xe9, 0x9b, 0x49, 0x32, 0xca, 0xb8, 0xe6, 0x01, 0x92,
-	0xbc, 0xf1, 0xf9, 0x6f, 0xff, 0x7c, 0x13, 0x93, 0xd1, 0x8a, 0xba, 0x65, 0x0c, 0x18, 0x85, 0xba,
-	0xd3, 0x08, 0xfa, 0x49, 0x82, 0xee, 0x34, 0x80, 0x36, 0xc7, 0x5a, 0x27, 0xa4, 0xca, 0x8f, 0x69,
-	0xcd, 0xa1, 0x6e, 0x33, 0xa8, 0x6d, 0xa4, 0x8e, 0x82, 0x52, 0x4f, 0xa3, 0xcd, 0xf0, 0x0c, 0x7d,
-	0x25, 0x41, 0x52, 0x0c, 0x16, 0x68, 0xac, 0xe9, 0x81, 0x8e, 0xc5, 0xd8, 0x37, 0xad, 0xc8, 0xeb,
-	0x8c, 0x71, 0x15, 0xe5, 0x06, 0x33, 0x8a, 0x21, 0x04, 0xfd, 0x20, 0x41, 0x42, 0xb4, 0xf5, 0x57,
-	0xc7, 0x1b, 0x68, 0x02, 0xa2, 0x2b, 0x4d, 0x3f, 0xf2, 0x2d, 0x06, 0xa4, 0xa2, 0xfc, 0x08, 0x20,
-	0xf5, 0x34, 0x52, 0xf8, 0xce, 0xd0, 0x2f, 0x12, 0xf4, 0xb4, 0x60, 0xb4, 0x3d, 0x6c, 0xdd, 0x81,
-	0x23, 0x40, 0xa6, 0x70, 0x15, 0x17, 0x0e, 0xac, 0x30, 0xe0, 0x0d, 0xb4, 0x36, 0x18, 0xd8, 0x9f,
-	0x03, 0xf2, 0x21, 0x6a, 0xde, 0xd4, 0xd1, 0xf7, 0x12, 0xcc, 0x04, 0x75, 0x64, 0x64, 0xbf, 0x15,
-	0x9b, 0xfa, 0xf2, 0x18, 0x96, 0x1c, 0xe7, 0x0e, 0xc3, 0xb9, 0x85, 0x76, 0xae, 0x94, 0x3f, 0x35,
-	0x68, 0xe5, 0x3f, 0x4a, 0x10, 0xf7, 0xe5, 0xd0, 0xfa, 0xe8, 0x51, 0x20, 0x20, 0x1b, 0x7b, 0x66,
-	0x90, 0x77, 0x19, 0xd8, 0x1b, 0xe8, 0xce, 0x04, 0x60, 0xea, 0x29, 0xeb, 0xbc, 0x67, 0x2c, 0x79,
-	0xac, 0x03, 0x0e, 0x4f, 0x5e, 0xb4, 0xb7, 0x0e, 0x4f, 0xde, 0xa5, 0x76, 0x3a, 0x69, 0xf2, 0x3c,
-	0x46, 0xf4, 0xb5, 0x04, 0x49, 0x51, 0x2c, 0x87, 0xdf, 0xda, 0xde, 0xd2, 0x3c, 0xfc, 0xd6, 0xf6,
-	0x55, 0xe0, 0x51, 0xe5, 0xce, 0xc5, 0xc7, 0x79, 0x87, 0x79, 0x14, 0xdf, 0x39, 0xff, 0x3b, 0x3b,
-	0x75, 0xde, 0xc9, 0x4a, 0xcf, 0x3b, 0x59, 0xe9, 0xaf, 0x4e, 0x56, 0x7a, 0x72, 0x91, 0x9d, 0x7a,
-	0x7e, 0x91, 0x9d, 0xfa, 0xfd, 0x22, 0x3b, 0xf5, 0xf1, 0x66, 0xa4, 0xb5, 0x6c, 0x19, 0x75, 0x5c,
-	0xa5, 0xea, 0x96, 0x91, 0xaf, 0x1d, 0x60, 0xd3, 0x52, 0x4f, 0x22, 0xc2, 0xac, 0xc9, 0x54, 0x67,
-	0xd9, 0x98, 0xbd, 0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xbe, 0xe5, 0xfd, 0x61, 0x0f,
-	0x00, 0x00,
+	// 1210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xc1, 0x6f, 0xe3, 0xc4,
+	0x17, 0xc7, 0xeb, 0x34, 0xed, 0x26, 0x2f, 0xdb, 0xfe, 0xfa, 0x1b, 0xb5, 0x25, 0x0d, 0xab, 0xa4,
+	0x35, 0x52, 0xdb, 0x85, 0xda, 0x6e, 0x53, 0x56, 0x2b, 0xb1, 0x8b, 0xc4, 0xa6, 0x61, 0x51, 0x40,
+	0x42, 0xc5, 0x14, 0x0e, 0xac, 0x44, 0x34, 0x89, 0x67, 0x5d, 0xb3, 0x89, 0xed, 0x7a, 0x9c, 0xb6,
+	0xd9, 0xd2, 0x0b, 0x77, 0xd0, 0x02, 0x42, 0x02, 0x09, 0x21, 0x21, 0x0e, 0xf0, 0x07, 0xec, 0x1f,
+	0x51, 0xed, 0x69, 0x25, 0x2e, 0x88, 0x43, 0x80, 0x94, 0x3f, 0x04, 0x79, 0x3c, 0x76, 0xdc, 0x34,
+	0x24, 0x6e, 0x38, 0xd9, 0x1e, 0xbf, 0xf7, 0x9d, 0xcf, 0xbc, 0x99, 0x79, 0xef, 0xc1, 0xca, 0x63,
+	0xbd, 0xae, 0xd4, 0xad, 0x66, 0xd3, 0x70, 0x5d, 0x42, 0x94, 0xc3, 0xad, 0x1a, 0x71, 0xf1, 0x96,
+	0x72, 0xd0, 0x22, 0x4e, 0x5b, 0xb6, 0x1d, 0xcb, 0xb5, 0xd0, 0xc2, 0x63, 0xbd, 0x2e, 0x87, 0x26,
+	0x32, 0x37, 0xc9, 0xbd, 0x5c, 0xb7, 0x68, 0xd3, 0xa2, 0x4a, 0x0d, 0x53, 0xe2, 0xdb, 0x87, 0xde,
+	0x36, 0xd6, 0x0d, 0x13, 0xbb, 0x86, 0x65, 0xfa, 0x12, 0xb9, 0x25, 0xdf, 0xb6, 0xca, 0xbe, 0x14,
+	0xff, 0x83, 0xff, 0x9a, 0xd7, 0x2d, 0xdd, 0xf2, 0xc7, 0xbd, 0x37, 0x3e, 0x7a, 0x43, 0xb7, 0x2c,
+	0xbd, 0x41, 0x14, 0x6c, 0x1b, 0x0a, 0x36, 0x4d, 0xcb, 0x65, 0x6a, 0x81, 0xcf, 0x12, 0xff, 0xcb,
+	0xbe, 0x6a, 0xad, 0x87, 0x0a, 0x36, 0x39, 0x6c, 0xae, 0xd0, 0xff, 0xcb, 0x35, 0x9a, 0x84, 0xba,
+	0xb8, 0x69, 0x73, 0x83, 0x97, 0x06, 0x2f, 0x58, 0x27, 0x26, 0xa1, 0x06, 0x9f, 0x40, 0xcc, 0xc2,
+	0xe2, 0x7b, 0xde, 0x8a, 0x76, 0x02, 0x3b, 0xaa, 0x92, 0x83, 0x16, 0xa1, 0xae, 0xf8, 0x31, 0xbc,
+	0x70, 0xe9, 0x0f, 0xb5, 0x2d, 0x93, 0x12, 0xb4, 0x03, 0x10, 0xea, 0xd2, 0xac, 0xb0, 0x3c, 0xb9,
+	0x9e, 0x29, 0xce, 0xcb, 0x3e, 0x8f, 0x1c, 0xf0, 0xc8, 0xf7, 0xcc, 0x76, 0x69, 0xe6, 0xd9, 0x53,
+	0x29, 0x1d, 0x2a, 0xa8, 0x11, 0x37, 0xf1, 0x35, 0x58, 0xb8, 0xa8, 0xcf, 0x27, 0x46, 0x2b, 0x70,
+	0x3d, 0x34, 0xab, 0x1a, 0x5a, 0x56, 0x58, 0x16, 0xd6, 0x93, 0x6a, 0x26, 0x1c, 0xab, 0x68, 0xe2,
+	0x83, 0x7e, 0xea, 0x10, 0xed, 0x1e, 0xa4, 0x43, 0x43, 0xe6, 0x19, 0x93, 0xac, 0xe7, 0x15, 0x82,
+	0xed, 0x3a, 0x96, 0x6d, 0x51, 0xdc, 0xa0, 0x57, 0x00, 0xfb, 0x04, 0x16, 0xfb, 0x7d, 0x39, 0xd8,
+	0x2e, 0xa4, 0xed, 0x60, 0x90, 0x87, 0x6c, 0x43, 0x1e, 0x78, 0xde, 0xe4, 0x0b, 0x0a, 0x81, 0x40,
+	0x29, 0x79, 0xd6, 0x29, 0x4c, 0xa8, 0x3d, 0x11, 0xf1, 0x36, 0xcc, 0xf7, 0x59, 0xfa, 0x98, 0x05,
+	0xc8, 0x04, 0x46, 0x3d, 0x4a, 0x08, 0x86, 0x2a, 0x9a, 0xf8, 0x79, 0x02, 0x16, 0x06, 0xce, 0x81,
+	0x1e, 0xc2, 0x75, 0xbb, 0x55, 0xab, 0x06, 0xb6, 0x43, 0x03, 0x28, 0x75, 0x3b, 0x85, 0xcc, 0x6e,
+	0xab, 0x16, 0x88, 0x3c, 0x7b, 0x2a, 0xe5, 0xf8, 0x79, 0xd7, 0xad, 0xc3, 0x70, 0x31, 0x3b, 0x96,
+	0xe9, 0x12, 0xd3, 0x55, 0x33, 0x76, 0xcf, 0x14, 0x2d, 0x42, 0xc2, 0xd0, 0xb2, 0x09, 0x8f, 0xac,
+	0x34, 0xdd, 0xed, 0x14, 0x12, 0x95, 0xb2, 0x9a, 0x30, 0x34, 0x54, 0xec, 0x8b, 0xf0, 0x24, 0xb3,
+	0xf8, 0x9f, 0x37, 0x53, 0xb8, 0x55, 0x95, 0xf2, 0x85, 0x90, 0xa3, 0x37, 0x20, 0xa5, 0x11, 0xac,
+	0x35, 0x0c, 0x93, 0x64, 0x93, 0x8c, 0x37, 0x77, 0x89, 0x77, 0x2f, 0xb8, 0x1a, 0xa5, 0x94, 0x17,
+	0xc5, 0x27, 0x7f, 0x14, 0x04, 0x35, 0xf4, 0x12, 0x6f, 0x40, 0x8e, 0x85, 0xe3, 0x5d, 0x72, 0xec,
+	0x06, 0x88, 0x95, 0x72, 0x70, 0x0f, 0x1e, 0xc0, 0x8b, 0x03, 0xff, 0xf2, 0x90, 0xdd, 0x85, 0x39,
+	0x93, 0x1c, 0xbb, 0xd5, 0x4b, 0x21, 0x2f, 0xa1, 0x6e, 0xa7, 0x30, 0xdb, 0xe7, 0x35, 0x6b, 0x46,
+	0xbf, 0x35, 0xf1, 0x53, 0xf8, 0x3f, 0x13, 0xff, 0xd0, 0x72, 0x09, 0x8d, 0xbb, 0x81, 0xe8, 0x3e,
+	0x40, 0x2f, 0xf1, 0xb0, 0x30, 0x66, 0x8a, 0xab, 0x32, 0x0f, 0xbe, 0x97, 0xa5, 0x64, 0x3f, 0xab,
+	0x05, 0x7b, 0xb0, 0x8b, 0xf5, 0xe0, 0x76, 0xa9, 0x11, 0x4f, 0xf1, 0x27, 0x01, 0x50, 0x74, 0x7a,
+	0xbe, 0xa4, 0x32, 0x4c, 0x1d, 0x7a, 0x03, 0xfc, 0x98, 0xae, 0x0f, 0x3b, 0xa6, 0x9e, 0x67, 0xdf,
+	0x11, 0xf5, 0x9d, 0xd1, 0x5b, 0x03, 0x20, 0xd7, 0x46, 0x42, 0xfa, 0x4a, 0x17, 0x28, 0x2b, 0x30,
+	0x17, 0x99, 0x2a, 0x66, 0x88, 0xe6, 0xfd, 0x35, 0x38, 0x6c, 0xe2, 0xb4, 0xcf, 0xe4, 0x88, 0xdf,
+	0x0a, 0x91, 0x78, 0x87, 0xeb, 0x55, 0x06, 0x88, 0x95, 0x66, 0xbb, 0x9d, 0x02, 0x44, 0x76, 0x6e,
+	0xa4, 0x38, 0xba, 0x0b, 0x69, 0xef, 0xa5, 0xea, 0xb6, 0x6d, 0xc2, 0x4e, 0xee, 0x6c, 0xb1, 0xf0,
+	0x2f, 0xa1, 0xf3, 0xa6, 0xdf, 0x6b, 0xdb, 0x44, 0x4d, 0x1d, 0xf2, 0x37, 0xf1, 0x55, 0x4e, 0xb6,
+	0x87, 0x1b, 0x8d, 0x76, 0xec, 0xab, 0xfc, 0x4b, 0x12, 0x50, 0xd4, 0x6d, 0xdc, 0x15, 0xbd, 0x03,
+	0xe9, 0x36, 0xa1, 0x55, 0x7f, 0xdb, 0xd9, 0xaa, 0x4a, 0xb2, 0xb7, 0x99, 0xbf, 0x77, 0x0a, 0xab,
+	0xba, 0xe1, 0xee, 0xb7, 0x6a, 0xde, 0x2a, 0x78, 0x3d, 0xe3, 0x0f, 0x89, 0x6a, 0x8f, 0x14, 0x6f,
+	0xb1, 0x54, 0x2e, 0x93, 0xba, 0x9a, 0x6a, 0x13, 0xca, 0xce, 0x11, 0xaa, 0x40, 0xca, 0xb4, 0xb8,
+	0xd6, 0xe4, 0x58, 0x5a, 0xd7, 0x4c, 0xcb, 0x97, 0x7a, 0x1f, 0x66, 0xea, 0x2d, 0xc7, 0x21, 0xa6,
+	0xcb, 0xf5, 0x92, 0x63, 0xe9, 0x5d, 0xe7, 0x22, 0xbe, 0xe8, 0x07, 0x30, 0x6b, 0x5b, 0x94, 0x1a,
+	0xb5, 0x06, 0xe1, 0xaa, 0x53, 0x63, 0xa9, 0xce, 0x04, 0x2a, 0xa1, 0xac, 0xbf, 0xff, 0xfb, 0x0e,
+	0xa1, 0xfb, 0x56, 0x43, 0xcb, 0x4e, 0x8f, 0x27, 0xcb, 0xce, 0x44, 0x20, 0x82, 0xee, 0xc3, 0xf4,
+	0x41, 0xcb, 0x72, 0x5a, 0xcd, 0xec, 0xb5, 0xb1, 0xe4, 0xb8, 0xb7, 0xf8, 0x26, 0x4f, 0xfa, 0x2a,
+	0x3e, 0xda, 0xc5, 0x0e, 0x6e, 0x86, 0xe9, 0x26, 0x07, 0x29, 0xda, 0xaa, 0x51, 0x1b, 0xd7, 0xfd,
+	0x8a, 0x99, 0x56, 0xc3, 0x6f, 0x34, 0x07, 0x93, 0x8f, 0x48, 0x9b, 0x9f, 0x73, 0xef, 0x55, 0xdc,
+	0x86, 0xc5, 0x7e, 0x19, 0x7e, 0xe8, 0x96, 0x20, 0xe5, 0xe0, 0xa3, 0xaa, 0x86, 0x5d, 0xcc, 0x75,
+	0xae, 0x39, 0xf8, 0xa8, 0x8c, 0x5d, 0x5c, 0xfc, 0x22, 0x03, 0x53, 0xcc, 0x0b, 0x7d, 0x23, 0x00,
+	0xf4, 0x3a, 0x0a, 0x24, 0x0d, 0xcb, 0x2d, 0x97, 0x7a, 0x92, 0x9c, 0x1c, 0xd7, 0xdc, 0x47, 0x12,
+	0xd7, 0x3f, 0xfb, 0xf5, 0xef, 0xaf, 0x13, 0x22, 0x5a, 0x56, 0x36, 0xf5, 0x01, 0xad, 0x50, 0xbd,
+	0x07, 0xf2, 0xa3, 0x00, 0xbd, 0x6e, 0x00, 0x6d, 0xc4, 0x9a, 0x27, 0xa0, 0x92, 0x62, 0x5a, 0x73,
+	0xa8, 0xdb, 0x0c, 0x6a, 0x0b, 0x29, 0xa3, 0xa0, 0x94, 0x93, 0x68, 0x31, 0x3c, 0x45, 0x5f, 0x0a,
+	0x90, 0x0e, 0x1b, 0x0b, 0x14, 0xab, 0x7b, 0xa0, 0xb1, 0x18, 0x2f, 0x75, 0x2b, 0xe2, 0x1a, 0x63,
+	0x5c, 0x41, 0x85, 0xc1, 0x8c, 0x61, 0x13, 0x82, 0xbe, 0x17, 0x20, 0x15, 0x96, 0xf5, 0x57, 0xe2,
+	0x35, 0x34, 0x3e, 0xd1, 0x95, 0xba, 0x1f, 0xf1, 0x16, 0x03, 0x52, 0x90, 0x34, 0x02, 0x48, 0x39,
+	0x89, 0x24, 0xbe, 0x53, 0xf4, 0xb3, 0x00, 0x7d, 0x25, 0x18, 0x6d, 0x0d, 0x9b, 0x77, 0x60, 0x0b,
+	0x90, 0x2b, 0x5e, 0xc5, 0x85, 0x03, 0xcb, 0x0c, 0x78, 0x1d, 0xad, 0x0e, 0x06, 0xf6, 0xfa, 0x00,
+	0x29, 0x40, 0x95, 0x0c, 0x0d, 0x7d, 0x27, 0xc0, 0x94, 0x9f, 0x47, 0x46, 0xd6, 0xdb, 0x70, 0x53,
+	0x6f, 0xc6, 0xb0, 0xe4, 0x38, 0x77, 0x18, 0xce, 0x2d, 0xb4, 0x7d, 0xa5, 0xf8, 0x29, 0x7e, 0x29,
+	0xff, 0x41, 0x80, 0xa4, 0x27, 0x87, 0xd6, 0x46, 0xb7, 0x02, 0x3e, 0x59, 0xec, 0x9e, 0x41, 0xdc,
+	0x61, 0x60, 0xaf, 0xa3, 0x3b, 0x63, 0x80, 0x29, 0x27, 0xde, 0xc3, 0x39, 0x65, 0xc1, 0x63, 0x15,
+	0x70, 0x78, 0xf0, 0xa2, 0xb5, 0x35, 0x77, 0x33, 0x86, 0xe5, 0x7f, 0x0b, 0x9e, 0xcb, 0x88, 0xbe,
+	0x12, 0x20, 0x1d, 0x26, 0xcb, 0xe1, 0xb7, 0xb6, 0x3f, 0x35, 0xe7, 0xa4, 0x98, 0xd6, 0xf1, 0xd2,
+	0x9d, 0x83, 0x8f, 0x24, 0x9b, 0x79, 0x94, 0xde, 0x3e, 0xfb, 0x2b, 0x3f, 0x71, 0xd6, 0xcd, 0x0b,
+	0xcf, 0xbb, 0x79, 0xe1, 0xcf, 0x6e, 0x5e, 0x78, 0x72, 0x9e, 0x9f, 0x78, 0x7e, 0x9e, 0x9f, 0xf8,
+	0xed, 0x3c, 0x3f, 0xf1, 0xd1, 0x46, 0xa4, 0xb4, 0x6c, 0xea, 0x0d, 0x5c, 0xa3, 0xca, 0xa6, 0x2e,
+	0xd5, 0xf7, 0xb1, 0x61, 0x2a, 0xc7, 0x11, 0x61, 0x56, 0x64, 0x6a, 0xd3, 0xac, 0xcd, 0xde, 0xfe,
+	0x67, 0x00, 0xd5, 0xbe, 0xe5, 0xfd, 0x61, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
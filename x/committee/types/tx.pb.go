@@ -195,7 +195,7 @@ func init() {
 func init() { proto.RegisterFile("zgc/committee/v1beta1/tx.proto", fileDescriptor_323a2f7ecd37af6f) }
 
 var fileDescriptor_323a2f7ecd37af6f = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
+	// 457 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
 	0x10, 0xcd, 0xd2, 0x02, 0xed, 0xb8, 0x4a, 0x55, 0x2b, 0x48, 0x89, 0x0f, 0x9b, 0x28, 0x48, 0x28,
 	0x07, 0xba, 0x9b, 0x86, 0x2b, 0x17, 0xd2, 0x5e, 0x82, 0x08, 0x42, 0x06, 0x81, 0xc4, 0x25, 0xb2,
@@ -207,24 +207,24 @@ var fileDescriptor_323a2f7ecd37af6f = []byte{
 	0x29, 0x27, 0x9c, 0x96, 0x44, 0x89, 0x65, 0x3d, 0x8f, 0xaa, 0x6a, 0x47, 0x22, 0xca, 0x85, 0xe0,
 	0x45, 0xe6, 0x27, 0x1f, 0xb8, 0x17, 0xa5, 0x55, 0xeb, 0xe1, 0xcd, 0x12, 0xa4, 0x88, 0x84, 0x0a,
 	0x2b, 0xd6, 0xfe, 0x17, 0x02, 0x07, 0x53, 0x25, 0x5f, 0x27, 0xfe, 0x32, 0xd4, 0xaf, 0x56, 0x18,
-	0xa3, 0xf2, 0x16, 0xf6, 0x3b, 0xd8, 0x8b, 0x13, 0x3f, 0x57, 0x51, 0xe4, 0x6d, 0xd2, 0x23, 0x03,
-	0x6b, 0xd4, 0x62, 0xe5, 0x32, 0x66, 0x96, 0xb1, 0x67, 0x51, 0x3a, 0xa6, 0xdf, 0x3e, 0x1f, 0x3a,
-	0x95, 0x52, 0x89, 0x6b, 0x63, 0x85, 0x1d, 0x63, 0xa4, 0x45, 0xa4, 0x5d, 0x2b, 0x4e, 0xfc, 0x9a,
-	0xd8, 0x81, 0x9d, 0x92, 0x54, 0xac, 0xda, 0x77, 0x7a, 0x64, 0xb0, 0xeb, 0xd6, 0xb9, 0x3d, 0x82,
-	0xbd, 0x5a, 0xed, 0x2c, 0x9c, 0xb7, 0xb7, 0x7a, 0x64, 0xb0, 0x3d, 0xde, 0xcf, 0xae, 0xba, 0xd6,
-	0xb1, 0xa9, 0x4f, 0x4e, 0x5c, 0xab, 0x06, 0x4d, 0xe6, 0xfd, 0x17, 0xd0, 0xb9, 0xa6, 0xde, 0x15,
-	0x2a, 0xc6, 0x48, 0x09, 0x9b, 0x83, 0x65, 0x1c, 0xe4, 0x7c, 0xa4, 0xe0, 0x6b, 0x66, 0x57, 0x5d,
-	0x30, 0xd0, 0xc9, 0x89, 0x0b, 0x06, 0x32, 0x99, 0xf7, 0x3f, 0x12, 0xb8, 0x3f, 0x55, 0xf2, 0x2d,
-	0xea, 0xff, 0x1f, 0xb6, 0x5b, 0x70, 0x77, 0x8d, 0xba, 0xf6, 0x55, 0x26, 0xf6, 0x53, 0xd8, 0xcd,
-	0x83, 0x99, 0x4e, 0x63, 0x51, 0x38, 0x6a, 0x8e, 0xba, 0xec, 0xc6, 0xb7, 0x67, 0xf9, 0xda, 0x37,
-	0x69, 0x2c, 0xdc, 0x9d, 0x75, 0x15, 0xf5, 0x0f, 0x60, 0xbf, 0xd2, 0x63, 0x4c, 0x8d, 0xbe, 0x12,
-	0xd8, 0x9a, 0x2a, 0x69, 0x2f, 0xa0, 0xf9, 0xc7, 0xa3, 0x0d, 0x6e, 0xe1, 0xbd, 0x76, 0x20, 0x67,
-	0xf8, 0xaf, 0xc8, 0xfa, 0x94, 0x2f, 0x61, 0xbb, 0xb8, 0x0a, 0xbd, 0x7d, 0x32, 0xef, 0x3b, 0x8f,
-	0xfe, 0xde, 0x37, 0x7c, 0xe3, 0xe7, 0x17, 0x3f, 0x69, 0xe3, 0x22, 0xa3, 0xe4, 0x32, 0xa3, 0xe4,
-	0x47, 0x46, 0xc9, 0xa7, 0x0d, 0x6d, 0x5c, 0x6e, 0x68, 0xe3, 0xfb, 0x86, 0x36, 0xde, 0x3f, 0x96,
-	0xa1, 0x3e, 0x4d, 0xfc, 0x9c, 0x87, 0x0f, 0xe5, 0xc2, 0xf3, 0x15, 0x1f, 0xca, 0xc3, 0xe0, 0xd4,
-	0x0b, 0x23, 0x7e, 0xf6, 0xdb, 0x97, 0xce, 0x8f, 0xaa, 0xfc, 0x7b, 0xc5, 0x77, 0x7c, 0xf2, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0xbf, 0x24, 0x94, 0x73, 0x03, 0x00, 0x00,
+	0xa3, 0xf2, 0x16, 0xf6, 0x3b, 0xd8, 0x8b, 0x13, 0x7f, 0x16, 0x57, 0x79, 0x9b, 0xf4, 0xc8, 0xc0,
+	0x1a, 0xb5, 0x58, 0xb9, 0x8c, 0x99, 0x65, 0xec, 0x59, 0x94, 0x8e, 0xe9, 0xb7, 0xcf, 0x87, 0x4e,
+	0xa5, 0x54, 0xe2, 0xda, 0x58, 0x61, 0xc7, 0x18, 0x69, 0x11, 0x69, 0xd7, 0x8a, 0x13, 0xbf, 0x26,
+	0x76, 0x60, 0xa7, 0x24, 0x15, 0xab, 0xf6, 0x9d, 0x1e, 0x19, 0xec, 0xba, 0x75, 0x6e, 0x8f, 0x60,
+	0xaf, 0x56, 0x3b, 0x0b, 0xe7, 0xed, 0xad, 0x1e, 0x19, 0x6c, 0x8f, 0xf7, 0xb3, 0xab, 0xae, 0x75,
+	0x6c, 0xea, 0x93, 0x13, 0xd7, 0xaa, 0x41, 0x93, 0x79, 0xff, 0x05, 0x74, 0xae, 0xa9, 0x77, 0x85,
+	0x8a, 0x31, 0x52, 0xc2, 0xe6, 0x60, 0x19, 0x07, 0x39, 0x1f, 0x29, 0xf8, 0x9a, 0xd9, 0x55, 0x17,
+	0x0c, 0x74, 0x72, 0xe2, 0x82, 0x81, 0x4c, 0xe6, 0xfd, 0x8f, 0x04, 0xee, 0x4f, 0x95, 0x7c, 0x8b,
+	0xfa, 0xff, 0x87, 0xed, 0x16, 0xdc, 0x5d, 0xa3, 0xae, 0x7d, 0x95, 0x89, 0xfd, 0x14, 0x76, 0xf3,
+	0x60, 0xa6, 0xd3, 0x58, 0x14, 0x8e, 0x9a, 0xa3, 0x2e, 0xbb, 0xf1, 0xed, 0x59, 0xbe, 0xf6, 0x4d,
+	0x1a, 0x0b, 0x77, 0x67, 0x5d, 0x45, 0xfd, 0x03, 0xd8, 0xaf, 0xf4, 0x18, 0x53, 0xa3, 0xaf, 0x04,
+	0xb6, 0xa6, 0x4a, 0xda, 0x0b, 0x68, 0xfe, 0xf1, 0x68, 0x83, 0x5b, 0x78, 0xaf, 0x1d, 0xc8, 0x19,
+	0xfe, 0x2b, 0xb2, 0x3e, 0xe5, 0x4b, 0xd8, 0x2e, 0xae, 0x42, 0x6f, 0x9f, 0xcc, 0xfb, 0xce, 0xa3,
+	0xbf, 0xf7, 0x0d, 0xdf, 0xf8, 0xf9, 0xc5, 0x4f, 0xda, 0xb8, 0xc8, 0x28, 0xb9, 0xcc, 0x28, 0xf9,
+	0x91, 0x51, 0xf2, 0x69, 0x43, 0x1b, 0x97, 0x1b, 0xda, 0xf8, 0xbe, 0xa1, 0x8d, 0xf7, 0x8f, 0x65,
+	0xa8, 0x4f, 0x13, 0x3f, 0xe7, 0xe1, 0x43, 0xb9, 0xf0, 0x7c, 0xc5, 0x87, 0xf2, 0x30, 0x38, 0xf5,
+	0xc2, 0x88, 0x9f, 0xfd, 0xf6, 0xa5, 0xf3, 0xa3, 0x2a, 0xff, 0x5e, 0xf1, 0x1d, 0x9f, 0xfc, 0x1a,
+	0x00, 0xca, 0xbf, 0x24, 0x94, 0x73, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
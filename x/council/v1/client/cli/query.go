@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -10,6 +11,7 @@ import (
 	"github.com/0glabs/0g-chain/x/council/v1/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // GetQueryCmd returns the cli query commands for the inflation module.
@@ -25,6 +27,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCurrentCouncilID(),
 		GetRegisteredVoters(),
+		GetCouncil(),
+		GetVotes(),
+		GetVoter(),
+		GetElectionSchedule(),
 	)
 
 	return cmd
@@ -85,3 +91,132 @@ func GetRegisteredVoters() *cobra.Command {
 
 	return cmd
 }
+
+func GetCouncil() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "council [council-id]",
+		Short:   "Query a council by its ID",
+		Example: fmt.Sprintf("%s q %s council 2", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			councilID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("council-id %s not a valid uint, please input a valid council-id", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Council(context.Background(), &types.QueryCouncilRequest{CouncilId: councilID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes [council-id]",
+		Short: "Query the votes cast for a council",
+		Example: fmt.Sprintf(`%[1]s q %[2]s votes 2
+%[1]s q %[2]s votes 2 --limit 50 --page 2`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			councilID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("council-id %s not a valid uint, please input a valid council-id", args[0])
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Votes(context.Background(), &types.QueryVotesRequest{
+				CouncilId:  councilID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
+
+	return cmd
+}
+
+func GetVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter [validator-addr]",
+		Short: "Query the VRF public key registered by a voter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Voter(context.Background(), &types.QueryVoterRequest{Voter: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetElectionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "election-schedule",
+		Short: "Query the heights of the upcoming council election",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ElectionSchedule(context.Background(), &types.QueryElectionScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
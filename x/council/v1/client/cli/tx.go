@@ -2,19 +2,18 @@ package cli
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
 
-	sdkmath "cosmossdk.io/math"
 	"github.com/0glabs/0g-chain/crypto/vrf"
 	"github.com/0glabs/0g-chain/x/council/v1/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdkkr "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -34,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		NewRegisterCmd(),
+		NewUnregisterCmd(),
+		NewRotateKeyCmd(),
 		NewVoteCmd(),
 	)
 	return cmd
@@ -45,55 +46,62 @@ func NewRegisterCmd() *cobra.Command {
 		Short: "Register a voter",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// bypass the restriction of set keyring options
-			ctx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(vrf.VrfOption())
-			client.SetCmdClientContext(cmd, ctx)
-			clientCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, pubKey, err := newVoterKey(cmd)
 			if err != nil {
 				return err
 			}
 
-			kr := clientCtx.Keyring
-			// get account name by address
-			accAddr := clientCtx.GetFromAddress()
-			accRecord, err := kr.KeyByAddress(accAddr)
+			valAddr, err := sdk.ValAddressFromHex(hex.EncodeToString(clientCtx.GetFromAddress().Bytes()))
 			if err != nil {
-				// not found record by address in keyring
-				return nil
+				return err
 			}
 
-			// check voter account record exists
-			voterAccName := accRecord.Name + "-voter"
-			_, err = kr.Key(voterAccName)
-			if err == nil {
-				// account exists, ask for user confirmation
-				response, err2 := input.GetConfirmation(fmt.Sprintf("override the existing name %s", voterAccName), bufio.NewReader(clientCtx.Input), cmd.ErrOrStderr())
-				if err2 != nil {
-					return err2
-				}
-
-				if !response {
-					return errors.New("aborted")
-				}
-
-				err2 = kr.Delete(voterAccName)
-				if err2 != nil {
-					return err2
-				}
+			msg := &types.MsgRegister{
+				Voter: valAddr.String(),
+				Key:   pubKey.Bytes(),
 			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
-			keyringAlgos, _ := kr.SupportedAlgorithms()
-			algo, err := sdkkr.NewSigningAlgoFromString("vrf", keyringAlgos)
+func NewUnregisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister",
+		Short: "Unregister a voter, removing its vote in the ongoing election",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			newRecord, err := kr.NewAccount(voterAccName, "", "", "", algo)
+			valAddr, err := sdk.ValAddressFromHex(hex.EncodeToString(clientCtx.GetFromAddress().Bytes()))
 			if err != nil {
 				return err
 			}
 
-			pubKey, err := newRecord.GetPubKey()
+			msg := &types.MsgUnregister{
+				Voter: valAddr.String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRotateKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Replace the VRF key of a voter, the new key votes in the elections starting after the rotation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, pubKey, err := newVoterKey(cmd)
 			if err != nil {
 				return err
 			}
@@ -103,7 +111,7 @@ func NewRegisterCmd() *cobra.Command {
 				return err
 			}
 
-			msg := &types.MsgRegister{
+			msg := &types.MsgRotateKey{
 				Voter: valAddr.String(),
 				Key:   pubKey.Bytes(),
 			}
@@ -115,6 +123,64 @@ func NewRegisterCmd() *cobra.Command {
 	return cmd
 }
 
+// newVoterKey creates the VRF key of the from account in the keyring, named after the account with
+// a "-voter" suffix, replacing the existing one upon confirmation.
+func newVoterKey(cmd *cobra.Command) (client.Context, cryptotypes.PubKey, error) {
+	// bypass the restriction of set keyring options
+	ctx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(vrf.VrfOption())
+	client.SetCmdClientContext(cmd, ctx)
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return clientCtx, nil, err
+	}
+
+	kr := clientCtx.Keyring
+	// get account name by address
+	accAddr := clientCtx.GetFromAddress()
+	accRecord, err := kr.KeyByAddress(accAddr)
+	if err != nil {
+		// not found record by address in keyring
+		return clientCtx, nil, err
+	}
+
+	// check voter account record exists
+	voterAccName := accRecord.Name + "-voter"
+	_, err = kr.Key(voterAccName)
+	if err == nil {
+		// account exists, ask for user confirmation
+		response, err2 := input.GetConfirmation(fmt.Sprintf("override the existing name %s", voterAccName), bufio.NewReader(clientCtx.Input), cmd.ErrOrStderr())
+		if err2 != nil {
+			return clientCtx, nil, err2
+		}
+
+		if !response {
+			return clientCtx, nil, errors.New("aborted")
+		}
+
+		err2 = kr.Delete(voterAccName)
+		if err2 != nil {
+			return clientCtx, nil, err2
+		}
+	}
+
+	keyringAlgos, _ := kr.SupportedAlgorithms()
+	algo, err := sdkkr.NewSigningAlgoFromString("vrf", keyringAlgos)
+	if err != nil {
+		return clientCtx, nil, err
+	}
+
+	newRecord, err := kr.NewAccount(voterAccName, "", "", "", algo)
+	if err != nil {
+		return clientCtx, nil, err
+	}
+
+	pubKey, err := newRecord.GetPubKey()
+	if err != nil {
+		return clientCtx, nil, err
+	}
+	return clientCtx, pubKey, nil
+}
+
 func NewVoteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote council-id",
@@ -156,31 +222,26 @@ func NewVoteCmd() *cobra.Command {
 				return err
 			}
 
-			votingStartHeight := types.DefaultVotingStartHeight + (councilID-1)*types.DefaultVotingPeriod
-
-			rsp, err := stakingtypes.NewQueryClient(clientCtx).HistoricalInfo(cmd.Context(), &stakingtypes.QueryHistoricalInfoRequest{Height: int64(votingStartHeight)})
+			councilRsp, err := types.NewQueryClient(clientCtx).Council(cmd.Context(), &types.QueryCouncilRequest{CouncilId: councilID})
 			if err != nil {
 				return err
 			}
 
-			var tokens sdkmath.Int
-			for _, val := range rsp.Hist.Valset {
-				thisValAddr := val.GetOperator()
-
-				if thisValAddr.Equals(valAddr) {
-					tokens = val.GetTokens()
-				}
+			// the ballots allowed are counted from the bonded tokens when the vote is cast
+			valRsp, err := stakingtypes.NewQueryClient(clientCtx).Validator(cmd.Context(), &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
+			if err != nil {
+				return err
 			}
-			// the denom of token is evm denom, need to convert to A0GI
-			a0giTokenCnt := tokens.Quo(sdk.NewInt(1_000_000_000_000_000_000))
-			// 1_000 0AGI token / vote
-			numBallots := a0giTokenCnt.Quo(sdk.NewInt(1_000)).Uint64()
+			numBallots := types.BallotsForTokens(valRsp.Validator.GetTokens())
+			seed := councilRsp.Seed
 			ballots := make([]*types.Ballot, numBallots)
 			for i := range ballots {
 				ballotID := uint64(i)
+				content, proof := sk.Prove(types.BallotMessage(seed, ballotID))
 				ballots[i] = &types.Ballot{
 					ID:      ballotID,
-					Content: sk.Compute(bytes.Join([][]byte{rsp.Hist.Header.LastCommitHash, types.Uint64ToBytes(ballotID)}, nil)),
+					Content: content,
+					Proof:   proof,
 				}
 			}
 
@@ -22,6 +22,8 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs types.GenesisState) {
 		panic(errorsmod.Wrapf(err, "error setting params"))
 	}
 
+	keeper.SetVotingStartHeight(ctx, gs.VotingStartHeight)
+	keeper.SetVotingPeriod(ctx, gs.VotingPeriod)
 	keeper.SetCurrentCouncilID(ctx, gs.CurrentCouncilID)
 
 	for _, p := range gs.Councils {
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/0glabs/0g-chain/x/council/v1/types"
 )
 
 type Ballot struct {
@@ -15,18 +19,24 @@ type Ballot struct {
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	councilID, err := k.GetCurrentCouncilID(ctx)
 	if err != nil {
-		// TODO: handle the case where councilID is not available
-		return
+		// the council state was not set at genesis, start the elections from this block
+		k.Logger(ctx).Error("[BeginBlock] current council not set, starting a new one", "err", err)
+		councilID = 1
+		k.SetCurrentCouncilID(ctx, councilID)
 	}
-	council, bz := k.GetCouncil(ctx, councilID)
-	if !bz {
-		return
+	council, found := k.GetCouncil(ctx, councilID)
+	if !found {
+		k.Logger(ctx).Error("[BeginBlock] current council not found, starting a new one", "council", councilID)
+		council = k.bootstrapCouncil(ctx, councilID)
 	}
 
 	if ctx.BlockHeight() >= int64(council.StartHeight) {
-		// We are ready to accept votes for the next council
-		if err := k.StoreNewCouncil(ctx, council.StartHeight); err != nil {
-			return
+		// We are ready to accept votes for the next council, its seed is fixed once created
+		if _, found := k.GetCouncil(ctx, councilID+1); !found {
+			if err := k.StoreNewCouncil(ctx, council.StartHeight); err != nil {
+				k.Logger(ctx).Error("[BeginBlock] cannot store next council", "council", councilID+1, "err", err)
+				return
+			}
 		}
 	}
 
@@ -34,15 +44,49 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		return
 	}
 
-	k.IncrementCurrentCouncilID(ctx)
-	council, bz = k.GetCouncil(ctx, councilID+1)
-	if !bz {
+	next, found := k.GetCouncil(ctx, councilID+1)
+	if !found {
 		return
 	}
+	k.elect(ctx, &next, council.Members)
+	k.SetCouncil(ctx, next)
+	k.SetCurrentCouncilID(ctx, next.ID)
+}
 
+func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
+}
+
+// bootstrapCouncil stores a council without members or seed whose voting starts at the current
+// height, for chains whose council state is missing.
+func (k Keeper) bootstrapCouncil(ctx sdk.Context, councilID uint64) types.Council {
+	votingPeriod, err := k.GetVotingPeriod(ctx)
+	if err != nil {
+		votingPeriod = types.DefaultVotingPeriod
+		k.SetVotingPeriod(ctx, votingPeriod)
+	}
+	height := uint64(ctx.BlockHeight())
+	council := types.Council{
+		ID:                councilID,
+		VotingStartHeight: height,
+		StartHeight:       height + votingPeriod,
+		EndHeight:         height + votingPeriod*2,
+		Votes:             []types.Vote{},
+		Members:           []sdk.ValAddress{},
+	}
+	k.SetCouncil(ctx, council)
+	return council
+}
+
+// elect draws the members of a council from the ballots cast for it. Ballots are VRF outputs
+// verified when voting, so sorting them draws the voters at random, weighted by their ballots, and
+// each voter takes at most one seat. When fewer voters than seats took part, the under subscribed
+// policy either fills the remaining seats with previous members or leaves the council smaller, but
+// never smaller than the minimum council size. Only previous members that are still registered
+// voters are carried over.
+func (k Keeper) elect(ctx sdk.Context, council *types.Council, previous []sdk.ValAddress) {
 	ballots := []Ballot{}
 	seen := make(map[string]struct{})
-	for _, vote := range council.Votes {
+	for _, vote := range k.GetVotesByCouncil(ctx, council.ID) {
 		for _, ballot := range vote.Ballots {
 			ballot := Ballot{
 				voter:   vote.Voter,
@@ -59,14 +103,54 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		return ballots[i].content < ballots[j].content
 	})
 
-	councilSize := k.GetParams(ctx).CouncilSize
-	council.Members = make([]sdk.ValAddress, councilSize)
-	for i := 0; i < int(councilSize); i = i + 1 {
-		council.Members[i] = ballots[i].voter
+	params := k.GetParams(ctx)
+	councilSize := int(params.CouncilSize)
+	members := make([]sdk.ValAddress, 0, councilSize)
+	seated := make(map[string]struct{})
+	for _, ballot := range ballots {
+		if len(members) == councilSize {
+			break
+		}
+		if _, ok := seated[ballot.voter.String()]; ok {
+			continue
+		}
+		members = append(members, ballot.voter)
+		seated[ballot.voter.String()] = struct{}{}
 	}
+	elected := len(members)
 
-	k.SetCouncil(ctx, council)
-}
+	carryOver := int(params.MinCouncilSize)
+	if params.UnderSubscribedPolicy == types.UNDER_SUBSCRIBED_POLICY_CARRY_OVER {
+		carryOver = councilSize
+	}
+	for _, member := range previous {
+		if len(members) >= carryOver {
+			break
+		}
+		if _, ok := seated[member.String()]; ok {
+			continue
+		}
+		// members removed or unregistered since their election no longer hold a voter key
+		if _, found := k.GetVoter(ctx, member); !found {
+			continue
+		}
+		members = append(members, member)
+		seated[member.String()] = struct{}{}
+	}
+	council.Members = members
 
-func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
+	addresses := make([]string, len(members))
+	for i, member := range members {
+		addresses[i] = member.String()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeElection,
+			sdk.NewAttribute(types.AttributeKeyCouncilID, fmt.Sprintf("%d", council.ID)),
+			sdk.NewAttribute(types.AttributeKeyBallots, fmt.Sprintf("%d", len(ballots))),
+			sdk.NewAttribute(types.AttributeKeyElected, fmt.Sprintf("%d", elected)),
+			sdk.NewAttribute(types.AttributeKeyCarriedOver, fmt.Sprintf("%d", len(members)-elected)),
+			sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(addresses, ",")),
+		),
+	)
 }
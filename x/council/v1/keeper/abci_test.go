@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+func (suite *KeeperTestSuite) setCouncilParams(size uint64, minSize uint64, policy types.UnderSubscribedPolicy) {
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.Params{
+		CouncilSize:           size,
+		UnderSubscribedPolicy: policy,
+		MinCouncilSize:        minSize,
+	}))
+}
+
+func memberStrings(members []sdk.ValAddress) []string {
+	addresses := make([]string, len(members))
+	for i, member := range members {
+		addresses[i] = member.String()
+	}
+	return addresses
+}
+
+func (suite *KeeperTestSuite) TestBeginBlock_Election() {
+	const (
+		carryOver = types.UNDER_SUBSCRIBED_POLICY_CARRY_OVER
+		shrink    = types.UNDER_SUBSCRIBED_POLICY_SHRINK
+	)
+	testCases := []struct {
+		name        string
+		size        uint64
+		minSize     uint64
+		policy      types.UnderSubscribedPolicy
+		ballots     []uint64 // ballots cast by each voter
+		previous    []int    // voters seated in the current council, -1 for a non voting member
+		removed     []int    // previous members unregistered before the election
+		elected     int
+		carriedOver int
+	}{
+		{"fully subscribed", 2, 0, carryOver, []uint64{1, 1, 1}, nil, nil, 2, 0},
+		{"exactly subscribed", 3, 0, shrink, []uint64{2, 1, 1}, nil, nil, 3, 0},
+		{"voter takes a single seat", 2, 0, shrink, []uint64{3}, nil, nil, 1, 0},
+		{"under subscribed carries previous members over", 3, 0, carryOver, []uint64{1}, []int{-1, -1}, nil, 1, 2},
+		{"under subscribed keeps seats for previous members only", 3, 0, carryOver, []uint64{1}, []int{-1, -1, -1}, nil, 1, 2},
+		{"elected previous member is seated once", 2, 0, carryOver, []uint64{1}, []int{0, -1}, nil, 1, 1},
+		{"under subscribed shrinks", 3, 0, shrink, []uint64{1, 1}, []int{-1, -1}, nil, 2, 0},
+		{"no votes carries the council over", 2, 0, carryOver, nil, []int{-1, -1}, nil, 0, 2},
+		{"no votes shrinks to an empty council", 2, 0, shrink, nil, []int{-1, -1}, nil, 0, 0},
+		{"no votes and no previous members", 2, 0, carryOver, nil, nil, nil, 0, 0},
+		{"no votes shrinks to the minimum council size", 2, 1, shrink, nil, []int{-1, -1}, nil, 0, 1},
+		{"under subscribed shrinks to the minimum council size", 3, 2, shrink, []uint64{1}, []int{-1, -1}, nil, 1, 1},
+		{"removed previous members are not carried over", 3, 0, carryOver, []uint64{1}, []int{-1, -1, -1}, []int{0}, 1, 2},
+		{"removed previous members do not keep the minimum council size", 2, 2, shrink, nil, []int{-1, -1}, []int{1}, 0, 1},
+		{"no registered previous members leaves the council empty", 2, 1, shrink, nil, []int{-1}, []int{0}, 0, 0},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setCouncilParams(tc.size, tc.minSize, tc.policy)
+
+			voters := make([]sdk.ValAddress, len(tc.ballots))
+			keys := make([]vrfalgo.PrivateKey, len(tc.ballots))
+			for i, n := range tc.ballots {
+				voters[i], keys[i] = suite.createVoter(int64(n))
+			}
+			previous := make([]sdk.ValAddress, len(tc.previous))
+			for i, index := range tc.previous {
+				if index < 0 {
+					previous[i], _ = suite.createVoter(1)
+				} else {
+					previous[i] = voters[index]
+				}
+			}
+			current, found := suite.keeper.GetCouncil(suite.ctx, 1)
+			suite.Require().True(found)
+			current.Members = previous
+			suite.keeper.SetCouncil(suite.ctx, current)
+			removed := make([]string, len(tc.removed))
+			for i, index := range tc.removed {
+				suite.Require().NoError(suite.keeper.RemoveVoter(suite.ctx, previous[index]))
+				removed[i] = previous[index].String()
+			}
+
+			suite.advance(1 + votingPeriod)
+			next, found := suite.keeper.GetCouncil(suite.ctx, 2)
+			suite.Require().True(found)
+			for i, n := range tc.ballots {
+				ids := make([]uint64, n)
+				for id := range ids {
+					ids[id] = uint64(id)
+				}
+				suite.Require().NoError(suite.keeper.AddVote(suite.ctx, next.ID, voters[i], makeBallots(keys[i], next.Seed, ids...)))
+			}
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.advance(1 + votingPeriod*2)
+			id, err := suite.keeper.GetCurrentCouncilID(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(2), id)
+
+			next, found = suite.keeper.GetCouncil(suite.ctx, 2)
+			suite.Require().True(found)
+			suite.Require().Len(next.Members, tc.elected+tc.carriedOver)
+			seats := make(map[string]struct{})
+			for i, member := range next.Members {
+				_, ok := seats[member.String()]
+				suite.Require().False(ok, "member %s seated twice", member)
+				seats[member.String()] = struct{}{}
+				suite.Require().NotContains(removed, member.String())
+				if i >= tc.elected {
+					suite.Require().Contains(memberStrings(previous), member.String())
+				} else {
+					suite.Require().Contains(memberStrings(voters), member.String())
+				}
+			}
+
+			var ballots uint64
+			for _, n := range tc.ballots {
+				ballots += n
+			}
+			suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeElection,
+				sdk.NewAttribute(types.AttributeKeyCouncilID, "2"),
+				sdk.NewAttribute(types.AttributeKeyBallots, fmt.Sprintf("%d", ballots)),
+				sdk.NewAttribute(types.AttributeKeyElected, fmt.Sprintf("%d", tc.elected)),
+				sdk.NewAttribute(types.AttributeKeyCarriedOver, fmt.Sprintf("%d", tc.carriedOver)),
+				sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(memberStrings(next.Members), ",")),
+			)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeginBlock_MissingCouncil() {
+	// the council state was not set at genesis
+	store := suite.ctx.KVStore(suite.app.GetKVStoreKey(types.StoreKey))
+	store.Delete(types.CurrentCouncilIDKey)
+	store.Delete(append(types.CouncilKeyPrefix, types.GetKeyFromID(1)...))
+
+	suite.Require().NotPanics(func() {
+		suite.advance(2)
+	})
+	id, err := suite.keeper.GetCurrentCouncilID(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), id)
+	council, found := suite.keeper.GetCouncil(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), council.VotingStartHeight)
+	suite.Require().Equal(uint64(2+votingPeriod), council.StartHeight)
+	suite.Require().Empty(council.Members)
+
+	// the elections go on from the new council, voting for the following one starts a block later
+	suite.advance(2 + votingPeriod*2)
+	id, err = suite.keeper.GetCurrentCouncilID(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), id)
+	suite.advance(3 + votingPeriod*2)
+	_, found = suite.keeper.GetCouncil(suite.ctx, 3)
+	suite.Require().True(found)
+}
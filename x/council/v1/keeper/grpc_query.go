@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"github.com/0glabs/0g-chain/x/council/v1/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -33,3 +37,108 @@ func (k Keeper) RegisteredVoters(
 	}
 	return &types.QueryRegisteredVotersResponse{Voters: voters}, nil
 }
+
+func (k Keeper) Council(
+	c context.Context,
+	request *types.QueryCouncilRequest,
+) (*types.QueryCouncilResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	council, found := k.GetCouncil(ctx, request.CouncilId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "council %d not found", request.CouncilId)
+	}
+	members := make([]string, len(council.Members))
+	for i, member := range council.Members {
+		members[i] = member.String()
+	}
+	return &types.QueryCouncilResponse{
+		CouncilID:         council.ID,
+		VotingStartHeight: council.VotingStartHeight,
+		StartHeight:       council.StartHeight,
+		EndHeight:         council.EndHeight,
+		Members:           members,
+		Seed:              council.Seed,
+	}, nil
+}
+
+func (k Keeper) Votes(
+	c context.Context,
+	request *types.QueryVotesRequest,
+) (*types.QueryVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetCouncil(ctx, request.CouncilId); !found {
+		return nil, status.Errorf(codes.NotFound, "council %d not found", request.CouncilId)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.VoteKeyPrefix, types.GetKeyFromID(request.CouncilId)...))
+	votes := make([]types.VoteResponse, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, types.VoteResponse{
+			CouncilID: vote.CouncilID,
+			Voter:     vote.Voter.String(),
+			Ballots:   vote.Ballots,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Voter(
+	c context.Context,
+	request *types.QueryVoterRequest,
+) (*types.QueryVoterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	voter, err := sdk.ValAddressFromBech32(request.Voter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid voter address: %s", err)
+	}
+	pk, found := k.GetVoter(ctx, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "voter %s not registered", request.Voter)
+	}
+	return &types.QueryVoterResponse{
+		Voter:            voter.String(),
+		PublicKey:        pk,
+		RegisteredHeight: k.GetVoterHeight(ctx, voter),
+	}, nil
+}
+
+func (k Keeper) ElectionSchedule(
+	c context.Context,
+	_ *types.QueryElectionScheduleRequest,
+) (*types.QueryElectionScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	currentCouncilID, err := k.GetCurrentCouncilID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, found := k.GetCouncil(ctx, currentCouncilID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "council %d not found", currentCouncilID)
+	}
+	response := types.QueryElectionScheduleResponse{
+		CurrentCouncilID:  current.ID,
+		NextCouncilID:     current.ID + 1,
+		VotingStartHeight: current.StartHeight,
+		ElectionHeight:    current.EndHeight,
+	}
+	// the next council is stored once its voting starts, until then its heights are projected from
+	// the voting period
+	if next, found := k.GetCouncil(ctx, current.ID+1); found {
+		response.VotingStartHeight = next.VotingStartHeight
+		response.VotingEndHeight = next.StartHeight
+	} else {
+		votingPeriod, err := k.GetVotingPeriod(ctx)
+		if err != nil {
+			return nil, err
+		}
+		response.VotingEndHeight = current.StartHeight + votingPeriod
+	}
+	return &response, nil
+}
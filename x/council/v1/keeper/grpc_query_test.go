@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestCouncilQuery() {
+	voter, _ := suite.createVoter(1)
+	current, found := suite.keeper.GetCouncil(suite.ctx, 1)
+	suite.Require().True(found)
+	current.Members = []sdk.ValAddress{voter}
+	current.Seed = []byte("seed")
+	suite.keeper.SetCouncil(suite.ctx, current)
+
+	res, err := suite.keeper.Council(sdk.WrapSDKContext(suite.ctx), &types.QueryCouncilRequest{CouncilId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCouncilResponse{
+		CouncilID:         1,
+		VotingStartHeight: 1,
+		StartHeight:       1 + votingPeriod,
+		EndHeight:         1 + votingPeriod*2,
+		Members:           []string{voter.String()},
+		Seed:              []byte("seed"),
+	}, res)
+
+	_, err = suite.keeper.Council(sdk.WrapSDKContext(suite.ctx), &types.QueryCouncilRequest{CouncilId: 2})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestVotesQuery() {
+	suite.advance(1 + votingPeriod)
+	council, found := suite.keeper.GetCouncil(suite.ctx, 2)
+	suite.Require().True(found)
+
+	expected := make(map[string]int)
+	for i := 0; i < 3; i += 1 {
+		// voters registered before the voting of council 2 started
+		suite.ctx = suite.ctx.WithBlockHeight(1)
+		voter, sk := suite.createVoter(2)
+		suite.ctx = suite.ctx.WithBlockHeight(1 + votingPeriod)
+		suite.Require().NoError(suite.keeper.AddVote(suite.ctx, council.ID, voter, makeBallots(sk, council.Seed, 0, 1)))
+		expected[voter.String()] = 2
+	}
+
+	res, err := suite.keeper.Votes(sdk.WrapSDKContext(suite.ctx), &types.QueryVotesRequest{CouncilId: council.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Votes, 3)
+	for _, vote := range res.Votes {
+		suite.Require().Equal(council.ID, vote.CouncilID)
+		suite.Require().Equal(expected[vote.Voter], len(vote.Ballots))
+	}
+
+	// paginated by key
+	first, err := suite.keeper.Votes(sdk.WrapSDKContext(suite.ctx), &types.QueryVotesRequest{
+		CouncilId:  council.ID,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(first.Votes, 2)
+	second, err := suite.keeper.Votes(sdk.WrapSDKContext(suite.ctx), &types.QueryVotesRequest{
+		CouncilId:  council.ID,
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Votes, append(first.Votes, second.Votes...))
+	suite.Require().Nil(second.Pagination.NextKey)
+
+	// no votes for the current council
+	res, err = suite.keeper.Votes(sdk.WrapSDKContext(suite.ctx), &types.QueryVotesRequest{CouncilId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Votes)
+
+	_, err = suite.keeper.Votes(sdk.WrapSDKContext(suite.ctx), &types.QueryVotesRequest{CouncilId: 3})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestVoterQuery() {
+	voter, sk := suite.createVoter(1)
+	pk, ok := sk.Public()
+	suite.Require().True(ok)
+
+	res, err := suite.keeper.Voter(sdk.WrapSDKContext(suite.ctx), &types.QueryVoterRequest{Voter: voter.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryVoterResponse{
+		Voter:            voter.String(),
+		PublicKey:        pk,
+		RegisteredHeight: 1,
+	}, res)
+
+	_, err = suite.keeper.Voter(sdk.WrapSDKContext(suite.ctx), &types.QueryVoterRequest{Voter: sdk.ValAddress("unregistered").String()})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	_, err = suite.keeper.Voter(sdk.WrapSDKContext(suite.ctx), &types.QueryVoterRequest{Voter: "invalid"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestElectionScheduleQuery() {
+	expected := &types.QueryElectionScheduleResponse{
+		CurrentCouncilID:  1,
+		NextCouncilID:     2,
+		VotingStartHeight: 1 + votingPeriod,
+		VotingEndHeight:   1 + votingPeriod*2,
+		ElectionHeight:    1 + votingPeriod*2,
+	}
+	// projected before the voting of the next council starts
+	res, err := suite.keeper.ElectionSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryElectionScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res)
+
+	suite.advance(1 + votingPeriod)
+	res, err = suite.keeper.ElectionSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryElectionScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res)
+
+	suite.advance(1 + votingPeriod*2)
+	res, err = suite.keeper.ElectionSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryElectionScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryElectionScheduleResponse{
+		CurrentCouncilID:  2,
+		NextCouncilID:     3,
+		VotingStartHeight: 1 + votingPeriod*2,
+		VotingEndHeight:   1 + votingPeriod*3,
+		ElectionHeight:    1 + votingPeriod*3,
+	}, res)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/0glabs/0g-chain/x/council/v1/types"
@@ -94,12 +97,17 @@ func (k Keeper) GetVotingPeriod(ctx sdk.Context) (uint64, error) {
 	return types.Uint64FromBytes(bz), nil
 }
 
-// StoreNewCouncil stores a council, adding a new ID
+// StoreNewCouncil stores a council, adding a new ID. Its seed is derived from the seed of the current
+// council and the ballots that elected it.
 func (k Keeper) StoreNewCouncil(ctx sdk.Context, votingStartHeight uint64) error {
 	currentCouncilID, err := k.GetCurrentCouncilID(ctx)
 	if err != nil {
 		return err
 	}
+	current, found := k.GetCouncil(ctx, currentCouncilID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCouncil, "%d", currentCouncilID)
+	}
 
 	votingPeriod, err := k.GetVotingPeriod(ctx)
 	if err != nil {
@@ -112,12 +120,26 @@ func (k Keeper) StoreNewCouncil(ctx sdk.Context, votingStartHeight uint64) error
 		EndHeight:         votingStartHeight + votingPeriod*2,
 		Votes:             []types.Vote{},
 		Members:           []sdk.ValAddress{},
+		Seed:              types.NextSeed(current.Seed, k.getBallotContents(ctx, currentCouncilID)),
 	}
 	k.SetCouncil(ctx, com)
 
 	return nil
 }
 
+// GetCurrentMembers returns the members of the current council.
+func (k Keeper) GetCurrentMembers(ctx sdk.Context) []sdk.ValAddress {
+	councilID, err := k.GetCurrentCouncilID(ctx)
+	if err != nil {
+		return []sdk.ValAddress{}
+	}
+	council, found := k.GetCouncil(ctx, councilID)
+	if !found {
+		return []sdk.ValAddress{}
+	}
+	return council.Members
+}
+
 func (k Keeper) GetCouncil(ctx sdk.Context, councilID uint64) (types.Council, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CouncilKeyPrefix)
 	bz := store.Get(types.GetKeyFromID(councilID))
@@ -245,6 +267,17 @@ func (k Keeper) GetVotesByCouncil(ctx sdk.Context, councilID uint64) []types.Vot
 	return results
 }
 
+// getBallotContents returns the VRF outputs of the ballots cast for a council
+func (k Keeper) getBallotContents(ctx sdk.Context, councilID uint64) [][]byte {
+	contents := [][]byte{}
+	for _, vote := range k.GetVotesByCouncil(ctx, councilID) {
+		for _, ballot := range vote.Ballots {
+			contents = append(contents, ballot.Content)
+		}
+	}
+	return contents
+}
+
 // ------------------------------------------
 //				Voters
 // ------------------------------------------
@@ -252,7 +285,39 @@ func (k Keeper) GetVotesByCouncil(ctx sdk.Context, councilID uint64) []types.Vot
 func (k Keeper) SetVoter(ctx sdk.Context, voter sdk.ValAddress, pk vrf.PublicKey) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterKeyPrefix)
 	store.Set(types.GetVoterKey(voter), pk)
-	fmt.Printf("voterStoreKey: %v, publicKey: %v\n", types.GetVoterKey(voter), pk)
+}
+
+// DeleteVoter removes the VRF public key of a voter and its registration height.
+func (k Keeper) DeleteVoter(ctx sdk.Context, voter sdk.ValAddress) {
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterKeyPrefix).Delete(types.GetVoterKey(voter))
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterHeightKeyPrefix).Delete(types.GetVoterKey(voter))
+}
+
+// GetVoter returns the VRF public key of a registered voter.
+func (k Keeper) GetVoter(ctx sdk.Context, voter sdk.ValAddress) (vrf.PublicKey, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterKeyPrefix)
+	bz := store.Get(types.GetVoterKey(voter))
+	if bz == nil {
+		return nil, false
+	}
+	return vrf.PublicKey(bz), true
+}
+
+// SetVoterHeight records the height at which a voter registered its current key.
+func (k Keeper) SetVoterHeight(ctx sdk.Context, voter sdk.ValAddress, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterHeightKeyPrefix)
+	store.Set(types.GetVoterKey(voter), types.Uint64ToBytes(height))
+}
+
+// GetVoterHeight returns the height at which a voter registered its current key, voters
+// registered before the height was recorded are considered registered at genesis.
+func (k Keeper) GetVoterHeight(ctx sdk.Context, voter sdk.ValAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoterHeightKeyPrefix)
+	bz := store.Get(types.GetVoterKey(voter))
+	if bz == nil {
+		return 0
+	}
+	return types.Uint64FromBytes(bz)
 }
 
 func (k Keeper) IterateVoters(ctx sdk.Context, cb func(voter sdk.ValAddress, pk vrf.PublicKey) (stop bool)) {
@@ -280,14 +345,71 @@ func (k Keeper) AddVoter(ctx sdk.Context, voter sdk.ValAddress, key []byte) erro
 	if len(key) != vrf.PublicKeySize {
 		return types.ErrInvalidPublicKey
 	}
+	if _, found := k.GetVoter(ctx, voter); found {
+		return errorsmod.Wrap(types.ErrVoterAlreadyRegistered, voter.String())
+	}
 
 	k.SetVoter(ctx, voter, vrf.PublicKey(key))
+	k.SetVoterHeight(ctx, voter, uint64(ctx.BlockHeight()))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRegister,
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
-			// TODO: types.AttributeKeyPublicKey
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(key)),
+		),
+	)
+
+	return nil
+}
+
+// RemoveVoter deletes the key of a voter, and its vote for the council being elected as its ballots
+// can no longer be attributed to a registered key.
+func (k Keeper) RemoveVoter(ctx sdk.Context, voter sdk.ValAddress) error {
+	pk, found := k.GetVoter(ctx, voter)
+	if !found {
+		return errorsmod.Wrap(types.ErrVoterNotRegistered, voter.String())
+	}
+
+	k.DeleteVoter(ctx, voter)
+	if councilID, err := k.GetCurrentCouncilID(ctx); err == nil {
+		k.DeleteVote(ctx, councilID+1, voter)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnregister,
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(pk)),
+		),
+	)
+
+	return nil
+}
+
+// RotateVoterKey replaces the key of a voter. As for a new registration, the key only votes in the
+// elections whose voting starts after the rotation, votes already cast are kept.
+func (k Keeper) RotateVoterKey(ctx sdk.Context, voter sdk.ValAddress, key []byte) error {
+	if len(key) != vrf.PublicKeySize {
+		return types.ErrInvalidPublicKey
+	}
+	previous, found := k.GetVoter(ctx, voter)
+	if !found {
+		return errorsmod.Wrap(types.ErrVoterNotRegistered, voter.String())
+	}
+	if bytes.Equal(previous, key) {
+		return errorsmod.Wrap(types.ErrInvalidPublicKey, "key already registered")
+	}
+
+	k.SetVoter(ctx, voter, vrf.PublicKey(key))
+	k.SetVoterHeight(ctx, voter, uint64(ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateKey,
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(key)),
+			sdk.NewAttribute(types.AttributeKeyPreviousPublicKey, hex.EncodeToString(previous)),
 		),
 	)
 
@@ -304,8 +426,9 @@ func (k Keeper) AddVote(ctx sdk.Context, councilID uint64, voter sdk.ValAddress,
 		return errorsmod.Wrapf(types.ErrProposalExpired, "%d ≥ %d", ctx.BlockHeight(), com.StartHeight)
 	}
 
-	// TODO: verify if the voter is registered
-	// TODO: verify whether ballots are valid or not
+	if err := k.verifyBallots(ctx, com, voter, ballots); err != nil {
+		return err
+	}
 
 	// Store vote, overwriting any prior vote
 	k.SetVote(ctx, types.NewVote(councilID, voter, ballots))
@@ -321,3 +444,38 @@ func (k Keeper) AddVote(ctx sdk.Context, councilID uint64, voter sdk.ValAddress,
 
 	return nil
 }
+
+// verifyBallots checks that the voter registered its key before the voting of the council started,
+// so that it could not pick a key knowing the seed, and that every ballot is a VRF proof of the
+// voter over the council seed, within the ballots allowed by its bonded tokens.
+func (k Keeper) verifyBallots(ctx sdk.Context, com types.Council, voter sdk.ValAddress, ballots []*types.Ballot) error {
+	pk, found := k.GetVoter(ctx, voter)
+	if !found {
+		return errorsmod.Wrap(types.ErrVoterNotRegistered, voter.String())
+	}
+	if height := k.GetVoterHeight(ctx, voter); height >= com.VotingStartHeight {
+		return errorsmod.Wrapf(types.ErrVoterNotRegistered, "key of %s registered at %d, after voting started at %d", voter, height, com.VotingStartHeight)
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, voter)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	allowed := types.BallotsForTokens(validator.GetTokens())
+	seen := make(map[uint64]struct{})
+	for _, ballot := range ballots {
+		if ballot == nil {
+			return types.ErrInvalidBallot
+		}
+		if ballot.ID >= allowed {
+			return errorsmod.Wrapf(types.ErrInvalidBallot, "ballot %d exceeds the %d ballots allowed", ballot.ID, allowed)
+		}
+		if _, ok := seen[ballot.ID]; ok {
+			return errorsmod.Wrapf(types.ErrInvalidBallot, "duplicate ballot %d", ballot.ID)
+		}
+		seen[ballot.ID] = struct{}{}
+		if !pk.Verify(types.BallotMessage(com.Seed, ballot.ID), ballot.Content, ballot.Proof) {
+			return errorsmod.Wrapf(types.ErrInvalidBallot, "invalid proof for ballot %d", ballot.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/council/v1/keeper"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+const votingPeriod = 10
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app    app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.NewTestApp()
+	suite.app.InitializeFromGenesisStates()
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.app.NewContext(false, tmproto.Header{Height: 1, Time: genesisTime})
+	suite.keeper = suite.app.CouncilKeeper
+
+	suite.keeper.SetVotingStartHeight(suite.ctx, 1)
+	suite.keeper.SetVotingPeriod(suite.ctx, votingPeriod)
+	suite.keeper.SetCurrentCouncilID(suite.ctx, 1)
+	suite.keeper.SetCouncil(suite.ctx, types.Council{
+		ID:                1,
+		VotingStartHeight: 1,
+		StartHeight:       1 + votingPeriod,
+		EndHeight:         1 + votingPeriod*2,
+	})
+}
+
+// createVoter creates a validator holding the tokens of the given number of ballots and registers
+// a fresh VRF key for it.
+func (suite *KeeperTestSuite) createVoter(ballots int64) (sdk.ValAddress, vrfalgo.PrivateKey) {
+	addr := app.RandomAddress()
+	tokens := types.TokensPerBallot.MulRaw(ballots)
+	suite.Require().NoError(suite.app.FundAccount(suite.ctx, addr, sdk.NewCoins(
+		sdk.NewCoin(suite.app.GetStakingKeeper().BondDenom(suite.ctx), tokens.Add(sdkmath.NewInt(1))),
+	)))
+	valAddr := sdk.ValAddress(addr)
+	suite.Require().NoError(suite.app.CreateNewUnbondedValidator(suite.ctx, valAddr, tokens))
+
+	sk, err := vrfalgo.GenerateKey(nil)
+	suite.Require().NoError(err)
+	pk, ok := sk.Public()
+	suite.Require().True(ok)
+	suite.Require().NoError(suite.keeper.AddVoter(suite.ctx, valAddr, pk))
+	return valAddr, sk
+}
+
+// makeBallots computes VRF ballots of the given IDs over the council seed
+func makeBallots(sk vrfalgo.PrivateKey, seed []byte, ids ...uint64) []*types.Ballot {
+	ballots := make([]*types.Ballot, len(ids))
+	for i, id := range ids {
+		content, proof := sk.Prove(types.BallotMessage(seed, id))
+		ballots[i] = &types.Ballot{ID: id, Content: content, Proof: proof}
+	}
+	return ballots
+}
+
+// advance moves the context to the given height, running the council begin blocker on the way
+func (suite *KeeperTestSuite) advance(height int64) {
+	for h := suite.ctx.BlockHeight() + 1; h <= height; h += 1 {
+		suite.ctx = suite.ctx.WithBlockHeight(h)
+		suite.keeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -49,3 +49,35 @@ func (k Keeper) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteR
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// Unregister handles MsgUnregister messages
+func (k Keeper) Unregister(goCtx context.Context, msg *types.MsgUnregister) (*types.MsgUnregisterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveVoter(ctx, voter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterResponse{}, nil
+}
+
+// RotateKey handles MsgRotateKey messages
+func (k Keeper) RotateKey(goCtx context.Context, msg *types.MsgRotateKey) (*types.MsgRotateKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RotateVoterKey(ctx, voter, msg.Key); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateKeyResponse{}, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestAddVote_VerifiesBallots() {
+	voter, sk := suite.createVoter(3)
+	other, otherSk := suite.createVoter(3)
+
+	// the council voted on next is created at the start height of the current one, the seed of the
+	// genesis council is empty and no ballots were cast for it
+	suite.advance(1 + votingPeriod)
+	council, found := suite.keeper.GetCouncil(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NextSeed(nil, nil), council.Seed)
+
+	unregistered := sdk.ValAddress([]byte("unregistered voter"))
+	late, lateSk := suite.createVoter(3)
+	forged := makeBallots(sk, council.Seed, 0)
+	forged[0].Content = makeBallots(otherSk, council.Seed, 0)[0].Content
+
+	testCases := []struct {
+		name    string
+		voter   sdk.ValAddress
+		ballots []*types.Ballot
+		err     error
+	}{
+		{"valid ballots", voter, makeBallots(sk, council.Seed, 0, 1, 2), nil},
+		{"no ballots", other, nil, nil},
+		{"unregistered voter", unregistered, makeBallots(sk, council.Seed, 0), types.ErrVoterNotRegistered},
+		{"key registered after voting started", late, makeBallots(lateSk, council.Seed, 0), types.ErrVoterNotRegistered},
+		{"ballot of another key", voter, makeBallots(otherSk, council.Seed, 0), types.ErrInvalidBallot},
+		{"ballot over another seed", voter, makeBallots(sk, []byte("other seed"), 0), types.ErrInvalidBallot},
+		{"forged content", voter, forged, types.ErrInvalidBallot},
+		{"ballot beyond bonded tokens", voter, makeBallots(sk, council.Seed, 3), types.ErrInvalidBallot},
+		{"duplicate ballot", voter, makeBallots(sk, council.Seed, 1, 1), types.ErrInvalidBallot},
+		{"malformed proof", voter, []*types.Ballot{{ID: 0, Content: make([]byte, vrfalgo.Size), Proof: []byte{0x01}}}, types.ErrInvalidBallot},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.keeper.AddVote(suite.ctx, council.ID, tc.voter, tc.ballots)
+			if tc.err != nil {
+				suite.Require().ErrorIs(err, tc.err)
+				return
+			}
+			suite.Require().NoError(err)
+			vote, found := suite.keeper.GetVote(suite.ctx, council.ID, tc.voter)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.ballots, vote.Ballots)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStoreNewCouncil_DerivesSeed() {
+	voter, sk := suite.createVoter(2)
+	other, otherSk := suite.createVoter(1)
+	suite.advance(1 + votingPeriod)
+	council, found := suite.keeper.GetCouncil(suite.ctx, 2)
+	suite.Require().True(found)
+	ballots := append(makeBallots(sk, council.Seed, 0, 1), makeBallots(otherSk, council.Seed, 0)...)
+	suite.Require().NoError(suite.keeper.AddVote(suite.ctx, council.ID, voter, ballots[:2]))
+	suite.Require().NoError(suite.keeper.AddVote(suite.ctx, council.ID, other, ballots[2:]))
+
+	// the block proposer has no say in the seed
+	header := suite.ctx.BlockHeader()
+	header.LastCommitHash = []byte("ground")
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+	suite.advance(1 + votingPeriod*2 + votingPeriod/2)
+
+	// the seed of a council is fixed once created
+	stored, found := suite.keeper.GetCouncil(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(council.Seed, stored.Seed)
+	suite.Require().Equal(uint64(1+votingPeriod), stored.VotingStartHeight)
+
+	// the next one is drawn over the seed and the ballots of the council elected before it
+	next, found := suite.keeper.GetCouncil(suite.ctx, 3)
+	suite.Require().True(found)
+	contents := [][]byte{ballots[2].Content, ballots[0].Content, ballots[1].Content}
+	suite.Require().Equal(types.NextSeed(council.Seed, contents), next.Seed)
+	suite.Require().NotEqual(types.NextSeed(council.Seed, nil), next.Seed)
+}
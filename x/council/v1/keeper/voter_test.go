@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	vrfalgo "github.com/coniks-sys/coniks-go/crypto/vrf"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/council/v1/types"
+)
+
+func (suite *KeeperTestSuite) newVRFKey() (vrfalgo.PrivateKey, vrfalgo.PublicKey) {
+	sk, err := vrfalgo.GenerateKey(nil)
+	suite.Require().NoError(err)
+	pk, ok := sk.Public()
+	suite.Require().True(ok)
+	return sk, pk
+}
+
+func (suite *KeeperTestSuite) TestRegister_RejectsRegisteredVoter() {
+	voter, sk := suite.createVoter(1)
+	pk, _ := sk.Public()
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRegister,
+		sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(pk)),
+	)))
+
+	_, other := suite.newVRFKey()
+	_, err := suite.keeper.Register(sdk.WrapSDKContext(suite.ctx), &types.MsgRegister{Voter: voter.String(), Key: other})
+	suite.Require().ErrorIs(err, types.ErrVoterAlreadyRegistered)
+	registered, found := suite.keeper.GetVoter(suite.ctx, voter)
+	suite.Require().True(found)
+	suite.Require().Equal(pk, registered)
+}
+
+func (suite *KeeperTestSuite) TestRotateKey() {
+	voter, sk := suite.createVoter(2)
+	pk, _ := sk.Public()
+	suite.advance(1 + votingPeriod)
+	council, found := suite.keeper.GetCouncil(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().NoError(suite.keeper.AddVote(suite.ctx, council.ID, voter, makeBallots(sk, council.Seed, 0)))
+
+	newSk, newPk := suite.newVRFKey()
+	testCases := []struct {
+		name string
+		msg  types.MsgRotateKey
+		err  error
+	}{
+		{"unregistered voter", types.MsgRotateKey{Voter: sdk.ValAddress("unregistered").String(), Key: newPk}, types.ErrVoterNotRegistered},
+		{"same key", types.MsgRotateKey{Voter: voter.String(), Key: pk}, types.ErrInvalidPublicKey},
+		{"invalid key", types.MsgRotateKey{Voter: voter.String(), Key: newPk[:16]}, types.ErrInvalidPublicKey},
+		{"new key", types.MsgRotateKey{Voter: voter.String(), Key: newPk}, nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.keeper.RotateKey(sdk.WrapSDKContext(suite.ctx), &tc.msg)
+			suite.Require().ErrorIs(err, tc.err)
+		})
+	}
+
+	registered, found := suite.keeper.GetVoter(suite.ctx, voter)
+	suite.Require().True(found)
+	suite.Require().Equal(newPk, registered)
+	suite.Require().Equal(uint64(1+votingPeriod), suite.keeper.GetVoterHeight(suite.ctx, voter))
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRotateKey,
+		sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(newPk)),
+		sdk.NewAttribute(types.AttributeKeyPreviousPublicKey, hex.EncodeToString(pk)),
+	)))
+
+	// the vote cast with the previous key is kept, the new key cannot vote in the ongoing election
+	_, found = suite.keeper.GetVote(suite.ctx, council.ID, voter)
+	suite.Require().True(found)
+	err := suite.keeper.AddVote(suite.ctx, council.ID, voter, makeBallots(newSk, council.Seed, 0, 1))
+	suite.Require().ErrorIs(err, types.ErrVoterNotRegistered)
+}
+
+func (suite *KeeperTestSuite) TestUnregister() {
+	voter, sk := suite.createVoter(1)
+	pk, _ := sk.Public()
+	other, otherSk := suite.createVoter(1)
+	suite.advance(1 + votingPeriod)
+	council, found := suite.keeper.GetCouncil(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().NoError(suite.keeper.AddVote(suite.ctx, council.ID, voter, makeBallots(sk, council.Seed, 0)))
+	suite.Require().NoError(suite.keeper.AddVote(suite.ctx, council.ID, other, makeBallots(otherSk, council.Seed, 0)))
+
+	_, err := suite.keeper.Unregister(sdk.WrapSDKContext(suite.ctx), &types.MsgUnregister{Voter: voter.String()})
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetVoter(suite.ctx, voter)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(0), suite.keeper.GetVoterHeight(suite.ctx, voter))
+	_, found = suite.keeper.GetVote(suite.ctx, council.ID, voter)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetVote(suite.ctx, council.ID, other)
+	suite.Require().True(found)
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnregister,
+		sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(pk)),
+	)))
+
+	_, err = suite.keeper.Unregister(sdk.WrapSDKContext(suite.ctx), &types.MsgUnregister{Voter: voter.String()})
+	suite.Require().ErrorIs(err, types.ErrVoterNotRegistered)
+	suite.Require().ErrorIs(suite.keeper.AddVote(suite.ctx, council.ID, voter, makeBallots(sk, council.Seed, 0)), types.ErrVoterNotRegistered)
+
+	// the voter may register again, for the elections whose voting starts afterwards
+	_, newPk := suite.newVRFKey()
+	_, err = suite.keeper.Register(sdk.WrapSDKContext(suite.ctx), &types.MsgRegister{Voter: voter.String(), Key: newPk})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestAfterValidatorRemoved_RemovesVoter() {
+	voter, _ := suite.createVoter(1)
+	other, _ := suite.createVoter(1)
+
+	// through the staking hooks of the app
+	suite.Require().NoError(suite.app.GetStakingKeeper().AfterValidatorRemoved(suite.ctx, sdk.ConsAddress(voter), voter))
+	_, found := suite.keeper.GetVoter(suite.ctx, voter)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetVoter(suite.ctx, other)
+	suite.Require().True(found)
+
+	// validators that never registered are ignored
+	unregistered := sdk.ValAddress("unregistered")
+	suite.Require().NoError(suite.keeper.Hooks().AfterValidatorRemoved(suite.ctx, sdk.ConsAddress(unregistered), unregistered))
+}
@@ -21,8 +21,10 @@ var (
 
 const (
 	// Amino names
-	registerName = "0g/council/MsgRegister"
-	voteName     = "0g/council/MsgVote"
+	registerName   = "0g/council/MsgRegister"
+	voteName       = "0g/council/MsgVote"
+	unregisterName = "0g/council/MsgUnregister"
+	rotateKeyName  = "0g/council/MsgRotateKey"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,6 +39,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegister{},
 		&MsgVote{},
+		&MsgUnregister{},
+		&MsgRotateKey{},
 	)
 
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &vrf.PubKey{})
@@ -49,4 +53,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegister{}, registerName, nil)
 	cdc.RegisterConcrete(&MsgVote{}, voteName, nil)
+	cdc.RegisterConcrete(&MsgUnregister{}, unregisterName, nil)
+	cdc.RegisterConcrete(&MsgRotateKey{}, rotateKeyName, nil)
 }
@@ -1,9 +1,19 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/chaincfg"
 )
 
+// TokensPerBallot defines the bonded tokens a voter needs for each ballot, 1_000 A0GI
+var TokensPerBallot = sdkmath.NewIntWithDecimal(1_000, chaincfg.EvmDenomUnit)
+
 type Councils []Council
 type Votes []Vote
 
@@ -19,3 +29,30 @@ func NewVote(councilID uint64, voter sdk.ValAddress, ballots []*Ballot) Vote {
 		Ballots:   ballots,
 	}
 }
+
+// BallotsForTokens returns the number of ballots a voter with the given bonded tokens may cast.
+func BallotsForTokens(tokens sdkmath.Int) uint64 {
+	return tokens.Quo(TokensPerBallot).Uint64()
+}
+
+// BallotMessage returns the message a ballot VRF output is computed over.
+func BallotMessage(seed []byte, ballotID uint64) []byte {
+	return bytes.Join([][]byte{seed, Uint64ToBytes(ballotID)}, nil)
+}
+
+// NextSeed returns the seed of the council following the one with the given seed, the hash of that
+// seed and the VRF outputs of the ballots cast for it. Neither is up to the block proposer, so the
+// proposer cannot grind the seed the next council is drawn over.
+func NextSeed(seed []byte, ballots [][]byte) []byte {
+	sorted := make([][]byte, len(ballots))
+	copy(sorted, ballots)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	hash := sha256.New()
+	hash.Write(seed)
+	for _, ballot := range sorted {
+		hash.Write(ballot)
+	}
+	return hash.Sum(nil)
+}
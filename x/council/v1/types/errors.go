@@ -16,4 +16,7 @@ var (
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
 	ErrInvalidPublicKey        = errorsmod.Register(ModuleName, 13, "invalid public key")
 	ErrInvalidValidatorAddress = errorsmod.Register(ModuleName, 14, "invalid validator address")
+	ErrVoterNotRegistered      = errorsmod.Register(ModuleName, 15, "voter not registered")
+	ErrInvalidBallot           = errorsmod.Register(ModuleName, 16, "invalid ballot")
+	ErrVoterAlreadyRegistered  = errorsmod.Register(ModuleName, 17, "voter already registered")
 )
@@ -2,8 +2,11 @@ package types
 
 // Module event types
 const (
-	EventTypeRegister = "register"
-	EventTypeVote     = "vote"
+	EventTypeRegister   = "register"
+	EventTypeUnregister = "unregister"
+	EventTypeRotateKey  = "rotate_key"
+	EventTypeVote       = "vote"
+	EventTypeElection   = "election"
 
 	AttributeValueCategory          = "council"
 	AttributeKeyCouncilID           = "council_id"
@@ -14,6 +17,10 @@ const (
 	AttributeKeyVoter               = "voter"
 	AttributeKeyBallots             = "ballots"
 	AttributeKeyPublicKey           = "public_key"
+	AttributeKeyPreviousPublicKey   = "previous_public_key"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyMembers             = "members"
+	AttributeKeyElected             = "elected"
+	AttributeKeyCarriedOver         = "carried_over"
 )
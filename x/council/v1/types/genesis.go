@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	DefaultVotingStartHeight = 1
 	DefaultVotingPeriod      = 200
@@ -20,7 +22,8 @@ func NewGenesisState(params Params, votingStartHeight uint64, votingPeriod uint6
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		Params{
-			CouncilSize: 1,
+			CouncilSize:    1,
+			MinCouncilSize: 1,
 		},
 		DefaultVotingStartHeight,
 		DefaultVotingPeriod,
@@ -38,5 +41,20 @@ func DefaultGenesisState() *GenesisState {
 
 // Validate performs basic validation of genesis data.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.VotingPeriod == 0 {
+		return fmt.Errorf("voting period must be positive")
+	}
+	found := false
+	for _, council := range gs.Councils {
+		if council.ID == gs.CurrentCouncilID {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("current council %d not found", gs.CurrentCouncilID)
+	}
 	return nil
 }
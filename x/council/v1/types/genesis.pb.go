@@ -27,8 +27,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UnderSubscribedPolicy enumerates how an election with fewer voters than seats fills the council.
+type UnderSubscribedPolicy int32
+
+const (
+	// UNDER_SUBSCRIBED_POLICY_CARRY_OVER fills the empty seats with members of the previous council
+	UNDER_SUBSCRIBED_POLICY_CARRY_OVER UnderSubscribedPolicy = 0
+	// UNDER_SUBSCRIBED_POLICY_SHRINK leaves the empty seats empty, shrinking the council
+	UNDER_SUBSCRIBED_POLICY_SHRINK UnderSubscribedPolicy = 1
+)
+
+var UnderSubscribedPolicy_name = map[int32]string{
+	0: "UNDER_SUBSCRIBED_POLICY_CARRY_OVER",
+	1: "UNDER_SUBSCRIBED_POLICY_SHRINK",
+}
+
+var UnderSubscribedPolicy_value = map[string]int32{
+	"UNDER_SUBSCRIBED_POLICY_CARRY_OVER": 0,
+	"UNDER_SUBSCRIBED_POLICY_SHRINK":     1,
+}
+
+func (x UnderSubscribedPolicy) String() string {
+	return proto.EnumName(UnderSubscribedPolicy_name, int32(x))
+}
+
+func (UnderSubscribedPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35f7661c22f951dd, []int{0}
+}
+
 type Params struct {
 	CouncilSize uint64 `protobuf:"varint,1,opt,name=council_size,json=councilSize,proto3" json:"council_size,omitempty"`
+	// under_subscribed_policy defines how the seats left empty by an election with fewer voters than
+	// council_size are handled
+	UnderSubscribedPolicy UnderSubscribedPolicy `protobuf:"varint,2,opt,name=under_subscribed_policy,json=underSubscribedPolicy,proto3,enum=zgc.council.v1.UnderSubscribedPolicy" json:"under_subscribed_policy,omitempty"`
+	// min_council_size defines the fewest members a council is left with, seats below it are filled with
+	// members of the previous council that are still registered voters whatever the policy
+	MinCouncilSize uint64 `protobuf:"varint,3,opt,name=min_council_size,json=minCouncilSize,proto3" json:"min_council_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,6 +105,20 @@ func (m *Params) GetCouncilSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetUnderSubscribedPolicy() UnderSubscribedPolicy {
+	if m != nil {
+		return m.UnderSubscribedPolicy
+	}
+	return UNDER_SUBSCRIBED_POLICY_CARRY_OVER
+}
+
+func (m *Params) GetMinCouncilSize() uint64 {
+	if m != nil {
+		return m.MinCouncilSize
+	}
+	return 0
+}
+
 // GenesisState defines the council module's genesis state.
 type GenesisState struct {
 	Params            Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -120,6 +168,11 @@ type Council struct {
 	EndHeight         uint64                                          `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 	Votes             []Vote                                          `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
 	Members           []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,6,rep,name=members,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"members,omitempty"`
+	// seed defines the randomness the VRF ballots of the council are computed over, the sha256 hash of
+	// the seed of the previous council followed by the sorted VRF outputs of the ballots cast for it.
+	// It is empty for the genesis council and for a council bootstrapped when the council state is
+	// missing.
+	Seed []byte `protobuf:"bytes,7,opt,name=seed,proto3" json:"seed,omitempty"`
 }
 
 func (m *Council) Reset()         { *m = Council{} }
@@ -197,6 +250,13 @@ func (m *Council) GetMembers() []github_com_cosmos_cosmos_sdk_types.ValAddress {
 	return nil
 }
 
+func (m *Council) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
 type Vote struct {
 	CouncilID uint64                                        `protobuf:"varint,1,opt,name=council_id,json=councilId,proto3" json:"council_id,omitempty"`
 	Voter     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"voter,omitempty"`
@@ -237,8 +297,11 @@ func (m *Vote) XXX_DiscardUnknown() {
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
 type Ballot struct {
-	ID      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// content defines the VRF output of the voter over the council seed and the ballot ID
 	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// proof defines the VRF proof of content
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -288,7 +351,15 @@ func (m *Ballot) GetContent() []byte {
 	return nil
 }
 
+func (m *Ballot) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("zgc.council.v1.UnderSubscribedPolicy", UnderSubscribedPolicy_name, UnderSubscribedPolicy_value)
 	proto.RegisterType((*Params)(nil), "zgc.council.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "zgc.council.v1.GenesisState")
 	proto.RegisterType((*Council)(nil), "zgc.council.v1.Council")
@@ -299,45 +370,54 @@ func init() {
 func init() { proto.RegisterFile("zgc/council/v1/genesis.proto", fileDescriptor_35f7661c22f951dd) }
 
 var fileDescriptor_35f7661c22f951dd = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xc6, 0x63, 0x27, 0x75, 0x7e, 0xbd, 0xb8, 0x3f, 0x95, 0x6b, 0x55, 0xdc, 0x0a, 0xec, 0x36,
-	0x2c, 0x95, 0x20, 0x76, 0x5a, 0x58, 0xe8, 0x86, 0x5b, 0xa9, 0xed, 0x56, 0xb9, 0x52, 0x07, 0x06,
-	0x22, 0xff, 0x39, 0x2e, 0x27, 0x6c, 0x5f, 0xe4, 0xbb, 0x44, 0x34, 0xaf, 0x80, 0x91, 0x57, 0x80,
-	0x58, 0xd9, 0x79, 0x11, 0x1d, 0x18, 0x2a, 0x26, 0xa6, 0x08, 0x39, 0xef, 0x82, 0x09, 0xe5, 0xee,
-	0x5c, 0x92, 0x20, 0x06, 0x24, 0x26, 0xfb, 0x9e, 0xe7, 0x73, 0x7f, 0x9e, 0xef, 0xf7, 0x6c, 0xf0,
-	0x60, 0x8c, 0x63, 0x2f, 0xa6, 0xc3, 0x3c, 0x26, 0xa9, 0x37, 0x3a, 0xf0, 0x30, 0xca, 0x11, 0x23,
-	0xcc, 0x1d, 0x14, 0x94, 0x53, 0xf8, 0xff, 0x18, 0xc7, 0xae, 0x72, 0xdd, 0xd1, 0xc1, 0xce, 0x76,
-	0x4c, 0x59, 0x46, 0x59, 0x4f, 0xb8, 0x9e, 0x1c, 0x48, 0x74, 0x67, 0x13, 0x53, 0x4c, 0xa5, 0x3e,
-	0x7b, 0x53, 0xea, 0x36, 0xa6, 0x14, 0xa7, 0xc8, 0x13, 0xa3, 0x68, 0xf8, 0xda, 0x0b, 0xf3, 0x6b,
-	0x65, 0x39, 0xcb, 0x16, 0x27, 0x19, 0x62, 0x3c, 0xcc, 0x06, 0x12, 0x68, 0x3f, 0x06, 0xc6, 0x45,
-	0x58, 0x84, 0x19, 0x83, 0x7b, 0xc0, 0x54, 0x87, 0xe8, 0x31, 0x32, 0x46, 0x96, 0xb6, 0xab, 0xed,
-	0x37, 0x82, 0x96, 0xd2, 0x2e, 0xc9, 0x18, 0xb5, 0x3f, 0xe8, 0xc0, 0x3c, 0x95, 0x67, 0xbf, 0xe4,
-	0x21, 0x47, 0xf0, 0x19, 0x30, 0x06, 0x62, 0xb6, 0xa0, 0x5b, 0x87, 0x5b, 0xee, 0x62, 0x16, 0x57,
-	0xae, 0xed, 0x37, 0x6e, 0x26, 0x4e, 0x2d, 0x50, 0x2c, 0x74, 0xc1, 0xc6, 0x88, 0x72, 0x92, 0xe3,
-	0x1e, 0xe3, 0x61, 0xc1, 0x7b, 0x7d, 0x44, 0x70, 0x9f, 0x5b, 0xba, 0xd8, 0xf0, 0x9e, 0xb4, 0x2e,
-	0x67, 0xce, 0x99, 0x30, 0xe0, 0x23, 0xb0, 0xa6, 0xf8, 0x01, 0x2a, 0x08, 0x4d, 0xac, 0xba, 0x20,
-	0x4d, 0x29, 0x5e, 0x08, 0x0d, 0xfa, 0x00, 0xc6, 0xc3, 0xa2, 0x40, 0x39, 0xef, 0x55, 0x31, 0x48,
-	0x62, 0x35, 0x66, 0xa4, 0xbf, 0x59, 0x4e, 0x9c, 0xf5, 0x63, 0xe9, 0x1e, 0x4b, 0xf3, 0xfc, 0x24,
-	0x58, 0x8f, 0x17, 0x95, 0x04, 0x3e, 0x07, 0xff, 0xa9, 0xb9, 0xcc, 0x5a, 0xd9, 0xad, 0xef, 0xb7,
-	0x0e, 0xef, 0x2f, 0x07, 0x52, 0xb0, 0x4a, 0x74, 0x87, 0x1f, 0x35, 0xde, 0x7d, 0x74, 0x6a, 0xed,
-	0x4f, 0x3a, 0x68, 0x2a, 0x02, 0x6e, 0x01, 0x9d, 0x24, 0xb2, 0x8a, 0xbe, 0x51, 0x4e, 0x1c, 0xfd,
-	0xfc, 0x24, 0xd0, 0x49, 0xf2, 0xd7, 0xe9, 0xf7, 0x80, 0xb9, 0x00, 0xca, 0xf0, 0x2d, 0x36, 0x87,
-	0x3c, 0x04, 0x00, 0xe5, 0x49, 0x05, 0x88, 0xcc, 0xc1, 0x2a, 0xca, 0x13, 0x65, 0x77, 0xc1, 0xca,
-	0x88, 0x72, 0x54, 0x65, 0xda, 0x5c, 0xce, 0x74, 0x45, 0x39, 0x52, 0x81, 0x24, 0x08, 0x23, 0xd0,
-	0xcc, 0x50, 0x16, 0xa1, 0x82, 0x59, 0xc6, 0x6e, 0x7d, 0xdf, 0xf4, 0xcf, 0x7e, 0x4c, 0x9c, 0x0e,
-	0x26, 0xbc, 0x3f, 0x8c, 0xdc, 0x98, 0x66, 0xea, 0x56, 0xaa, 0x47, 0x87, 0x25, 0x6f, 0x3c, 0x7e,
-	0x3d, 0x40, 0xcc, 0xbd, 0x0a, 0xd3, 0x17, 0x49, 0x52, 0x20, 0xc6, 0xbe, 0x7e, 0xee, 0x6c, 0xa8,
-	0xbb, 0xab, 0x14, 0xff, 0x9a, 0x23, 0x16, 0x54, 0x0b, 0xb7, 0xbf, 0x68, 0xa0, 0x31, 0xdb, 0x19,
-	0x3e, 0x01, 0x60, 0xae, 0x63, 0xb2, 0x60, 0x6b, 0xe5, 0xc4, 0x59, 0xfd, 0xd5, 0xaa, 0xd5, 0xf8,
-	0xae, 0x47, 0xaf, 0x64, 0x98, 0x42, 0x14, 0xec, 0x5f, 0x1e, 0x4c, 0x2e, 0x0b, 0xbb, 0xa0, 0x19,
-	0x85, 0x69, 0x4a, 0x39, 0xb3, 0xea, 0xa2, 0x5c, 0xbf, 0xdd, 0x69, 0x5f, 0xd8, 0x41, 0x85, 0xa9,
-	0xd6, 0x1f, 0x01, 0x43, 0x1a, 0x7f, 0x6c, 0xbc, 0x05, 0x9a, 0x31, 0xcd, 0x39, 0xca, 0x65, 0xb3,
-	0xcd, 0xa0, 0x1a, 0xfa, 0xa7, 0x37, 0xa5, 0xad, 0xdd, 0x96, 0xb6, 0xf6, 0xbd, 0xb4, 0xb5, 0xf7,
-	0x53, 0xbb, 0x76, 0x3b, 0xb5, 0x6b, 0xdf, 0xa6, 0x76, 0xed, 0xe5, 0x7c, 0xb4, 0x2e, 0x4e, 0xc3,
-	0x88, 0x79, 0x5d, 0xdc, 0x89, 0xfb, 0x21, 0xc9, 0xbd, 0xb7, 0xf3, 0xbf, 0x14, 0x91, 0x32, 0x32,
-	0xc4, 0x47, 0xfd, 0xf4, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x4d, 0xc7, 0xe0, 0x71, 0x04,
-	0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x8f, 0xda, 0x46,
+	0x18, 0xc6, 0x31, 0x78, 0xa1, 0x3b, 0x38, 0x2b, 0x3a, 0x21, 0x89, 0xb3, 0x6a, 0x0d, 0xa1, 0x6a,
+	0x85, 0xaa, 0x62, 0x93, 0x6d, 0x2f, 0xed, 0x2d, 0x86, 0x55, 0x16, 0xb5, 0x4a, 0xd0, 0xa0, 0x5d,
+	0x29, 0x95, 0x5a, 0xcb, 0x7f, 0x26, 0x66, 0x54, 0xec, 0x41, 0x9e, 0x01, 0x15, 0x3e, 0x41, 0x8e,
+	0xfd, 0x04, 0x55, 0xa5, 0x7e, 0x85, 0xbd, 0xf6, 0xbe, 0x87, 0x1e, 0x56, 0x3d, 0xf5, 0x84, 0x2a,
+	0xf6, 0x5b, 0xf4, 0x54, 0x31, 0x33, 0xb0, 0x80, 0x76, 0x0f, 0x95, 0x72, 0xc2, 0xf3, 0x3e, 0x3f,
+	0xac, 0xe7, 0x79, 0xdf, 0xd7, 0x03, 0x3e, 0x9a, 0xc7, 0xa1, 0x13, 0xd2, 0x49, 0x1a, 0x92, 0x91,
+	0x33, 0x7d, 0xee, 0xc4, 0x38, 0xc5, 0x8c, 0x30, 0x7b, 0x9c, 0x51, 0x4e, 0xe1, 0xd1, 0x3c, 0x0e,
+	0x6d, 0xa5, 0xda, 0xd3, 0xe7, 0xc7, 0x4f, 0x43, 0xca, 0x12, 0xca, 0x3c, 0xa1, 0x3a, 0xf2, 0x20,
+	0xd1, 0xe3, 0x6a, 0x4c, 0x63, 0x2a, 0xeb, 0xab, 0x27, 0x55, 0x7d, 0x1a, 0x53, 0x1a, 0x8f, 0xb0,
+	0x23, 0x4e, 0xc1, 0xe4, 0xad, 0xe3, 0xa7, 0x33, 0x25, 0xd5, 0xf6, 0x25, 0x4e, 0x12, 0xcc, 0xb8,
+	0x9f, 0x8c, 0x25, 0xd0, 0xb8, 0xd4, 0x40, 0xb1, 0xef, 0x67, 0x7e, 0xc2, 0xe0, 0x33, 0x60, 0x28,
+	0x17, 0x1e, 0x23, 0x73, 0x6c, 0x6a, 0x75, 0xad, 0xa9, 0xa3, 0xb2, 0xaa, 0x0d, 0xc8, 0x1c, 0xc3,
+	0x1f, 0xc0, 0x93, 0x49, 0x1a, 0xe1, 0xcc, 0x63, 0x93, 0x80, 0x85, 0x19, 0x09, 0x70, 0xe4, 0x8d,
+	0xe9, 0x88, 0x84, 0x33, 0x33, 0x5f, 0xd7, 0x9a, 0x47, 0x27, 0x9f, 0xda, 0xbb, 0x61, 0xec, 0xf3,
+	0x15, 0x3e, 0xd8, 0xd0, 0x7d, 0x01, 0xa3, 0x47, 0x93, 0xbb, 0xca, 0xb0, 0x09, 0x2a, 0x09, 0x49,
+	0xbd, 0x1d, 0x17, 0x05, 0xe1, 0xe2, 0x28, 0x21, 0x69, 0xe7, 0xd6, 0x48, 0xe3, 0xd7, 0x3c, 0x30,
+	0x5e, 0xca, 0x2e, 0x0e, 0xb8, 0xcf, 0x31, 0xfc, 0x0a, 0x14, 0xc7, 0x22, 0x86, 0xb0, 0x5d, 0x3e,
+	0x79, 0xbc, 0x6f, 0x44, 0x86, 0x74, 0xf5, 0xab, 0x45, 0x2d, 0x87, 0x14, 0x0b, 0x6d, 0xf0, 0x70,
+	0x4a, 0x39, 0x49, 0x63, 0x8f, 0x71, 0x3f, 0xe3, 0xde, 0x10, 0x93, 0x78, 0xc8, 0x45, 0x16, 0x1d,
+	0x7d, 0x28, 0xa5, 0xc1, 0x4a, 0x39, 0x13, 0x02, 0xfc, 0x04, 0x3c, 0x50, 0xfc, 0x18, 0x67, 0x84,
+	0x46, 0xca, 0x9d, 0x21, 0x8b, 0x7d, 0x51, 0x83, 0x2e, 0x80, 0xe1, 0x24, 0xcb, 0x70, 0xca, 0x37,
+	0x49, 0x48, 0x64, 0xea, 0x2b, 0xd2, 0xad, 0x2e, 0x17, 0xb5, 0x4a, 0x47, 0xaa, 0x2a, 0x4f, 0xaf,
+	0x8b, 0x2a, 0xe1, 0x6e, 0x25, 0x82, 0x5f, 0x83, 0x0f, 0xd4, 0x7f, 0x99, 0x79, 0x50, 0x2f, 0x34,
+	0xcb, 0x27, 0x4f, 0xf6, 0x03, 0x29, 0x58, 0x25, 0xda, 0xe0, 0xdf, 0xe8, 0xef, 0x7e, 0xab, 0xe5,
+	0x1a, 0x7f, 0xe4, 0x41, 0x49, 0x11, 0xf0, 0x31, 0xc8, 0x93, 0x48, 0x8e, 0xd3, 0x2d, 0x2e, 0x17,
+	0xb5, 0x7c, 0xaf, 0x8b, 0xf2, 0x24, 0xfa, 0xdf, 0xe9, 0x9f, 0x01, 0x63, 0x07, 0x94, 0xe1, 0xcb,
+	0x6c, 0x0b, 0xf9, 0x18, 0x00, 0x9c, 0x46, 0x6b, 0x40, 0x64, 0x46, 0x87, 0x38, 0x8d, 0x94, 0xdc,
+	0x06, 0x07, 0x53, 0xca, 0xf1, 0x3a, 0x53, 0x75, 0x3f, 0xd3, 0x05, 0xe5, 0x58, 0x05, 0x92, 0x20,
+	0x0c, 0x40, 0x29, 0xc1, 0x49, 0x80, 0x33, 0x66, 0x16, 0xeb, 0x85, 0xa6, 0xe1, 0x9e, 0xfd, 0xbb,
+	0xa8, 0xb5, 0x62, 0xc2, 0x87, 0x93, 0xc0, 0x0e, 0x69, 0xa2, 0xbe, 0x0f, 0xf5, 0xd3, 0x62, 0xd1,
+	0x4f, 0x0e, 0x9f, 0x8d, 0x31, 0xb3, 0x2f, 0xfc, 0xd1, 0x8b, 0x28, 0xca, 0x30, 0x63, 0x7f, 0x5d,
+	0xb6, 0x1e, 0x4a, 0xd9, 0x56, 0x15, 0x77, 0xc6, 0x31, 0x43, 0xeb, 0x17, 0x43, 0x08, 0x74, 0x86,
+	0x71, 0x64, 0x96, 0xea, 0x5a, 0xd3, 0x40, 0xe2, 0xb9, 0xf1, 0xa7, 0x06, 0xf4, 0x95, 0x1b, 0xf8,
+	0x05, 0x00, 0x5b, 0x53, 0x94, 0x4d, 0x7c, 0xb0, 0x5c, 0xd4, 0x0e, 0x6f, 0xc7, 0x77, 0x18, 0x6e,
+	0xe6, 0xf6, 0xa3, 0x0c, 0x98, 0x89, 0x26, 0xbe, 0x4f, 0xb3, 0xf2, 0xb5, 0xb0, 0x0d, 0x4a, 0x81,
+	0x3f, 0x1a, 0x51, 0xce, 0xcc, 0x42, 0xbd, 0x70, 0xd7, 0x9e, 0xbb, 0x42, 0x46, 0x6b, 0x4c, 0xad,
+	0x43, 0x1f, 0x14, 0xa5, 0x70, 0xef, 0x32, 0x98, 0xa0, 0x14, 0xd2, 0x94, 0xe3, 0x54, 0x2e, 0x80,
+	0x81, 0xd6, 0x47, 0x58, 0x05, 0x07, 0xe3, 0x8c, 0xd2, 0xb7, 0x62, 0xde, 0x06, 0x92, 0x87, 0xcf,
+	0x09, 0x78, 0x74, 0xe7, 0xb7, 0x0d, 0x3f, 0x03, 0x8d, 0xf3, 0x57, 0xdd, 0x53, 0xe4, 0x0d, 0xce,
+	0xdd, 0x41, 0x07, 0xf5, 0xdc, 0xd3, 0xae, 0xd7, 0x7f, 0xfd, 0x5d, 0xaf, 0xf3, 0xc6, 0xeb, 0xbc,
+	0x40, 0xe8, 0x8d, 0xf7, 0xfa, 0xe2, 0x14, 0x55, 0x72, 0xb0, 0x01, 0xac, 0xfb, 0xb8, 0xc1, 0x19,
+	0xea, 0xbd, 0xfa, 0xb6, 0xa2, 0x1d, 0xeb, 0xef, 0x7e, 0xb7, 0x72, 0xee, 0xcb, 0xab, 0xa5, 0xa5,
+	0x5d, 0x2f, 0x2d, 0xed, 0x9f, 0xa5, 0xa5, 0xfd, 0x72, 0x63, 0xe5, 0xae, 0x6f, 0xac, 0xdc, 0xdf,
+	0x37, 0x56, 0xee, 0xfb, 0xed, 0xde, 0xb6, 0xe3, 0x91, 0x1f, 0x30, 0xa7, 0x1d, 0xb7, 0xc2, 0xa1,
+	0x4f, 0x52, 0xe7, 0xe7, 0xed, 0x1b, 0x57, 0xb4, 0x39, 0x28, 0x8a, 0x3b, 0xef, 0xcb, 0xff, 0x06,
+	0x00, 0x63, 0x0c, 0x2b, 0xd0, 0x90, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -360,6 +440,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinCouncilSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinCouncilSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnderSubscribedPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnderSubscribedPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.CouncilSize != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CouncilSize))
 		i--
@@ -450,6 +540,13 @@ func (m *Council) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Members) > 0 {
 		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Members[iNdEx])
@@ -565,6 +662,13 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Content) > 0 {
 		i -= len(m.Content)
 		copy(dAtA[i:], m.Content)
@@ -600,6 +704,12 @@ func (m *Params) Size() (n int) {
 	if m.CouncilSize != 0 {
 		n += 1 + sovGenesis(uint64(m.CouncilSize))
 	}
+	if m.UnderSubscribedPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.UnderSubscribedPolicy))
+	}
+	if m.MinCouncilSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MinCouncilSize))
+	}
 	return n
 }
 
@@ -659,6 +769,10 @@ func (m *Council) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -697,6 +811,10 @@ func (m *Ballot) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -754,6 +872,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderSubscribedPolicy", wireType)
+			}
+			m.UnderSubscribedPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnderSubscribedPolicy |= UnderSubscribedPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCouncilSize", wireType)
+			}
+			m.MinCouncilSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCouncilSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1120,6 +1276,40 @@ func (m *Council) Unmarshal(dAtA []byte) error {
 			m.Members = append(m.Members, make([]byte, postIndex-iNdEx))
 			copy(m.Members[len(m.Members)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1360,6 +1550,40 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				m.Content = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
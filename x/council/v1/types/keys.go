@@ -20,6 +20,8 @@ var (
 	VoteKeyPrefix    = []byte{0x01} // prefix for keys that store votes
 	VoterKeyPrefix   = []byte{0x02} // prefix for keys that store voters
 
+	VoterHeightKeyPrefix = []byte{0x07} // prefix for keys that store the height voters registered their key at
+
 	ParamsKey            = []byte{0x03}
 	VotingStartHeightKey = []byte{0x04}
 	VotingPeriodKey      = []byte{0x05}
@@ -7,7 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _, _ sdk.Msg = &MsgRegister{}, &MsgVote{}
+var _, _, _, _ sdk.Msg = &MsgRegister{}, &MsgVote{}, &MsgUnregister{}, &MsgRotateKey{}
 
 // GetSigners returns the expected signers for a MsgRegister message.
 func (msg *MsgRegister) GetSigners() []sdk.AccAddress {
@@ -56,6 +56,11 @@ func (msg *MsgVote) ValidateBasic() error {
 	if _, err := sdk.ValAddressFromBech32(msg.Voter); err != nil {
 		return ErrInvalidValidatorAddress
 	}
+	for _, ballot := range msg.Ballots {
+		if ballot == nil || len(ballot.Content) != vrf.Size || len(ballot.Proof) != vrf.ProofSize {
+			return ErrInvalidBallot
+		}
+	}
 	return nil
 }
 
@@ -63,3 +68,58 @@ func (msg *MsgVote) ValidateBasic() error {
 func (msg MsgVote) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// GetSigners returns the expected signers for a MsgUnregister message.
+func (msg *MsgUnregister) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgUnregister) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Voter); err != nil {
+		return ErrInvalidValidatorAddress
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUnregister) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotateKey message.
+func (msg *MsgRotateKey) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.Voter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgRotateKey) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Voter); err != nil {
+		return ErrInvalidValidatorAddress
+	}
+	if len(msg.Key) != vrf.PublicKeySize {
+		return ErrInvalidPublicKey
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRotateKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
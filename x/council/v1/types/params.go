@@ -0,0 +1,17 @@
+package types
+
+import "fmt"
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.CouncilSize == 0 {
+		return fmt.Errorf("council size must be positive")
+	}
+	if p.MinCouncilSize > p.CouncilSize {
+		return fmt.Errorf("min council size %d exceeds council size %d", p.MinCouncilSize, p.CouncilSize)
+	}
+	if _, ok := UnderSubscribedPolicy_name[int32(p.UnderSubscribedPolicy)]; !ok {
+		return fmt.Errorf("unknown under subscribed policy %d", p.UnderSubscribedPolicy)
+	}
+	return nil
+}
@@ -3,12 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // GetQueryCmd returns the cli query commands for the inflation module.
@@ -22,12 +24,41 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetParams(),
 		GetEpochNumber(),
+		GetSigners(),
 	)
 
 	return cmd
 }
 
+func GetParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current parameters and the ones pending for the next epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetEpochNumber() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "epoch-number",
@@ -55,3 +86,68 @@ func GetEpochNumber() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	FlagFilter = "filter"
+	FlagEpoch  = "epoch"
+)
+
+var signersFilters = map[string]types.SignersFilter{
+	"all":        types.SIGNERS_FILTER_ALL,
+	"registered": types.SIGNERS_FILTER_REGISTERED,
+	"in-quorums": types.SIGNERS_FILTER_IN_QUORUMS,
+}
+
+func GetSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signers",
+		Short: "Query registered signers, optionally filtered by epoch",
+		Example: fmt.Sprintf(`%[1]s q %[2]s signers
+%[1]s q %[2]s signers --filter registered --epoch 10
+%[1]s q %[2]s signers --filter in-quorums --epoch 10 --limit 50`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			filterName, err := cmd.Flags().GetString(FlagFilter)
+			if err != nil {
+				return err
+			}
+			filter, ok := signersFilters[strings.ToLower(filterName)]
+			if !ok {
+				return fmt.Errorf("invalid filter %s, expected one of all, registered, in-quorums", filterName)
+			}
+			epoch, err := cmd.Flags().GetUint64(FlagEpoch)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Signers(context.Background(), &types.QuerySignersRequest{
+				Filter:      filter,
+				EpochNumber: epoch,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFilter, "all", "Filter signers: all, registered (for the epoch) or in-quorums (of the epoch)")
+	cmd.Flags().Uint64(FlagEpoch, 0, "Epoch the filter applies to")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signers")
+
+	return cmd
+}
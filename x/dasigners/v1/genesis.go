@@ -20,10 +20,60 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs types.GenesisState) {
 			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
 		}
 	}
-	for epoch, quorums := range gs.QuorumsByEpoch {
-		keeper.SetEpochQuorums(ctx, uint64(epoch), *quorums)
+	keeper.SetEarliestEpoch(ctx, gs.EarliestEpoch)
+	for i, quorums := range gs.QuorumsByEpoch {
+		keeper.SetEpochQuorums(ctx, gs.EarliestEpoch+uint64(i), *quorums)
+	}
+	for i, ballots := range gs.BallotsByEpoch {
+		for _, ballot := range ballots.Ballots {
+			if err := keeper.SetEpochBallot(ctx, gs.EarliestEpoch+uint64(i), *ballot); err != nil {
+				panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+			}
+		}
+	}
+	for _, flag := range gs.SignerFlags {
+		if err := keeper.SetSignerFlag(ctx, *flag); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, missed := range gs.MissedSignatures {
+		if err := keeper.SetMissedSignatures(ctx, *missed); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, evidence := range gs.ReportedEvidence {
+		keeper.SetReportedEvidence(ctx, *evidence)
+	}
+	for _, exit := range gs.SignerExits {
+		if err := keeper.SetSignerExit(ctx, *exit); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, rotation := range gs.KeyRotations {
+		if err := keeper.SetKeyRotation(ctx, *rotation); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, record := range gs.KeyHistory {
+		if err := keeper.SetSignerKeyRecord(ctx, *record); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	if err := keeper.TrackActiveSigners(ctx); err != nil {
+		panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
 	}
 	keeper.SetParams(ctx, gs.Params)
+	if gs.PendingParams != nil {
+		keeper.SetPendingParams(ctx, *gs.PendingParams)
+	}
+	keeper.SetEpochAnchor(ctx, gs.EpochAnchorHeight, gs.EpochAnchorEpoch)
+	for _, registration := range gs.StandingRegistrations {
+		if err := keeper.SetStandingRegistration(ctx, *registration); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	// registrations are not exported, standing signers are registered for the next epoch again
+	keeper.RenewStandingRegistrations(ctx, gs.EpochNumber+1)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,21 +88,69 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		signers = append(signers, &signer)
 		return false
 	})
+	earliestEpoch := keeper.GetEarliestEpoch(ctx)
 	epochQuorums := make([]*types.Quorums, 0)
-	for i := 0; i < int(epochNumber); i += 1 {
-		quorumCnt, err := keeper.GetQuorumCount(ctx, uint64(i))
+	ballotsByEpoch := make([]*types.SignerBallots, 0)
+	signerFlags := make([]*types.SignerFlag, 0)
+	missedSignatures := make([]*types.MissedSignatures, 0)
+	reportedEvidence := make([]*types.SignedBitmap, 0)
+	for epoch := earliestEpoch; epoch <= epochNumber; epoch += 1 {
+		quorumCnt, err := keeper.GetQuorumCount(ctx, epoch)
 		if err != nil {
 			panic("historical quorums not found")
 		}
 		quorums := make([]*types.Quorum, quorumCnt)
 		for quorumId := uint64(0); quorumId < quorumCnt; quorumId += 1 {
-			quorum, err := keeper.GetEpochQuorum(ctx, uint64(i), quorumId)
+			quorum, err := keeper.GetEpochQuorum(ctx, epoch, quorumId)
 			if err != nil {
 				panic("failed to load historical quorum")
 			}
 			quorums[quorumId] = &quorum
 		}
 		epochQuorums = append(epochQuorums, &types.Quorums{Quorums: quorums})
+		ballots := make([]*types.SignerBallot, 0)
+		keeper.IterateEpochBallots(ctx, epoch, func(ballot types.SignerBallot) (stop bool) {
+			ballots = append(ballots, &ballot)
+			return false
+		})
+		ballotsByEpoch = append(ballotsByEpoch, &types.SignerBallots{Ballots: ballots})
+		keeper.IterateSignerFlags(ctx, epoch, func(flag types.SignerFlag) (stop bool) {
+			signerFlags = append(signerFlags, &flag)
+			return false
+		})
+		keeper.IterateMissedSignatures(ctx, epoch, func(missed types.MissedSignatures) (stop bool) {
+			missedSignatures = append(missedSignatures, &missed)
+			return false
+		})
+		keeper.IterateReportedEvidence(ctx, epoch, func(evidence types.SignedBitmap) (stop bool) {
+			reportedEvidence = append(reportedEvidence, &evidence)
+			return false
+		})
+	}
+	signerExits := make([]*types.SignerExit, 0)
+	keeper.IterateSignerExits(ctx, func(exit types.SignerExit) (stop bool) {
+		signerExits = append(signerExits, &exit)
+		return false
+	})
+	keyRotations := make([]*types.SignerKeyRotation, 0)
+	keeper.IterateKeyRotations(ctx, func(rotation types.SignerKeyRotation) (stop bool) {
+		keyRotations = append(keyRotations, &rotation)
+		return false
+	})
+	keyHistory := make([]*types.SignerKeyRecord, 0)
+	keeper.IterateSignerKeyHistory(ctx, func(record types.SignerKeyRecord) (stop bool) {
+		keyHistory = append(keyHistory, &record)
+		return false
+	})
+	var pendingParams *types.Params
+	if pending, found := keeper.GetPendingParams(ctx); found {
+		pendingParams = &pending
 	}
-	return types.NewGenesisState(params, epochNumber, signers, epochQuorums)
+	anchorHeight, anchorEpoch := keeper.GetEpochAnchor(ctx)
+	standingRegistrations := make([]*types.StandingRegistration, 0)
+	keeper.IterateStandingRegistrations(ctx, func(registration types.StandingRegistration) (stop bool) {
+		standingRegistrations = append(standingRegistrations, &registration)
+		return false
+	})
+	return types.NewGenesisState(params, epochNumber, signers, epochQuorums, signerExits, keyRotations, keyHistory, earliestEpoch, ballotsByEpoch, signerFlags, missedSignatures, reportedEvidence, pendingParams, anchorHeight, anchorEpoch, standingRegistrations)
 }
@@ -1,13 +1,10 @@
 package keeper
 
 import (
-	"bytes"
 	"math/big"
-	"sort"
 
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -23,23 +20,35 @@ func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		panic(err)
 	}
 	params := k.GetParams(ctx)
-	expectedEpoch := uint64(ctx.BlockHeight()) / params.EpochBlocks
+	expectedEpoch := k.ExpectedEpoch(ctx, params)
 	if expectedEpoch == epochNumber {
+		// drain the pruning backlog, if any
+		k.PruneEpochs(ctx, epochNumber)
 		return
 	}
 	if expectedEpoch > epochNumber+1 || expectedEpoch < epochNumber {
 		panic("block height is not continuous")
 	}
-	// new epoch
+	// new epoch, parameter updates take effect from here
+	params = k.applyPendingParams(ctx, expectedEpoch, params)
 	registrations := []Ballot{}
 	k.IterateRegistrations(ctx, expectedEpoch, func(account string, signature []byte) (stop bool) {
+		// exiting signers are no longer eligible for quorums
+		if exiting, err := k.IsSignerExiting(ctx, account); err != nil || exiting {
+			return false
+		}
+		// signers flagged by the staking hooks or jailed for missed signatures sit out the next epoch
+		if _, flagged, err := k.GetSignerFlag(ctx, epochNumber, account); err != nil || flagged {
+			return false
+		}
 		registrations = append(registrations, Ballot{
 			account: account,
 			content: signature,
 		})
 		return false
 	})
-	ballots := []Ballot{}
+	candidates := make([]Candidate, 0, len(registrations))
+	signerBallots := make([]types.SignerBallot, 0, len(registrations))
 	tokensPerVote := sdk.NewIntFromUint64(params.TokensPerVote)
 	for _, registration := range registrations {
 		// get validator
@@ -50,64 +59,69 @@ func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		}
 		bonded := k.GetDelegatorBonded(ctx, accAddr)
 		num := bonded.Quo(BondedConversionRate).Quo(tokensPerVote).Abs().BigInt()
+		capped := false
 		if num.Cmp(big.NewInt(int64(params.MaxVotesPerSigner))) > 0 {
 			num = big.NewInt(int64(params.MaxVotesPerSigner))
+			capped = true
 		}
-		content := registration.content
-		ballotNum := num.Int64()
-		for j := 0; j < int(ballotNum); j += 1 {
-			ballots = append(ballots, Ballot{
-				account: registration.account,
-				content: content,
-			})
-			content = crypto.Keccak256(content)
-		}
+		signerBallots = append(signerBallots, types.SignerBallot{
+			Account: registration.account,
+			Bonded:  bonded,
+			Votes:   num.Uint64(),
+			Capped:  capped,
+		})
+		candidates = append(candidates, Candidate{
+			Account: registration.account,
+			Seed:    registration.content,
+			Votes:   num.Uint64(),
+			Bonded:  bonded,
+		})
 	}
-	sort.Slice(ballots, func(i, j int) bool {
-		return bytes.Compare(ballots[i].content, ballots[j].content) < 0
-	})
 
-	quorums := types.Quorums{
-		Quorums: make([]*types.Quorum, 0),
-	}
-	if len(ballots) >= int(params.EncodedSlices) {
-		for i := 0; i+int(params.EncodedSlices) <= len(ballots); i += int(params.EncodedSlices) {
-			if int(params.MaxQuorums) < len(quorums.Quorums) {
-				break
-			}
-			quorum := types.Quorum{
-				Signers: make([]string, params.EncodedSlices),
-			}
-			for j := 0; j < int(params.EncodedSlices); j += 1 {
-				quorum.Signers[j] = ballots[i+j].account
-			}
-			quorums.Quorums = append(quorums.Quorums, &quorum)
-		}
-		if len(ballots)%int(params.EncodedSlices) != 0 && int(params.MaxQuorums) > len(quorums.Quorums) {
-			quorum := types.Quorum{
-				Signers: make([]string, 0),
-			}
-			for j := len(ballots) - int(params.EncodedSlices); j < len(ballots); j += 1 {
-				quorum.Signers = append(quorum.Signers, ballots[j].account)
-			}
-			quorums.Quorums = append(quorums.Quorums, &quorum)
-		}
-	} else if len(ballots) > 0 {
-		quorum := types.Quorum{
-			Signers: make([]string, params.EncodedSlices),
-		}
-		n := len(ballots)
-		for i := 0; i < int(params.EncodedSlices); i += 1 {
-			quorum.Signers[i] = ballots[i%n].account
-		}
-		quorums.Quorums = append(quorums.Quorums, &quorum)
-	} else {
-		quorums.Quorums = append(quorums.Quorums, &types.Quorum{
-			Signers: make([]string, 0),
-		})
+	strategy, err := GetQuorumStrategy(params.QuorumStrategy)
+	if err != nil {
+		k.Logger(ctx).Error("[BeginBlock] falling back to sorted ballots", "err", err)
+		strategy = SortedBallotsStrategy{}
 	}
+	quorums := strategy.AssignQuorums(candidates, params)
 
 	// save to store
 	k.SetEpochQuorums(ctx, expectedEpoch, quorums)
 	k.SetEpochNumber(ctx, expectedEpoch)
+	k.saveEpochBallots(ctx, expectedEpoch, signerBallots, quorums)
+
+	// keep standing signers registered for the next epoch
+	k.RenewStandingRegistrations(ctx, expectedEpoch+1)
+
+	// switch rotated keys for the new epoch
+	k.ApplyKeyRotations(ctx, expectedEpoch)
+
+	// drop history out of the retention window
+	k.PruneEpochs(ctx, expectedEpoch)
+
+	// complete matured exits, an exit that fails leaves no partial writes and stays queued for the next epoch
+	for _, account := range k.GetMatureExits(ctx, expectedEpoch) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.TombstoneSigner(cacheCtx, account); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to tombstone signer", "signer", account, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+// saveEpochBallots persists the ballot breakdown of an epoch together with the quorum seats each signer won.
+func (k Keeper) saveEpochBallots(ctx sdk.Context, epoch uint64, signerBallots []types.SignerBallot, quorums types.Quorums) {
+	seats := make(map[string]uint64)
+	for _, quorum := range quorums.Quorums {
+		for _, signer := range quorum.Signers {
+			seats[signer] += 1
+		}
+	}
+	for _, ballot := range signerBallots {
+		ballot.Seats = seats[ballot.Account]
+		if err := k.SetEpochBallot(ctx, epoch, ballot); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to save ballot", "signer", ballot.Account, "err", err)
+		}
+	}
 }
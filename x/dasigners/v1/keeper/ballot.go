@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetEpochBallot(ctx sdk.Context, epoch uint64, account string) (types.SignerBallot, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochBallotKeyPrefix(epoch))
+	key, err := types.GetEpochBallotKey(account)
+	if err != nil {
+		return types.SignerBallot{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerBallot{}, false, nil
+	}
+	var ballot types.SignerBallot
+	k.cdc.MustUnmarshal(bz, &ballot)
+	return ballot, true, nil
+}
+
+func (k Keeper) SetEpochBallot(ctx sdk.Context, epoch uint64, ballot types.SignerBallot) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochBallotKeyPrefix(epoch))
+	key, err := types.GetEpochBallotKey(ballot.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&ballot))
+	return nil
+}
+
+// iterate through the ballots of an epoch and perform the provided function
+func (k Keeper) IterateEpochBallots(ctx sdk.Context, epoch uint64, fn func(ballot types.SignerBallot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEpochBallotKeyPrefix(epoch))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ballot types.SignerBallot
+		k.cdc.MustUnmarshal(iterator.Value(), &ballot)
+		if fn(ballot) {
+			break
+		}
+	}
+}
+
+func (k Keeper) deleteEpochBallots(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochBallotKeyPrefix(epoch))
+	iterator := store.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// runBallotEpoch registers a signer over the vote cap and one under it for epoch 1 and starts that epoch
+func (suite *KeeperTestSuite) runBallotEpoch() (capped string, uncapped string) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxVotesPerSigner = 5
+	suite.keeper.SetParams(suite.ctx, params)
+
+	capped = hex.EncodeToString(suite.createSigner(votes(10)))
+	uncapped = hex.EncodeToString(suite.createSigner(votes(3)))
+	for _, account := range []string{capped, uncapped} {
+		suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 1, account, []byte(account)))
+	}
+	suite.beginBlocks(10)
+	suite.Require().Equal(uint64(1), suite.epochNumber())
+	return capped, uncapped
+}
+
+func (suite *KeeperTestSuite) TestBeginBlock_SavesEpochBallots() {
+	capped, uncapped := suite.runBallotEpoch()
+
+	// the seats of every signer add up to its rows in the quorums of the epoch
+	seats := make(map[string]uint64)
+	quorumCount, err := suite.keeper.GetQuorumCount(suite.ctx, 1)
+	suite.Require().NoError(err)
+	for quorumId := uint64(0); quorumId < quorumCount; quorumId += 1 {
+		quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, 1, quorumId)
+		suite.Require().NoError(err)
+		for _, signer := range quorum.Signers {
+			seats[signer] += 1
+		}
+	}
+	suite.Require().Len(seats, 2)
+
+	testCases := []struct {
+		account string
+		bonded  int64
+		votes   uint64
+		capped  bool
+	}{
+		{capped, 10, 5, true},
+		{uncapped, 3, 3, false},
+	}
+	for _, tc := range testCases {
+		ballot, found, err := suite.keeper.GetEpochBallot(suite.ctx, 1, tc.account)
+		suite.Require().NoError(err)
+		suite.Require().True(found)
+		suite.Require().Equal(types.SignerBallot{
+			Account: tc.account,
+			Bonded:  votes(tc.bonded),
+			Votes:   tc.votes,
+			Capped:  tc.capped,
+			Seats:   seats[tc.account],
+		}, ballot)
+	}
+
+	// signers that were not on the ballot have no breakdown
+	ballots := make([]types.SignerBallot, 0)
+	suite.keeper.IterateEpochBallots(suite.ctx, 1, func(ballot types.SignerBallot) bool {
+		ballots = append(ballots, ballot)
+		return false
+	})
+	suite.Require().Len(ballots, 2)
+	_, found, err := suite.keeper.GetEpochBallot(suite.ctx, 0, capped)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
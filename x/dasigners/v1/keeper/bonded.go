@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// The bonded tokens of every signer are cached per validator and kept up to date by the staking
+// hooks, so that quorum selection reads a single value per signer instead of walking delegations.
+
+func (k Keeper) getInt(ctx sdk.Context, key []byte) (math.Int, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return math.ZeroInt(), false
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount, true
+}
+
+func (k Keeper) setInt(ctx sdk.Context, key []byte, amount math.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+// IsTrackedSigner returns true if the bonded tokens of the account are cached.
+func (k Keeper) IsTrackedSigner(ctx sdk.Context, signer sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetSignerBondedKey(signer))
+}
+
+// GetDelegatorBonded returns the cached bonded tokens of a signer.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int {
+	bonded, _ := k.getInt(ctx, types.GetSignerBondedKey(delegator))
+	return bonded
+}
+
+// TrackSignerDelegations builds the bonded cache of a signer from all of its delegations. It walks
+// every delegation of the signer and is therefore only called from transactions that pay for it.
+func (k Keeper) TrackSignerDelegations(ctx sdk.Context, signer sdk.AccAddress) {
+	k.UntrackSignerDelegations(ctx, signer)
+	k.setInt(ctx, types.GetSignerBondedKey(signer), math.ZeroInt())
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, signer, func(delegation stakingtypes.Delegation) bool {
+		k.updateSignerDelegation(ctx, signer, delegation.GetValidatorAddr())
+		return false
+	})
+}
+
+// UntrackSignerDelegations removes the bonded cache of a signer.
+func (k Keeper) UntrackSignerDelegations(ctx sdk.Context, signer sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSignerDelegationPrefix(signer))
+	validators := make([]sdk.ValAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		validators = append(validators, sdk.ValAddress(iterator.Key()[len(types.GetSignerDelegationPrefix(signer)):]))
+	}
+	iterator.Close()
+	for _, validator := range validators {
+		store.Delete(types.GetSignerDelegationKey(signer, validator))
+		store.Delete(types.GetValidatorSignerKey(validator, signer))
+	}
+	store.Delete(types.GetSignerBondedKey(signer))
+}
+
+// updateSignerDelegation refreshes the cached tokens of one delegation and the signer total.
+func (k Keeper) updateSignerDelegation(ctx sdk.Context, signer sdk.AccAddress, validator sdk.ValAddress) {
+	tokens := math.ZeroInt()
+	if delegation, found := k.stakingKeeper.GetDelegation(ctx, signer, validator); found {
+		if val, found := k.stakingKeeper.GetValidator(ctx, validator); found {
+			tokens = val.TokensFromSharesTruncated(delegation.Shares).TruncateInt()
+		}
+	}
+	k.setSignerDelegationTokens(ctx, signer, validator, tokens)
+}
+
+func (k Keeper) setSignerDelegationTokens(ctx sdk.Context, signer sdk.AccAddress, validator sdk.ValAddress, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSignerDelegationKey(signer, validator)
+	previous, _ := k.getInt(ctx, key)
+	bonded := k.GetDelegatorBonded(ctx, signer).Sub(previous).Add(tokens)
+	k.setInt(ctx, types.GetSignerBondedKey(signer), bonded)
+	if tokens.IsZero() {
+		store.Delete(key)
+		store.Delete(types.GetValidatorSignerKey(validator, signer))
+		return
+	}
+	k.setInt(ctx, key, tokens)
+	store.Set(types.GetValidatorSignerKey(validator, signer), []byte{})
+}
+
+// getValidatorSigners returns the tracked signers delegating to the validator.
+func (k Keeper) getValidatorSigners(ctx sdk.Context, validator sdk.ValAddress) []sdk.AccAddress {
+	prefix := types.GetValidatorSignerPrefix(validator)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	signers := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		signers = append(signers, sdk.AccAddress(iterator.Key()[len(prefix):]))
+	}
+	return signers
+}
+
+// slashSignerDelegations reduces the cached tokens of all signers delegating to a slashed validator.
+func (k Keeper) slashSignerDelegations(ctx sdk.Context, validator sdk.ValAddress, fraction sdk.Dec) {
+	remaining := sdk.OneDec().Sub(fraction)
+	for _, signer := range k.getValidatorSigners(ctx, validator) {
+		tokens, _ := k.getInt(ctx, types.GetSignerDelegationKey(signer, validator))
+		k.setSignerDelegationTokens(ctx, signer, validator, sdk.NewDecFromInt(tokens).Mul(remaining).TruncateInt())
+	}
+}
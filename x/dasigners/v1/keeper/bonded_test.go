@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/0glabs/0g-chain/app"
+)
+
+// stakedTokens sums the tokens of the delegations of an account as the staking module values them
+func (suite *KeeperTestSuite) stakedTokens(delegator sdk.AccAddress) sdkmath.Int {
+	tokens := sdk.ZeroInt()
+	for _, delegation := range suite.stakingKeeper.GetAllDelegatorDelegations(suite.ctx, delegator) {
+		validator, found := suite.stakingKeeper.GetValidator(suite.ctx, delegation.GetValidatorAddr())
+		suite.Require().True(found)
+		tokens = tokens.Add(validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt())
+	}
+	return tokens
+}
+
+func (suite *KeeperTestSuite) redelegate(delegator sdk.AccAddress, src sdk.ValAddress, dst sdk.ValAddress, amount sdkmath.Int) {
+	msgServer := stakingkeeper.NewMsgServerImpl(suite.stakingKeeper)
+	_, err := msgServer.BeginRedelegate(sdk.WrapSDKContext(suite.ctx), stakingtypes.NewMsgBeginRedelegate(
+		delegator,
+		src,
+		dst,
+		sdk.NewCoin(suite.stakingKeeper.BondDenom(suite.ctx), amount),
+	))
+	suite.Require().NoError(err)
+}
+
+// slash slashes the validator for an infraction at the given height, with the given consensus power
+func (suite *KeeperTestSuite) slash(validator sdk.ValAddress, infractionHeight int64, power int64, fraction sdk.Dec) {
+	val, found := suite.stakingKeeper.GetValidator(suite.ctx, validator)
+	suite.Require().True(found)
+	consAddr, err := val.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.stakingKeeper.Slash(suite.ctx, consAddr, infractionHeight, power, fraction)
+}
+
+func (suite *KeeperTestSuite) TestSignerBonded_FollowsDelegations() {
+	signer := suite.createSigner(votes(10))
+	first := suite.createSigner(votes(10))
+	second := suite.createSigner(votes(10))
+	suite.bondValidators()
+
+	testCases := []struct {
+		name   string
+		action func()
+		bonded sdkmath.Int
+	}{
+		{"delegate", func() { suite.delegate(signer, sdk.ValAddress(first), votes(6)) }, votes(16)},
+		{"delegate more", func() { suite.delegate(signer, sdk.ValAddress(first), votes(2)) }, votes(18)},
+		{"redelegate part", func() { suite.redelegate(signer, sdk.ValAddress(first), sdk.ValAddress(second), votes(5)) }, votes(18)},
+		{"redelegate the rest", func() { suite.redelegate(signer, sdk.ValAddress(first), sdk.ValAddress(second), votes(3)) }, votes(18)},
+		{"undelegate", func() { suite.undelegate(signer, sdk.ValAddress(second), votes(4)) }, votes(14)},
+		{"undelegate the rest", func() { suite.undelegate(signer, sdk.ValAddress(second), votes(4)) }, votes(10)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.action()
+			suite.Require().Equal(tc.bonded, suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+			suite.Require().Equal(suite.stakedTokens(signer), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+		})
+	}
+
+	// delegators that are not signers are left out of the cache
+	delegator := app.RandomAddress()
+	suite.Require().NoError(suite.app.FundAccount(suite.ctx, delegator, sdk.NewCoins(
+		sdk.NewCoin(suite.stakingKeeper.BondDenom(suite.ctx), votes(10)),
+	)))
+	suite.delegate(delegator, sdk.ValAddress(first), votes(10))
+	suite.Require().False(suite.keeper.IsTrackedSigner(suite.ctx, delegator))
+	suite.Require().True(suite.keeper.GetDelegatorBonded(suite.ctx, delegator).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSignerBonded_SlashedByEffectiveFraction() {
+	validator := suite.createSigner(votes(10))
+	delegator := suite.createSigner(votes(10))
+	suite.delegate(delegator, sdk.ValAddress(validator), votes(10))
+	suite.bondValidators()
+
+	// the infraction is charged on more power than the validator has left, so the slash burns
+	// three quarters of its tokens rather than the half asked for
+	val, found := suite.stakingKeeper.GetValidator(suite.ctx, sdk.ValAddress(validator))
+	suite.Require().True(found)
+	power := val.ConsensusPower(suite.stakingKeeper.PowerReduction(suite.ctx))
+	suite.slash(sdk.ValAddress(validator), suite.ctx.BlockHeight(), power*3/2, sdk.NewDecWithPrec(5, 1))
+
+	suite.Require().Equal(votes(10).QuoRaw(4), suite.keeper.GetDelegatorBonded(suite.ctx, validator))
+	suite.Require().Equal(votes(10).QuoRaw(4).Add(votes(10)), suite.keeper.GetDelegatorBonded(suite.ctx, delegator))
+	for _, signer := range []sdk.AccAddress{validator, delegator} {
+		suite.Require().Equal(suite.stakedTokens(signer), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+	}
+}
+
+func (suite *KeeperTestSuite) TestSignerBonded_SlashedRedelegation() {
+	source := suite.createSigner(votes(10))
+	destination := suite.createSigner(votes(10))
+	signer := suite.createSigner(votes(10))
+	suite.delegate(signer, sdk.ValAddress(source), votes(8))
+	suite.bondValidators()
+	infractionHeight := suite.ctx.BlockHeight()
+	suite.ctx = suite.ctx.WithBlockHeight(infractionHeight + 1)
+	suite.redelegate(signer, sdk.ValAddress(source), sdk.ValAddress(destination), votes(8))
+	suite.Require().Equal(votes(18), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+
+	// the redelegation happened after the infraction, so half of it is taken from the destination
+	val, found := suite.stakingKeeper.GetValidator(suite.ctx, sdk.ValAddress(source))
+	suite.Require().True(found)
+	power := val.ConsensusPower(suite.stakingKeeper.PowerReduction(suite.ctx))
+	suite.slash(sdk.ValAddress(source), infractionHeight, power*18/10, sdk.NewDecWithPrec(5, 1))
+
+	suite.Require().Equal(votes(14), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+	suite.Require().Equal(suite.stakedTokens(signer), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+	suite.Require().Equal(suite.stakedTokens(source), suite.keeper.GetDelegatorBonded(suite.ctx, source))
+}
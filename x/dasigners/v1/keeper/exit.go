@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetSignerExit(ctx sdk.Context, account string) (types.SignerExit, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerExitKeyPrefix)
+	key, err := types.GetSignerExitKey(account)
+	if err != nil {
+		return types.SignerExit{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerExit{}, false, nil
+	}
+	var exit types.SignerExit
+	k.cdc.MustUnmarshal(bz, &exit)
+	return exit, true, nil
+}
+
+// SetSignerExit saves the exit record and keeps the exit queue in sync with it.
+func (k Keeper) SetSignerExit(ctx sdk.Context, exit types.SignerExit) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerExitKeyPrefix)
+	key, err := types.GetSignerExitKey(exit.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&exit))
+
+	queueKey, err := types.GetExitQueueKey(exit.ExitEpoch, exit.Account)
+	if err != nil {
+		return err
+	}
+	if exit.Tombstoned {
+		ctx.KVStore(k.storeKey).Delete(queueKey)
+	} else {
+		ctx.KVStore(k.storeKey).Set(queueKey, []byte{})
+	}
+	return nil
+}
+
+// iterate through the signer exits set and perform the provided function
+func (k Keeper) IterateSignerExits(ctx sdk.Context, fn func(exit types.SignerExit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SignerExitKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exit types.SignerExit
+		k.cdc.MustUnmarshal(iterator.Value(), &exit)
+		if fn(exit) {
+			break
+		}
+	}
+}
+
+// IsSignerExiting returns true if the signer has requested to deregister, whether or not the exit has completed.
+func (k Keeper) IsSignerExiting(ctx sdk.Context, account string) (bool, error) {
+	_, found, err := k.GetSignerExit(ctx, account)
+	return found, err
+}
+
+// GetMatureExits returns the queued accounts whose exit epoch is no later than the given epoch. The
+// queue entries are only removed by TombstoneSigner once the exit completes.
+func (k Keeper) GetMatureExits(ctx sdk.Context, epoch uint64) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.ExitQueueKeyPrefix, sdk.PrefixEndBytes(types.GetExitQueueKeyPrefix(epoch)))
+	defer iterator.Close()
+
+	accounts := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// key layout: prefix | epoch (8 bytes) | account
+		accounts = append(accounts, hex.EncodeToString(iterator.Key()[len(types.ExitQueueKeyPrefix)+8:]))
+	}
+	return accounts
+}
+
+// TombstoneSigner completes the exit of a signer. The signer record is kept so that historical
+// quorums can still be verified, but its socket is cleared and it can never be registered again.
+func (k Keeper) TombstoneSigner(ctx sdk.Context, account string) error {
+	exit, found, err := k.GetSignerExit(ctx, account)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrSignerExitNotFound
+	}
+	signer, found, err := k.GetSigner(ctx, account)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrSignerNotFound
+	}
+	signer.Socket = ""
+	if err := k.SetSigner(ctx, signer); err != nil {
+		return err
+	}
+	exit.Tombstoned = true
+	if err := k.SetSignerExit(ctx, exit); err != nil {
+		return err
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	if err != nil {
+		return err
+	}
+	k.UntrackSignerDelegations(ctx, accAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSignerExited,
+			sdk.NewAttribute(types.AttributeKeySigner, account),
+			sdk.NewAttribute(types.AttributeKeyExitEpoch, strconv.FormatUint(exit.ExitEpoch, 10)),
+		),
+	)
+	return nil
+}
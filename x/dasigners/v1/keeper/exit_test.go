@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) deregisterSigner(account string) (*types.MsgDeregisterSignerResponse, error) {
+	return suite.keeper.DeregisterSigner(sdk.WrapSDKContext(suite.ctx), &types.MsgDeregisterSigner{Account: account})
+}
+
+func (suite *KeeperTestSuite) signerExit(account string) types.SignerExit {
+	exit, found, err := suite.keeper.GetSignerExit(suite.ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	return exit
+}
+
+func (suite *KeeperTestSuite) TestDeregisterSigner_TombstonedAtExitEpoch() {
+	account := hex.EncodeToString(suite.createSigner(votes(10)))
+	suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 1, account, []byte{0x01}))
+	suite.beginBlocks(10)
+	suite.Require().True(suite.inQuorums(1, account))
+
+	res, err := suite.deregisterSigner(account)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.ExitEpoch)
+	_, err = suite.deregisterSigner(account)
+	suite.Require().ErrorIs(err, types.ErrSignerExiting)
+
+	// the signer sits out the next epochs but keeps its record until the exit epoch
+	suite.beginBlocks(20)
+	suite.Require().False(suite.inQuorums(2, account))
+	suite.Require().Empty(suite.keeper.GetMatureExits(suite.ctx, 2))
+	suite.Require().Equal([]string{account}, suite.keeper.GetMatureExits(suite.ctx, 3))
+	signer, found, err := suite.keeper.GetSigner(suite.ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().NotEmpty(signer.Socket)
+	suite.Require().False(suite.signerExit(account).Tombstoned)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.beginBlocks(30)
+	suite.Require().Equal(uint64(3), suite.epochNumber())
+	suite.Require().True(suite.signerExit(account).Tombstoned)
+	suite.Require().Empty(suite.keeper.GetMatureExits(suite.ctx, 3))
+	signer, found, err = suite.keeper.GetSigner(suite.ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Empty(signer.Socket)
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSignerExited,
+		sdk.NewAttribute(types.AttributeKeySigner, account),
+		sdk.NewAttribute(types.AttributeKeyExitEpoch, "3"),
+	)))
+}
+
+func (suite *KeeperTestSuite) TestDeregisterSigner_FailedTombstoneStaysQueued() {
+	// an exit whose signer record is missing cannot be tombstoned
+	account := hex.EncodeToString(app.RandomAddress())
+	suite.Require().NoError(suite.keeper.SetSignerExit(suite.ctx, types.SignerExit{
+		Account:   account,
+		ExitEpoch: 1,
+	}))
+
+	suite.beginBlocks(10)
+	suite.Require().Equal(uint64(1), suite.epochNumber())
+	suite.Require().False(suite.signerExit(account).Tombstoned)
+	suite.Require().Equal([]string{account}, suite.keeper.GetMatureExits(suite.ctx, 1))
+
+	// the exit is retried at the next epoch
+	suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: make([]byte, 64),
+		PubkeyG2: make([]byte, 128),
+	}))
+	suite.beginBlocks(20)
+	suite.Require().True(suite.signerExit(account).Tombstoned)
+	suite.Require().Empty(suite.keeper.GetMatureExits(suite.ctx, 2))
+}
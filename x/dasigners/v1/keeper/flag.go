@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// Signers are flagged when their stake is slashed, jailed or unbonded in the middle of an epoch, or
+// when they miss too many signatures. The quorums of the epoch are left untouched so that the signer
+// bitmaps stay valid, but flagged signers are excluded from the ballot of the next epoch.
+
+func (k Keeper) GetSignerFlag(ctx sdk.Context, epoch uint64, account string) (types.SignerFlag, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSignerFlagKeyPrefix(epoch))
+	key, err := types.GetSignerFlagKey(account)
+	if err != nil {
+		return types.SignerFlag{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerFlag{}, false, nil
+	}
+	var flag types.SignerFlag
+	k.cdc.MustUnmarshal(bz, &flag)
+	return flag, true, nil
+}
+
+func (k Keeper) SetSignerFlag(ctx sdk.Context, flag types.SignerFlag) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSignerFlagKeyPrefix(flag.Epoch))
+	key, err := types.GetSignerFlagKey(flag.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&flag))
+	return nil
+}
+
+// iterate through the flagged signers of an epoch and perform the provided function
+func (k Keeper) IterateSignerFlags(ctx sdk.Context, epoch uint64, fn func(flag types.SignerFlag) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSignerFlagKeyPrefix(epoch))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flag types.SignerFlag
+		k.cdc.MustUnmarshal(iterator.Value(), &flag)
+		if fn(flag) {
+			break
+		}
+	}
+}
+
+// FlagSigner flags a signer registered for the current or the next epoch, the flag is set in the
+// current epoch and keeps the signer out of the ballot of the next one. Signers that are registered
+// for neither epoch or already flagged are ignored.
+func (k Keeper) FlagSigner(ctx sdk.Context, signer sdk.AccAddress, reason string) error {
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		// not initialized yet
+		return nil
+	}
+	account := hex.EncodeToString(signer)
+	_, found, err := k.GetRegistration(ctx, epochNumber, account)
+	if err != nil {
+		return err
+	}
+	if !found {
+		if _, found, err = k.GetRegistration(ctx, epochNumber+1, account); err != nil || !found {
+			return err
+		}
+	}
+	_, err = k.flagSigner(ctx, epochNumber, account, reason)
+	return err
+}
+
+// flagSigner flags a signer in the given epoch unless it is already flagged, and returns whether
+// a new flag was set.
+func (k Keeper) flagSigner(ctx sdk.Context, epoch uint64, account string, reason string) (bool, error) {
+	if _, found, err := k.GetSignerFlag(ctx, epoch, account); err != nil || found {
+		return false, err
+	}
+	if err := k.SetSignerFlag(ctx, types.SignerFlag{
+		Account: account,
+		Epoch:   epoch,
+		Reason:  reason,
+		Height:  ctx.BlockHeight(),
+	}); err != nil {
+		return false, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFlagSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, account),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return true, nil
+}
+
+// flagValidatorSigners flags every tracked signer delegating to the validator.
+func (k Keeper) flagValidatorSigners(ctx sdk.Context, validator sdk.ValAddress, reason string) error {
+	for _, signer := range k.getValidatorSigners(ctx, validator) {
+		if err := k.FlagSigner(ctx, signer, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flagIfUnbonded flags a tracked signer whose bonded tokens no longer earn a single vote.
+func (k Keeper) flagIfUnbonded(ctx sdk.Context, signer sdk.AccAddress) error {
+	if err := k.CheckDelegations(ctx, hex.EncodeToString(signer)); err != nil {
+		return k.FlagSigner(ctx, signer, types.FlagReasonUnbonded)
+	}
+	return nil
+}
+
+func (k Keeper) deleteSignerFlags(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSignerFlagKeyPrefix(epoch))
+	iterator := store.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -2,15 +2,31 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
-	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
 
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	response := types.QueryParamsResponse{Params: k.GetParams(ctx)}
+	if pending, found := k.GetPendingParams(ctx); found {
+		response.PendingParams = &pending
+	}
+	return &response, nil
+}
+
 func (k Keeper) Signer(
 	c context.Context,
 	request *types.QuerySignerRequest,
@@ -31,6 +47,68 @@ func (k Keeper) Signer(
 	return &response, nil
 }
 
+func (k Keeper) Signers(c context.Context, request *types.QuerySignersRequest) (*types.QuerySignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	match, err := k.signersFilter(ctx, request.Filter, request.EpochNumber)
+	if err != nil {
+		return nil, err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyPrefix)
+	signers := make([]*types.Signer, 0)
+	pageRes, err := query.FilteredPaginate(store, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		matched, err := match(hex.EncodeToString(key))
+		if err != nil || !matched {
+			return false, err
+		}
+		if accumulate {
+			var signer types.Signer
+			if err := k.cdc.Unmarshal(value, &signer); err != nil {
+				return false, err
+			}
+			signers = append(signers, &signer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySignersResponse{Signers: signers, Pagination: pageRes}, nil
+}
+
+// signersFilter returns whether a signer account passes the filter of a signers listing.
+func (k Keeper) signersFilter(ctx sdk.Context, filter types.SignersFilter, epoch uint64) (func(account string) (bool, error), error) {
+	switch filter {
+	case types.SIGNERS_FILTER_ALL:
+		return func(string) (bool, error) { return true, nil }, nil
+	case types.SIGNERS_FILTER_REGISTERED:
+		return func(account string) (bool, error) {
+			_, found, err := k.GetRegistration(ctx, epoch, account)
+			return found, err
+		}, nil
+	case types.SIGNERS_FILTER_IN_QUORUMS:
+		quorumCount, err := k.GetQuorumCount(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		members := make(map[string]struct{})
+		for quorumId := uint64(0); quorumId < quorumCount; quorumId += 1 {
+			quorum, err := k.GetEpochQuorum(ctx, epoch, quorumId)
+			if err != nil {
+				return nil, err
+			}
+			for _, signer := range quorum.Signers {
+				members[signer] = struct{}{}
+			}
+		}
+		return func(account string) (bool, error) {
+			_, ok := members[account]
+			return ok, nil
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown signers filter %d", filter)
+	}
+}
+
 func (k Keeper) EpochNumber(
 	c context.Context,
 	_ *types.QueryEpochNumberRequest,
@@ -79,39 +157,149 @@ func (k Keeper) EpochQuorumRow(c context.Context, request *types.QueryEpochQuoru
 
 func (k Keeper) AggregatePubkeyG1(c context.Context, request *types.QueryAggregatePubkeyG1Request) (*types.QueryAggregatePubkeyG1Response, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	quorum, err := k.GetEpochQuorum(ctx, request.EpochNumber, request.QuorumId)
+	aggPubkeyG1, _, hit, total, err := k.AggregatePubkeys(ctx, request.EpochNumber, request.QuorumId, request.QuorumBitmap)
 	if err != nil {
 		return nil, err
 	}
-	if (len(quorum.Signers)+7)/8 != len(request.QuorumBitmap) {
-		return nil, types.ErrQuorumBitmapLengthMismatch
+	return &types.QueryAggregatePubkeyG1Response{
+		AggregatePubkeyG1: bn254util.SerializeG1(aggPubkeyG1),
+		Total:             total,
+		Hit:               hit,
+	}, nil
+}
+
+func (k Keeper) VerifyAggregateSignature(c context.Context, request *types.QueryVerifyAggregateSignatureRequest) (*types.QueryVerifyAggregateSignatureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valid, hit, total, err := k.VerifyQuorumSignature(ctx, request.EpochNumber, request.QuorumId, request.QuorumBitmap, request.MessageHash, request.Signature)
+	if err != nil {
+		return nil, err
 	}
-	aggPubkeyG1 := new(bn254.G1Affine)
-	hit := 0
-	added := make(map[string]struct{})
-	for i, signer := range quorum.Signers {
-		if _, ok := added[signer]; ok {
-			hit += 1
-			continue
-		}
-		b := request.QuorumBitmap[i/8] & (1 << (i % 8))
-		if b == 0 {
-			continue
+	return &types.QueryVerifyAggregateSignatureResponse{
+		Valid: valid,
+		Total: total,
+		Hit:   hit,
+	}, nil
+}
+
+func (k Keeper) SignerExit(c context.Context, request *types.QuerySignerExitRequest) (*types.QuerySignerExitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	exit, found, err := k.GetSignerExit(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerExitNotFound
+	}
+	return &types.QuerySignerExitResponse{SignerExit: &exit}, nil
+}
+
+func (k Keeper) SignerExits(c context.Context, request *types.QuerySignerExitsRequest) (*types.QuerySignerExitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerExitKeyPrefix)
+	exits := make([]*types.SignerExit, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var exit types.SignerExit
+		if err := k.cdc.Unmarshal(value, &exit); err != nil {
+			return err
 		}
-		hit += 1
-		added[signer] = struct{}{}
-		signer, found, err := k.GetSigner(ctx, signer)
-		if err != nil {
-			return nil, err
+		exits = append(exits, &exit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySignerExitsResponse{SignerExits: exits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) StandingRegistration(c context.Context, request *types.QueryStandingRegistrationRequest) (*types.QueryStandingRegistrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	registration, found, err := k.GetStandingRegistration(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrStandingNotFound
+	}
+	return &types.QueryStandingRegistrationResponse{StandingRegistration: &registration}, nil
+}
+
+func (k Keeper) SignerKeyRotation(c context.Context, request *types.QuerySignerKeyRotationRequest) (*types.QuerySignerKeyRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	rotation, found, err := k.GetKeyRotation(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrKeyRotationNotFound
+	}
+	return &types.QuerySignerKeyRotationResponse{KeyRotation: &rotation}, nil
+}
+
+func (k Keeper) EpochBallots(c context.Context, request *types.QueryEpochBallotsRequest) (*types.QueryEpochBallotsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetQuorumCount(ctx, request.EpochNumber); err != nil {
+		return nil, err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochBallotKeyPrefix(request.EpochNumber))
+	ballots := make([]*types.SignerBallot, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var ballot types.SignerBallot
+		if err := k.cdc.Unmarshal(value, &ballot); err != nil {
+			return err
 		}
-		if !found {
-			return nil, types.ErrSignerNotFound
+		ballots = append(ballots, &ballot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryEpochBallotsResponse{Ballots: ballots, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SignerBallot(c context.Context, request *types.QuerySignerBallotRequest) (*types.QuerySignerBallotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetQuorumCount(ctx, request.EpochNumber); err != nil {
+		return nil, err
+	}
+	ballot, found, err := k.GetEpochBallot(ctx, request.EpochNumber, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrBallotNotFound
+	}
+	return &types.QuerySignerBallotResponse{Ballot: &ballot}, nil
+}
+
+func (k Keeper) EpochSignerFlags(c context.Context, request *types.QueryEpochSignerFlagsRequest) (*types.QueryEpochSignerFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetQuorumCount(ctx, request.EpochNumber); err != nil {
+		return nil, err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSignerFlagKeyPrefix(request.EpochNumber))
+	flags := make([]*types.SignerFlag, 0)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var flag types.SignerFlag
+		if err := k.cdc.Unmarshal(value, &flag); err != nil {
+			return err
 		}
-		aggPubkeyG1.Add(aggPubkeyG1, bn254util.DeserializeG1(signer.PubkeyG1))
+		flags = append(flags, &flag)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &types.QueryAggregatePubkeyG1Response{
-		AggregatePubkeyG1: bn254util.SerializeG1(aggPubkeyG1),
-		Total:             uint64(len(quorum.Signers)),
-		Hit:               uint64(hit),
-	}, nil
+	return &types.QueryEpochSignerFlagsResponse{SignerFlags: flags, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MissedSignatures(c context.Context, request *types.QueryMissedSignaturesRequest) (*types.QueryMissedSignaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetQuorumCount(ctx, request.EpochNumber); err != nil {
+		return nil, err
+	}
+	count, err := k.GetMissedSignatures(ctx, request.EpochNumber, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMissedSignaturesResponse{Count: count}, nil
 }
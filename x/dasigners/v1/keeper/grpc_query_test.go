@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestSignersQuery() {
+	accounts := make([]string, 5)
+	for i := range accounts {
+		accounts[i] = hex.EncodeToString(app.RandomAddress())
+		suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, types.Signer{
+			Account:  accounts[i],
+			PubkeyG1: make([]byte, 64),
+			PubkeyG2: make([]byte, 128),
+		}))
+	}
+	// signers 0 to 2 registered for epoch 1, signers 1 and 3 in the quorums of epoch 0
+	for _, account := range accounts[:3] {
+		suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 1, account, []byte{0x01}))
+	}
+	suite.keeper.SetEpochQuorums(suite.ctx, 0, types.Quorums{Quorums: []*types.Quorum{
+		{Signers: []string{accounts[1], accounts[3]}},
+		{Signers: []string{accounts[3], accounts[3]}},
+	}})
+
+	sorted := func(accounts ...string) []string {
+		result := append([]string{}, accounts...)
+		sort.Strings(result)
+		return result
+	}
+
+	testCases := []struct {
+		name     string
+		request  types.QuerySignersRequest
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "all signers",
+			request:  types.QuerySignersRequest{Filter: types.SIGNERS_FILTER_ALL},
+			expected: sorted(accounts...),
+		},
+		{
+			name:     "registered for epoch",
+			request:  types.QuerySignersRequest{Filter: types.SIGNERS_FILTER_REGISTERED, EpochNumber: 1},
+			expected: sorted(accounts[:3]...),
+		},
+		{
+			name:     "registered for epoch without registrations",
+			request:  types.QuerySignersRequest{Filter: types.SIGNERS_FILTER_REGISTERED, EpochNumber: 2},
+			expected: []string{},
+		},
+		{
+			name:     "in quorums",
+			request:  types.QuerySignersRequest{Filter: types.SIGNERS_FILTER_IN_QUORUMS, EpochNumber: 0},
+			expected: sorted(accounts[1], accounts[3]),
+		},
+		{
+			name:    "in quorums of unknown epoch",
+			request: types.QuerySignersRequest{Filter: types.SIGNERS_FILTER_IN_QUORUMS, EpochNumber: 5},
+			err:     true,
+		},
+		{
+			name:    "unknown filter",
+			request: types.QuerySignersRequest{Filter: types.SignersFilter(100)},
+			err:     true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, err := suite.keeper.Signers(sdk.WrapSDKContext(suite.ctx), &tc.request)
+			if tc.err {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			signers := make([]string, len(response.Signers))
+			for i, signer := range response.Signers {
+				signers[i] = signer.Account
+			}
+			suite.Require().Equal(tc.expected, signers)
+		})
+	}
+
+	// page through the registered signers
+	signers := make([]string, 0)
+	var key []byte
+	for {
+		response, err := suite.keeper.Signers(sdk.WrapSDKContext(suite.ctx), &types.QuerySignersRequest{
+			Filter:      types.SIGNERS_FILTER_REGISTERED,
+			EpochNumber: 1,
+			Pagination:  &query.PageRequest{Key: key, Limit: 2},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(response.Signers), 2)
+		for _, signer := range response.Signers {
+			signers = append(signers, signer.Account)
+		}
+		if key = response.Pagination.NextKey; key == nil {
+			break
+		}
+	}
+	suite.Require().Equal(sorted(accounts[:3]...), signers)
+
+	response, err := suite.keeper.Signers(sdk.WrapSDKContext(suite.ctx), &types.QuerySignersRequest{
+		Filter:     types.SIGNERS_FILTER_ALL,
+		Pagination: &query.PageRequest{Offset: 4, Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Signers, 1)
+	suite.Require().Equal(uint64(5), response.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestEpochBallotsQuery() {
+	capped, uncapped := suite.runBallotEpoch()
+
+	response, err := suite.keeper.EpochBallots(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochBallotsRequest{EpochNumber: 1})
+	suite.Require().NoError(err)
+	accounts := make([]string, len(response.Ballots))
+	for i, ballot := range response.Ballots {
+		accounts[i] = ballot.Account
+	}
+	suite.Require().ElementsMatch([]string{capped, uncapped}, accounts)
+
+	response, err = suite.keeper.EpochBallots(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochBallotsRequest{
+		EpochNumber: 1,
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Ballots, 1)
+	suite.Require().Equal(uint64(2), response.Pagination.Total)
+
+	// no ballot was held for the genesis epoch
+	response, err = suite.keeper.EpochBallots(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochBallotsRequest{EpochNumber: 0})
+	suite.Require().NoError(err)
+	suite.Require().Empty(response.Ballots)
+
+	_, err = suite.keeper.EpochBallots(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochBallotsRequest{EpochNumber: 2})
+	suite.Require().ErrorIs(err, types.ErrQuorumNotFound)
+
+	// the ballots are pruned along with the quorums of the epoch
+	suite.setRetainedEpochs(1)
+	suite.beginBlocks(20)
+	_, err = suite.keeper.EpochBallots(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochBallotsRequest{EpochNumber: 1})
+	suite.Require().ErrorIs(err, types.ErrQuorumPruned)
+	suite.keeper.IterateEpochBallots(suite.ctx, 1, func(types.SignerBallot) bool {
+		suite.Fail("ballot of a pruned epoch left in store")
+		return true
+	})
+}
+
+func (suite *KeeperTestSuite) TestSignerBallotQuery() {
+	capped, uncapped := suite.runBallotEpoch()
+
+	testCases := []struct {
+		name    string
+		request types.QuerySignerBallotRequest
+		err     error
+	}{
+		{"capped signer", types.QuerySignerBallotRequest{EpochNumber: 1, Account: capped}, nil},
+		{"uncapped signer", types.QuerySignerBallotRequest{EpochNumber: 1, Account: uncapped}, nil},
+		{"signer not on the ballot", types.QuerySignerBallotRequest{EpochNumber: 1, Account: hex.EncodeToString(app.RandomAddress())}, types.ErrBallotNotFound},
+		{"epoch not reached", types.QuerySignerBallotRequest{EpochNumber: 2, Account: capped}, types.ErrQuorumNotFound},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, err := suite.keeper.SignerBallot(sdk.WrapSDKContext(suite.ctx), &tc.request)
+			if tc.err != nil {
+				suite.Require().ErrorIs(err, tc.err)
+				return
+			}
+			suite.Require().NoError(err)
+			ballot, found, err := suite.keeper.GetEpochBallot(suite.ctx, 1, tc.request.Account)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(&ballot, response.Ballot)
+		})
+	}
+
+	suite.setRetainedEpochs(1)
+	suite.beginBlocks(20)
+	_, err := suite.keeper.SignerBallot(sdk.WrapSDKContext(suite.ctx), &types.QuerySignerBallotRequest{EpochNumber: 1, Account: capped})
+	suite.Require().ErrorIs(err, types.ErrQuorumPruned)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// Hooks wrapper struct for dasigners keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks that keep the signer bonded cache up to date and flag signers
+// whose stake is slashed, jailed or unbonded in the middle of an epoch
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterDelegationModified refreshes the cached tokens of a signer delegation and flags the signer
+// if it can no longer afford a single vote
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !h.k.IsTrackedSigner(ctx, delAddr) {
+		return nil
+	}
+	h.k.updateSignerDelegation(ctx, delAddr, valAddr)
+	return h.k.flagIfUnbonded(ctx, delAddr)
+}
+
+// BeforeDelegationRemoved drops the cached tokens of a signer delegation and flags the signer
+// if it can no longer afford a single vote
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !h.k.IsTrackedSigner(ctx, delAddr) {
+		return nil
+	}
+	h.k.setSignerDelegationTokens(ctx, delAddr, valAddr, sdk.ZeroInt())
+	return h.k.flagIfUnbonded(ctx, delAddr)
+}
+
+// BeforeValidatorSlashed flags the signers delegating to the validator and applies the slash to
+// their cached tokens
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	if err := h.k.flagValidatorSigners(ctx, valAddr, types.FlagReasonSlashed); err != nil {
+		return err
+	}
+	h.k.slashSignerDelegations(ctx, valAddr, fraction)
+	return nil
+}
+
+// AfterValidatorBeginUnbonding flags the signers delegating to the validator if it was jailed
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	validator, found := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || !validator.IsJailed() {
+		return nil
+	}
+	return h.k.flagValidatorSigners(ctx, valAddr, types.FlagReasonJailed)
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestAfterDelegationModified_UpdatesBonded() {
+	signer := suite.createSigner(votes(10))
+	suite.Require().Equal(votes(10), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+
+	// delegations to other validators are tracked as well
+	other := suite.createSigner(votes(10))
+	suite.delegate(signer, sdk.ValAddress(other), votes(5))
+	suite.Require().Equal(votes(15), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+
+	suite.undelegate(signer, sdk.ValAddress(signer), votes(3))
+	suite.Require().Equal(votes(12), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+
+	_, flagged := suite.getFlag(signer)
+	suite.Require().False(flagged)
+}
+
+func (suite *KeeperTestSuite) TestAfterDelegationModified_FlagsInsufficientBonded() {
+	signer := suite.createSigner(votes(10))
+
+	// keep less than a single vote
+	suite.undelegate(signer, sdk.ValAddress(signer), votes(10).SubRaw(1))
+	suite.Require().Equal(sdk.OneInt(), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+
+	flag, flagged := suite.getFlag(signer)
+	suite.Require().True(flagged)
+	suite.Require().Equal(types.FlagReasonUnbonded, flag.Reason)
+	suite.Require().Equal(suite.ctx.BlockHeight(), flag.Height)
+}
+
+func (suite *KeeperTestSuite) TestBeforeDelegationRemoved_FlagsUnbondedSigner() {
+	signer := suite.createSigner(votes(10))
+
+	suite.undelegate(signer, sdk.ValAddress(signer), votes(10))
+	suite.Require().True(suite.keeper.GetDelegatorBonded(suite.ctx, signer).IsZero())
+	suite.Require().True(suite.keeper.IsTrackedSigner(suite.ctx, signer))
+
+	flag, flagged := suite.getFlag(signer)
+	suite.Require().True(flagged)
+	suite.Require().Equal(types.FlagReasonUnbonded, flag.Reason)
+
+	suite.Require().NoError(assertFlagEvent(suite.ctx.EventManager().Events(), signer, types.FlagReasonUnbonded))
+}
+
+func (suite *KeeperTestSuite) TestBeforeValidatorSlashed_FlagsDelegatingSigners() {
+	signer := suite.createSigner(votes(10))
+	delegator := suite.createSigner(votes(10))
+	suite.delegate(delegator, sdk.ValAddress(signer), votes(10))
+	suite.bondValidators()
+
+	validator, found := suite.stakingKeeper.GetValidator(suite.ctx, sdk.ValAddress(signer))
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.stakingKeeper.Slash(suite.ctx, consAddr, suite.ctx.BlockHeight(), validator.ConsensusPower(suite.stakingKeeper.PowerReduction(suite.ctx)), sdk.NewDecWithPrec(5, 1))
+
+	for _, addr := range []sdk.AccAddress{signer, delegator} {
+		flag, flagged := suite.getFlag(addr)
+		suite.Require().True(flagged)
+		suite.Require().Equal(types.FlagReasonSlashed, flag.Reason)
+	}
+	suite.Require().Equal(votes(5), suite.keeper.GetDelegatorBonded(suite.ctx, signer))
+	// the delegation to its own validator is untouched
+	suite.Require().Equal(votes(15), suite.keeper.GetDelegatorBonded(suite.ctx, delegator))
+}
+
+func (suite *KeeperTestSuite) TestAfterValidatorBeginUnbonding_FlagsJailedValidator() {
+	jailed := suite.createSigner(votes(10))
+	healthy := suite.createSigner(votes(10))
+	suite.bondValidators()
+
+	validator, found := suite.stakingKeeper.GetValidator(suite.ctx, sdk.ValAddress(jailed))
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.stakingKeeper.Jail(suite.ctx, consAddr)
+
+	// the validator leaves the active set at the end of the block
+	suite.bondValidators()
+
+	flag, flagged := suite.getFlag(jailed)
+	suite.Require().True(flagged)
+	suite.Require().Equal(types.FlagReasonJailed, flag.Reason)
+
+	_, flagged = suite.getFlag(healthy)
+	suite.Require().False(flagged)
+}
+
+func (suite *KeeperTestSuite) TestAfterValidatorBeginUnbonding_IgnoresUnjailedValidator() {
+	signer := suite.createSigner(votes(10))
+	suite.bondValidators()
+
+	suite.Require().NoError(suite.keeper.Hooks().AfterValidatorBeginUnbonding(suite.ctx, sdk.ConsAddress{}, sdk.ValAddress(signer)))
+
+	_, flagged := suite.getFlag(signer)
+	suite.Require().False(flagged)
+}
+
+func (suite *KeeperTestSuite) TestFlagSigner_IgnoresUnregisteredAndFlagged() {
+	signer := suite.createSigner(votes(10))
+	account := hex.EncodeToString(signer)
+	suite.Require().NoError(suite.keeper.DeleteRegistration(suite.ctx, 0, account))
+
+	suite.Require().NoError(suite.keeper.FlagSigner(suite.ctx, signer, types.FlagReasonSlashed))
+	_, flagged := suite.getFlag(signer)
+	suite.Require().False(flagged)
+
+	// the first reason is kept
+	suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 0, account, signer.Bytes()))
+	suite.Require().NoError(suite.keeper.FlagSigner(suite.ctx, signer, types.FlagReasonJailed))
+	suite.Require().NoError(suite.keeper.FlagSigner(suite.ctx, signer, types.FlagReasonSlashed))
+	flag, flagged := suite.getFlag(signer)
+	suite.Require().True(flagged)
+	suite.Require().Equal(types.FlagReasonJailed, flag.Reason)
+}
+
+func (suite *KeeperTestSuite) TestFlagSigner_RegisteredForNextEpoch() {
+	signer := suite.createSigner(votes(10))
+	account := hex.EncodeToString(signer)
+	suite.Require().NoError(suite.keeper.DeleteRegistration(suite.ctx, 0, account))
+	suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 1, account, signer.Bytes()))
+
+	// a signer that only registered for the next epoch is flagged and left out of its quorums
+	suite.Require().NoError(suite.keeper.FlagSigner(suite.ctx, signer, types.FlagReasonSlashed))
+	flag, flagged := suite.getFlag(signer)
+	suite.Require().True(flagged)
+	suite.Require().Equal(types.FlagReasonSlashed, flag.Reason)
+
+	suite.beginBlocks(10)
+	suite.Require().Equal(uint64(1), suite.epochNumber())
+	suite.Require().False(suite.inQuorums(1, account))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlock_ExcludesFlaggedSigners() {
+	flagged := suite.createSigner(votes(10))
+	healthy := suite.createSigner(votes(10))
+	suite.Require().NoError(suite.keeper.FlagSigner(suite.ctx, flagged, types.FlagReasonSlashed))
+
+	for _, addr := range []sdk.AccAddress{flagged, healthy} {
+		suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 1, hex.EncodeToString(addr), addr.Bytes()))
+	}
+
+	params := suite.keeper.GetParams(suite.ctx)
+	ctx := suite.ctx.WithBlockHeight(int64(params.EpochBlocks))
+	suite.keeper.BeginBlock(ctx, abci.RequestBeginBlock{})
+
+	quorum, err := suite.keeper.GetEpochQuorum(ctx, 1, 0)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(quorum.Signers)
+	for _, account := range quorum.Signers {
+		suite.Require().Equal(hex.EncodeToString(healthy), account)
+	}
+	_, found, err := suite.keeper.GetEpochBallot(ctx, 1, hex.EncodeToString(flagged))
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
+
+func assertFlagEvent(events sdk.Events, signer sdk.AccAddress, reason string) error {
+	return app.EventsContains(events, sdk.NewEvent(
+		types.EventTypeFlagSigner,
+		sdk.NewAttribute(types.AttributeKeySigner, hex.EncodeToString(signer)),
+		sdk.NewAttribute(types.AttributeKeyEpoch, "0"),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/0glabs/0g-chain/chaincfg"
@@ -22,6 +21,8 @@ type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
 	stakingKeeper types.StakingKeeper
+	// authority is the address allowed to update the module parameters, the governance module account
+	authority string
 }
 
 // NewKeeper creates a new das Keeper instance
@@ -29,14 +30,21 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	stakingKeeper types.StakingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -74,6 +82,9 @@ func (k Keeper) GetQuorumCount(ctx sdk.Context, epoch uint64) (uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QuorumCountKeyPrefix)
 	bz := store.Get(types.GetQuorumCountKey(epoch))
 	if bz == nil {
+		if epoch < k.GetEarliestEpoch(ctx) {
+			return 0, types.ErrQuorumPruned
+		}
 		return 0, types.ErrQuorumNotFound
 	}
 	return sdk.BigEndianToUint64(bz), nil
@@ -212,25 +223,14 @@ func (k Keeper) SetRegistration(ctx sdk.Context, epoch uint64, account string, s
 	return nil
 }
 
-func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int {
-	bonded := sdk.ZeroDec()
-
-	cnt := 0
-	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
-		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-		if err != nil {
-			panic(err) // shouldn't happen
-		}
-		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
-		if found {
-			shares := delegation.Shares
-			tokens := validator.TokensFromSharesTruncated(shares)
-			bonded = bonded.Add(tokens)
-		}
-		cnt += 1
-		return cnt > 10
-	})
-	return bonded.RoundInt()
+func (k Keeper) DeleteRegistration(ctx sdk.Context, epoch uint64, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(epoch))
+	key, err := types.GetRegistrationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
 }
 
 func (k Keeper) CheckDelegations(ctx sdk.Context, account string) error {
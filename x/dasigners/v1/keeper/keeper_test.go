@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app           app.TestApp
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	stakingKeeper stakingkeeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.NewTestApp()
+	suite.app.InitializeFromGenesisStates()
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.app.NewContext(false, tmproto.Header{Height: 1, Time: genesisTime})
+	suite.keeper = suite.app.GetDASignersKeeper()
+	suite.stakingKeeper = suite.app.GetStakingKeeper()
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.TokensPerVote = 1
+	params.EpochBlocks = 10
+	params.EncodedSlices = 4
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+// votes converts a number of votes into the bonded tokens earning them
+func votes(n int64) sdkmath.Int {
+	return keeper.BondedConversionRate.MulRaw(n)
+}
+
+// createSigner registers a signer backed by a validator it self delegates to, and registers the
+// signer for the current epoch.
+func (suite *KeeperTestSuite) createSigner(selfDelegation sdkmath.Int) sdk.AccAddress {
+	addr := app.RandomAddress()
+	suite.Require().NoError(suite.app.FundAccount(suite.ctx, addr, sdk.NewCoins(
+		sdk.NewCoin(suite.stakingKeeper.BondDenom(suite.ctx), selfDelegation.MulRaw(10)),
+	)))
+	suite.Require().NoError(suite.app.CreateNewUnbondedValidator(suite.ctx, sdk.ValAddress(addr), selfDelegation))
+
+	account := hex.EncodeToString(addr)
+	suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: make([]byte, 64),
+		PubkeyG2: make([]byte, 128),
+	}))
+	suite.keeper.TrackSignerDelegations(suite.ctx, addr)
+
+	epochNumber, err := suite.keeper.GetEpochNumber(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, epochNumber, account, addr.Bytes()))
+	return addr
+}
+
+// bondValidators runs the staking end blocker so that newly created validators are bonded
+func (suite *KeeperTestSuite) bondValidators() {
+	staking.EndBlocker(suite.ctx, suite.stakingKeeper)
+}
+
+func (suite *KeeperTestSuite) getFlag(addr sdk.AccAddress) (types.SignerFlag, bool) {
+	epochNumber, err := suite.keeper.GetEpochNumber(suite.ctx)
+	suite.Require().NoError(err)
+	flag, found, err := suite.keeper.GetSignerFlag(suite.ctx, epochNumber, hex.EncodeToString(addr))
+	suite.Require().NoError(err)
+	return flag, found
+}
+
+func (suite *KeeperTestSuite) delegate(delegator sdk.AccAddress, validator sdk.ValAddress, amount sdkmath.Int) {
+	msgServer := stakingkeeper.NewMsgServerImpl(suite.stakingKeeper)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), stakingtypes.NewMsgDelegate(
+		delegator,
+		validator,
+		sdk.NewCoin(suite.stakingKeeper.BondDenom(suite.ctx), amount),
+	))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) undelegate(delegator sdk.AccAddress, validator sdk.ValAddress, amount sdkmath.Int) {
+	msgServer := stakingkeeper.NewMsgServerImpl(suite.stakingKeeper)
+	_, err := msgServer.Undelegate(sdk.WrapSDKContext(suite.ctx), stakingtypes.NewMsgUndelegate(
+		delegator,
+		validator,
+		sdk.NewCoin(suite.stakingKeeper.BondDenom(suite.ctx), amount),
+	))
+	suite.Require().NoError(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
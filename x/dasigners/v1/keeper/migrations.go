@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// V2 caches the bonded tokens of every active signer.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.TrackActiveSigners(ctx)
+}
+
+// TrackActiveSigners builds the bonded cache of every signer that has not been tombstoned.
+func (k Keeper) TrackActiveSigners(ctx sdk.Context) error {
+	accounts := make([]string, 0)
+	k.IterateSigners(ctx, func(_ int64, signer types.Signer) (stop bool) {
+		accounts = append(accounts, signer.Account)
+		return false
+	})
+	for _, account := range accounts {
+		exit, found, err := k.GetSignerExit(ctx, account)
+		if err != nil {
+			return err
+		}
+		if found && exit.Tombstoned {
+			continue
+		}
+		accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+		if err != nil {
+			return err
+		}
+		k.TrackSignerDelegations(ctx, accAddr)
+	}
+	return nil
+}
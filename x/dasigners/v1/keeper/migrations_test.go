@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	active := suite.createSigner(votes(10))
+	other := suite.createSigner(votes(10))
+	suite.delegate(active, sdk.ValAddress(other), votes(5))
+	tombstoned := suite.createSigner(votes(10))
+	suite.Require().NoError(suite.keeper.SetSignerExit(suite.ctx, types.SignerExit{
+		Account:    hex.EncodeToString(tombstoned),
+		ExitEpoch:  0,
+		Tombstoned: true,
+	}))
+
+	// a v1 store holds the signers but no bonded cache
+	for _, signer := range []sdk.AccAddress{active, other, tombstoned} {
+		suite.keeper.UntrackSignerDelegations(suite.ctx, signer)
+		suite.Require().False(suite.keeper.IsTrackedSigner(suite.ctx, signer))
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	suite.Require().True(suite.keeper.IsTrackedSigner(suite.ctx, active))
+	suite.Require().Equal(votes(15), suite.keeper.GetDelegatorBonded(suite.ctx, active))
+	suite.Require().True(suite.keeper.IsTrackedSigner(suite.ctx, other))
+	suite.Require().Equal(votes(10), suite.keeper.GetDelegatorBonded(suite.ctx, other))
+	suite.Require().False(suite.keeper.IsTrackedSigner(suite.ctx, tombstoned))
+
+	// the cache built by the migration follows later delegations
+	suite.undelegate(active, sdk.ValAddress(other), votes(5))
+	suite.Require().Equal(votes(10), suite.keeper.GetDelegatorBonded(suite.ctx, active))
+}
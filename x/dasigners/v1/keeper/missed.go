@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// VerifyEvidenceGas is charged for the pairing check of every reported signed bitmap.
+const VerifyEvidenceGas uint64 = 100_000
+
+// A signed bitmap is only accepted as evidence if the distinct signers that signed it make up at
+// least EvidenceSignersNumerator / EvidenceSignersDenominator of the signers of the quorum, so a
+// minority of the quorum cannot report attestations it made up on its own, however many rows it holds.
+const (
+	EvidenceSignersNumerator   = 2
+	EvidenceSignersDenominator = 3
+)
+
+func (k Keeper) GetMissedSignatures(ctx sdk.Context, epoch uint64, account string) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMissedSignaturesKeyPrefix(epoch))
+	key, err := types.GetMissedSignaturesKey(account)
+	if err != nil {
+		return 0, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return 0, nil
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+func (k Keeper) SetMissedSignatures(ctx sdk.Context, missed types.MissedSignatures) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMissedSignaturesKeyPrefix(missed.Epoch))
+	key, err := types.GetMissedSignaturesKey(missed.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(missed.Count))
+	return nil
+}
+
+// iterate through the missed signature counters of an epoch and perform the provided function
+func (k Keeper) IterateMissedSignatures(ctx sdk.Context, epoch uint64, fn func(missed types.MissedSignatures) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMissedSignaturesKeyPrefix(epoch))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		missed := types.MissedSignatures{
+			Account: hex.EncodeToString(iterator.Key()),
+			Epoch:   epoch,
+			Count:   sdk.BigEndianToUint64(iterator.Value()),
+		}
+		if fn(missed) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IsEvidenceReported(ctx sdk.Context, evidence types.SignedBitmap) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetReportedEvidenceKeyPrefix(evidence.EpochNumber))
+	return store.Has(types.GetReportedEvidenceKey(evidence.QuorumId, evidence.MessageHash))
+}
+
+func (k Keeper) SetReportedEvidence(ctx sdk.Context, evidence types.SignedBitmap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetReportedEvidenceKeyPrefix(evidence.EpochNumber))
+	store.Set(types.GetReportedEvidenceKey(evidence.QuorumId, evidence.MessageHash), k.cdc.MustMarshal(&evidence))
+}
+
+// iterate through the reported evidence of an epoch and perform the provided function
+func (k Keeper) IterateReportedEvidence(ctx sdk.Context, epoch uint64, fn func(evidence types.SignedBitmap) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetReportedEvidenceKeyPrefix(epoch))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence types.SignedBitmap
+		k.cdc.MustUnmarshal(iterator.Value(), &evidence)
+		if fn(evidence) {
+			break
+		}
+	}
+}
+
+// HandleMissedSignatures checks a signed bitmap and counts a missed signature for every quorum
+// member that took no part in it. A signer holding several rows of the quorum is counted once per
+// reported attestation, and signers that missed MissedSignaturesThreshold reported attestations in an
+// epoch are jailed, i.e. flagged in the current epoch so that they are left out of the next ballot.
+// The jailed accounts are returned.
+func (k Keeper) HandleMissedSignatures(ctx sdk.Context, evidence types.SignedBitmap) ([]string, error) {
+	if k.IsEvidenceReported(ctx, evidence) {
+		return nil, types.ErrEvidenceReported
+	}
+	ctx.GasMeter().ConsumeGas(VerifyEvidenceGas, "verify signed bitmap")
+	valid, _, _, err := k.VerifyQuorumSignature(ctx, evidence.EpochNumber, evidence.QuorumId, evidence.QuorumBitmap, evidence.MessageHash, evidence.Signature)
+	if err != nil {
+		return nil, err
+	}
+	quorum, err := k.GetEpochQuorum(ctx, evidence.EpochNumber, evidence.QuorumId)
+	if err != nil {
+		return nil, err
+	}
+	// a signer holding several rows signed if any of its rows is set
+	signers := make(map[string]struct{})
+	signed := make(map[string]struct{})
+	for i, signer := range quorum.Signers {
+		signers[signer] = struct{}{}
+		if evidence.QuorumBitmap[i/8]&(1<<(i%8)) != 0 {
+			signed[signer] = struct{}{}
+		}
+	}
+	if len(signed)*EvidenceSignersDenominator < len(signers)*EvidenceSignersNumerator {
+		return nil, types.ErrInsufficientSigners
+	}
+	if !valid {
+		return nil, types.ErrInvalidSignature
+	}
+	k.SetReportedEvidence(ctx, evidence)
+
+	threshold := k.GetParams(ctx).MissedSignaturesThreshold
+	jailed := make([]string, 0)
+	for _, signer := range quorum.Signers {
+		if _, ok := signed[signer]; ok {
+			continue
+		}
+		// count each missing signer once
+		signed[signer] = struct{}{}
+		count, err := k.GetMissedSignatures(ctx, evidence.EpochNumber, signer)
+		if err != nil {
+			return nil, err
+		}
+		count += 1
+		if err := k.SetMissedSignatures(ctx, types.MissedSignatures{
+			Account: signer,
+			Epoch:   evidence.EpochNumber,
+			Count:   count,
+		}); err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMissedSignatures,
+				sdk.NewAttribute(types.AttributeKeySigner, signer),
+				sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(evidence.EpochNumber, 10)),
+				sdk.NewAttribute(types.AttributeKeyMissedCount, strconv.FormatUint(count, 10)),
+			),
+		)
+		// jail the signer once, when its count for the epoch reaches the threshold
+		if threshold == 0 || count != threshold {
+			continue
+		}
+		if err := k.jailSigner(ctx, signer, count); err != nil {
+			return nil, err
+		}
+		jailed = append(jailed, signer)
+	}
+	return jailed, nil
+}
+
+// jailSigner flags a signer in the current epoch for missing signatures.
+func (k Keeper) jailSigner(ctx sdk.Context, account string, missed uint64) error {
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := k.flagSigner(ctx, epochNumber, account, types.FlagReasonMissed); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, account),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyMissedCount, strconv.FormatUint(missed, 10)),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) deleteMissedSignatures(ctx sdk.Context, epoch uint64) {
+	for _, keyPrefix := range [][]byte{types.GetMissedSignaturesKeyPrefix(epoch), types.GetReportedEvidenceKeyPrefix(epoch)} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+		iterator := store.Iterator(nil, nil)
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) signedBitmap(secrets []*big.Int, epoch uint64, bitmap []byte, message string) *types.SignedBitmap {
+	var messageHash [32]byte
+	copy(messageHash[:], crypto.Keccak256([]byte(message)))
+	return &types.SignedBitmap{
+		EpochNumber:  epoch,
+		QuorumId:     0,
+		QuorumBitmap: bitmap,
+		MessageHash:  messageHash[:],
+		Signature:    aggregateSignature(secrets, bitmap, messageHash),
+	}
+}
+
+func (suite *KeeperTestSuite) reportMissedSignatures(evidence ...*types.SignedBitmap) (*types.MsgReportMissedSignaturesResponse, error) {
+	return suite.keeper.ReportMissedSignatures(sdk.WrapSDKContext(suite.ctx), &types.MsgReportMissedSignatures{
+		Reporter: hex.EncodeToString(app.RandomAddress()),
+		Evidence: evidence,
+	})
+}
+
+func (suite *KeeperTestSuite) missedSignatures(epoch uint64, account string) uint64 {
+	count, err := suite.keeper.GetMissedSignatures(suite.ctx, epoch, account)
+	suite.Require().NoError(err)
+	return count
+}
+
+// repeatQuorum stores the quorum of epoch 0 for the given epochs as well
+func (suite *KeeperTestSuite) repeatQuorum(epochs ...uint64) {
+	quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+	suite.Require().NoError(err)
+	for _, epoch := range epochs {
+		suite.keeper.SetEpochQuorums(suite.ctx, epoch, types.Quorums{Quorums: []*types.Quorum{&quorum}})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReportMissedSignatures_CountsMissingSigners() {
+	// signer 0 holds rows 0, 2 and 4, signer 1 holds rows 1, 3 and 5
+	secrets := suite.setupBLSQuorum([]int{0, 1, 0, 1, 0, 1, 2, 3, 4, 5}, 6)
+	quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+	suite.Require().NoError(err)
+	signer0, signer1, signer4, signer5 := quorum.Signers[0], quorum.Signers[1], quorum.Signers[8], quorum.Signers[9]
+
+	// signer 4 and 5 did not sign
+	_, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0xff, 0x00}, "blob"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.missedSignatures(0, signer0))
+	suite.Require().Equal(uint64(0), suite.missedSignatures(0, signer1))
+	suite.Require().Equal(uint64(1), suite.missedSignatures(0, signer4))
+	suite.Require().Equal(uint64(1), suite.missedSignatures(0, signer5))
+
+	// several attestations in a single message, signer 0 and 1 signed through some of their rows only
+	suite.repeatQuorum(1)
+	_, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0xc7, 0x02}, "other blob"), suite.signedBitmap(secrets, 1, []byte{0xc7, 0x02}, "blob"))
+	suite.Require().NoError(err)
+	for epoch := uint64(0); epoch < 2; epoch += 1 {
+		suite.Require().Equal(uint64(0), suite.missedSignatures(epoch, signer0))
+		suite.Require().Equal(uint64(0), suite.missedSignatures(epoch, signer1))
+	}
+	// every attestation missed in an epoch adds up
+	suite.Require().Equal(uint64(2), suite.missedSignatures(0, signer4))
+	suite.Require().Equal(uint64(1), suite.missedSignatures(1, signer4))
+	suite.Require().Equal(uint64(1), suite.missedSignatures(0, signer5))
+	suite.Require().Equal(uint64(0), suite.missedSignatures(1, signer5))
+
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMissedSignatures,
+		sdk.NewAttribute(types.AttributeKeySigner, signer4),
+		sdk.NewAttribute(types.AttributeKeyEpoch, "0"),
+		sdk.NewAttribute(types.AttributeKeyMissedCount, "2"),
+	)))
+}
+
+func (suite *KeeperTestSuite) TestReportMissedSignatures_RejectsInvalidEvidence() {
+	secrets := suite.setupBLSQuorum([]int{0, 1, 2}, 3)
+	quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+	suite.Require().NoError(err)
+
+	evidence := suite.signedBitmap(secrets, 0, []byte{0x03}, "blob")
+	_, err = suite.reportMissedSignatures(evidence)
+	suite.Require().NoError(err)
+	_, err = suite.reportMissedSignatures(evidence)
+	suite.Require().ErrorIs(err, types.ErrEvidenceReported)
+
+	// claiming that signer 1 did not sign, while its signature is part of the aggregate
+	forged := suite.signedBitmap(secrets, 0, []byte{0x07}, "other blob")
+	forged.QuorumBitmap = []byte{0x05}
+	_, err = suite.reportMissedSignatures(forged)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	_, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 1, []byte{0x03}, "third blob"))
+	suite.Require().ErrorIs(err, types.ErrQuorumNotFound)
+
+	suite.Require().Equal(uint64(0), suite.missedSignatures(0, quorum.Signers[1]))
+	suite.Require().Equal(uint64(1), suite.missedSignatures(0, quorum.Signers[2]))
+}
+
+func (suite *KeeperTestSuite) TestReportMissedSignatures_RequiresDistinctSigners() {
+	secrets := suite.setupBLSQuorum([]int{0, 1, 2, 3}, 4)
+	quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+	suite.Require().NoError(err)
+	victim := quorum.Signers[3]
+
+	// a minority of the quorum cannot report the attestations it signs on its own
+	for _, bitmap := range []byte{0x01, 0x03} {
+		for i := 0; i < 4; i += 1 {
+			_, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{bitmap}, fmt.Sprintf("blob %d", i)))
+			suite.Require().ErrorIs(err, types.ErrInsufficientSigners)
+		}
+	}
+	suite.Require().Equal(uint64(0), suite.missedSignatures(0, victim))
+
+	// nor can a single signer holding most of the rows
+	suite.SetupTest()
+	secrets = suite.setupBLSQuorum([]int{0, 0, 0, 0, 0, 0, 1, 2}, 3)
+	quorum, err = suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+	suite.Require().NoError(err)
+	_, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0x3f}, "blob"))
+	suite.Require().ErrorIs(err, types.ErrInsufficientSigners)
+	suite.Require().Equal(uint64(0), suite.missedSignatures(0, quorum.Signers[6]))
+	suite.Require().Equal(uint64(0), suite.missedSignatures(0, quorum.Signers[7]))
+
+	// two out of three distinct signers are enough
+	_, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0x7f}, "blob"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.missedSignatures(0, quorum.Signers[7]))
+}
+
+func (suite *KeeperTestSuite) TestReportMissedSignatures_JailsAtThreshold() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MissedSignaturesThreshold = 2
+	suite.keeper.SetParams(suite.ctx, params)
+
+	secrets := suite.setupBLSQuorum([]int{0, 1, 2}, 3)
+	suite.repeatQuorum(1)
+	quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+	suite.Require().NoError(err)
+	offender, other := quorum.Signers[2], quorum.Signers[1]
+
+	// the other signer misses one signature in each of epochs 0 and 1, which are counted apart
+	res, err := suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0x05}, "blob"), suite.signedBitmap(secrets, 1, []byte{0x05}, "blob"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Jailed)
+
+	res, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0x03}, "other blob"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Jailed)
+	res, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0x03}, "third blob"))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{offender}, res.Jailed)
+
+	flag, found, err := suite.keeper.GetSignerFlag(suite.ctx, 0, offender)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(types.FlagReasonMissed, flag.Reason)
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeJailSigner,
+		sdk.NewAttribute(types.AttributeKeySigner, offender),
+		sdk.NewAttribute(types.AttributeKeyEpoch, "0"),
+		sdk.NewAttribute(types.AttributeKeyMissedCount, "2"),
+	)))
+	_, found, err = suite.keeper.GetSignerFlag(suite.ctx, 0, other)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// the signer is jailed only once for an epoch
+	res, err = suite.reportMissedSignatures(suite.signedBitmap(secrets, 0, []byte{0x03}, "fourth blob"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Jailed)
+	suite.Require().Equal(uint64(3), suite.missedSignatures(0, offender))
+}
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	etherminttypes "github.com/evmos/ethermint/types"
 )
@@ -14,18 +18,29 @@ var _ types.MsgServer = &Keeper{}
 
 func (k Keeper) RegisterSigner(goCtx context.Context, msg *types.MsgRegisterSigner) (*types.MsgRegisterSignerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	// validate sender
-	err := k.CheckDelegations(ctx, msg.Signer.Account)
-	if err != nil {
-		return nil, err
-	}
 	_, found, err := k.GetSigner(ctx, msg.Signer.Account)
 	if err != nil {
 		return nil, err
 	}
 	if found {
+		exit, exiting, err := k.GetSignerExit(ctx, msg.Signer.Account)
+		if err != nil {
+			return nil, err
+		}
+		if exiting && exit.Tombstoned {
+			return nil, types.ErrSignerTombstoned
+		}
 		return nil, types.ErrSignerExists
 	}
+	// validate sender
+	accAddr, err := sdk.AccAddressFromHexUnsafe(msg.Signer.Account)
+	if err != nil {
+		return nil, err
+	}
+	k.TrackSignerDelegations(ctx, accAddr)
+	if err := k.CheckDelegations(ctx, msg.Signer.Account); err != nil {
+		return nil, err
+	}
 	// validate signature
 	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
 	if err != nil {
@@ -51,6 +66,13 @@ func (k Keeper) UpdateSocket(goCtx context.Context, msg *types.MsgUpdateSocket)
 	if !found {
 		return nil, types.ErrSignerNotFound
 	}
+	exit, exiting, err := k.GetSignerExit(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting && exit.Tombstoned {
+		return nil, types.ErrSignerTombstoned
+	}
 	signer.Socket = msg.Socket
 	if err := k.SetSigner(ctx, signer); err != nil {
 		return nil, err
@@ -72,6 +94,13 @@ func (k Keeper) RegisterNextEpoch(goCtx context.Context, msg *types.MsgRegisterN
 	if !found {
 		return nil, types.ErrSignerNotFound
 	}
+	exiting, err := k.IsSignerExiting(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
 	// validate signature
 	epochNumber, err := k.GetEpochNumber(ctx)
 	if err != nil {
@@ -89,3 +118,243 @@ func (k Keeper) RegisterNextEpoch(goCtx context.Context, msg *types.MsgRegisterN
 	k.SetRegistration(ctx, epochNumber+1, msg.Account, msg.Signature)
 	return &types.MsgRegisterNextEpochResponse{}, nil
 }
+
+func (k Keeper) DeregisterSigner(goCtx context.Context, msg *types.MsgDeregisterSigner) (*types.MsgDeregisterSignerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	exiting, err := k.IsSignerExiting(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the signer keeps its seats in the current epoch, so the exit completes one epoch later at the earliest
+	delay := k.GetParams(ctx).ExitDelayEpochs
+	if delay == 0 {
+		delay = 1
+	}
+	exit := types.SignerExit{
+		Account:      msg.Account,
+		RequestEpoch: epochNumber,
+		ExitEpoch:    epochNumber + delay,
+	}
+	if err := k.SetSignerExit(ctx, exit); err != nil {
+		return nil, err
+	}
+	// drop the registration for next epoch, it will never be counted
+	if err := k.DeleteRegistration(ctx, epochNumber+1, msg.Account); err != nil {
+		return nil, err
+	}
+	if err := k.DeleteKeyRotation(ctx, msg.Account); err != nil {
+		return nil, err
+	}
+	if err := k.DeleteStandingRegistration(ctx, msg.Account); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyExitEpoch, strconv.FormatUint(exit.ExitEpoch, 10)),
+		),
+	)
+	return &types.MsgDeregisterSignerResponse{ExitEpoch: exit.ExitEpoch}, nil
+}
+
+func (k Keeper) RotateSignerKey(goCtx context.Context, msg *types.MsgRotateSignerKey) (*types.MsgRotateSignerKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	exiting, err := k.IsSignerExiting(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
+	// validate proof of possession of the new key
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	newSigner := types.Signer{
+		Account:  msg.Account,
+		PubkeyG1: msg.PubkeyG1,
+		PubkeyG2: msg.PubkeyG2,
+	}
+	hash := types.PubkeyRotationHash(common.HexToAddress(msg.Account), chainID)
+	if !newSigner.ValidateSignature(hash, bn254util.DeserializeG1(msg.Signature)) {
+		return nil, types.ErrInvalidSignature
+	}
+	// the current epoch keeps verifying against the old key
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rotation := types.SignerKeyRotation{
+		Account:        msg.Account,
+		PubkeyG1:       msg.PubkeyG1,
+		PubkeyG2:       msg.PubkeyG2,
+		EffectiveEpoch: epochNumber + 1,
+	}
+	if err := k.SetKeyRotation(ctx, rotation); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateSignerKey,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyPublicKeyG1, hex.EncodeToString(msg.PubkeyG1)),
+			sdk.NewAttribute(types.AttributeKeyPublicKeyG2, hex.EncodeToString(msg.PubkeyG2)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveEpoch, strconv.FormatUint(rotation.EffectiveEpoch, 10)),
+		),
+	)
+	return &types.MsgRotateSignerKeyResponse{EffectiveEpoch: rotation.EffectiveEpoch}, nil
+}
+
+func (k Keeper) ReportMissedSignatures(goCtx context.Context, msg *types.MsgReportMissedSignatures) (*types.MsgReportMissedSignaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	jailed := make([]string, 0)
+	for _, evidence := range msg.Evidence {
+		accounts, err := k.HandleMissedSignatures(ctx, *evidence)
+		if err != nil {
+			return nil, err
+		}
+		jailed = append(jailed, accounts...)
+	}
+	return &types.MsgReportMissedSignaturesResponse{Jailed: jailed}, nil
+}
+
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the parameters, the epoch length in particular, only change on an epoch boundary
+	k.SetPendingParams(ctx, msg.Params)
+	effectiveEpoch := epochNumber + 1
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyEffectiveEpoch, strconv.FormatUint(effectiveEpoch, 10)),
+		),
+	)
+	return &types.MsgUpdateParamsResponse{EffectiveEpoch: effectiveEpoch}, nil
+}
+
+func (k Keeper) RegisterStanding(goCtx context.Context, msg *types.MsgRegisterStanding) (*types.MsgRegisterStandingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.CheckDelegations(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	signer, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	exiting, err := k.IsSignerExiting(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
+	_, found, err = k.GetStandingRegistration(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrStandingExists
+	}
+	// validate signature
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	registration := types.StandingRegistration{
+		Account:    msg.Account,
+		Signature:  msg.Signature,
+		StartEpoch: epochNumber + 1,
+	}
+	hash := types.StandingRegistrationHash(common.HexToAddress(msg.Account), registration.StartEpoch, chainID)
+	if !signer.ValidateSignature(hash, bn254util.DeserializeG1(msg.Signature)) {
+		return nil, types.ErrInvalidSignature
+	}
+	if err := k.SetStandingRegistration(ctx, registration); err != nil {
+		return nil, err
+	}
+	// register for next epoch right away, the following ones are renewed in BeginBlock
+	if err := k.registerStanding(ctx, registration.StartEpoch, registration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterStanding,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyStartEpoch, strconv.FormatUint(registration.StartEpoch, 10)),
+		),
+	)
+	return &types.MsgRegisterStandingResponse{StartEpoch: registration.StartEpoch}, nil
+}
+
+func (k Keeper) CancelStanding(goCtx context.Context, msg *types.MsgCancelStanding) (*types.MsgCancelStandingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	registration, found, err := k.GetStandingRegistration(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrStandingNotFound
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the signer keeps its seats in the current epoch and leaves the ballot of the next one
+	if err := k.cancelStanding(ctx, epochNumber+1, registration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelStanding,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+		),
+	)
+	return &types.MsgCancelStandingResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// GetPendingParams returns the parameters waiting for the start of the next epoch.
+func (k Keeper) GetPendingParams(ctx sdk.Context) (types.Params, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingParamsKey)
+	if bz == nil {
+		return types.Params{}, false
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, true
+}
+
+func (k Keeper) SetPendingParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.PendingParamsKey, bz)
+}
+
+// GetEpochAnchor returns the height at which the epoch length last changed and the epoch starting
+// at that height, both are 0 if the epoch length never changed.
+func (k Keeper) GetEpochAnchor(ctx sdk.Context) (uint64, uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochAnchorKey)
+	if bz == nil {
+		return 0, 0
+	}
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:])
+}
+
+func (k Keeper) SetEpochAnchor(ctx sdk.Context, height uint64, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochAnchorKey, append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(epoch)...))
+}
+
+// ExpectedEpoch returns the epoch of the current block height, counting epochs of the current
+// length from the epoch anchor.
+func (k Keeper) ExpectedEpoch(ctx sdk.Context, params types.Params) uint64 {
+	anchorHeight, anchorEpoch := k.GetEpochAnchor(ctx)
+	height := uint64(ctx.BlockHeight())
+	if height < anchorHeight {
+		return anchorEpoch
+	}
+	return anchorEpoch + (height-anchorHeight)/params.EpochBlocks
+}
+
+// applyPendingParams replaces the parameters by the pending ones at the start of a new epoch. When
+// the epoch length changes, the new epoch becomes the anchor of the following ones so that the
+// epoch number keeps increasing by one.
+func (k Keeper) applyPendingParams(ctx sdk.Context, epoch uint64, params types.Params) types.Params {
+	pending, found := k.GetPendingParams(ctx)
+	if !found {
+		return params
+	}
+	if pending.EpochBlocks != params.EpochBlocks {
+		k.SetEpochAnchor(ctx, uint64(ctx.BlockHeight()), epoch)
+	}
+	k.SetParams(ctx, pending)
+	ctx.KVStore(k.storeKey).Delete(types.PendingParamsKey)
+	return pending
+}
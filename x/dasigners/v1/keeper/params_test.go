@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) updateParams(authority string, params types.Params) (*types.MsgUpdateParamsResponse, error) {
+	return suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+}
+
+// beginBlocks runs the begin blocker from the next block height up to the given one
+func (suite *KeeperTestSuite) beginBlocks(height int64) {
+	for h := suite.ctx.BlockHeight() + 1; h <= height; h += 1 {
+		suite.ctx = suite.ctx.WithBlockHeight(h)
+		suite.keeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+	}
+}
+
+func (suite *KeeperTestSuite) epochNumber() uint64 {
+	epochNumber, err := suite.keeper.GetEpochNumber(suite.ctx)
+	suite.Require().NoError(err)
+	return epochNumber
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams_Authority() {
+	suite.Require().Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), suite.keeper.GetAuthority())
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.TokensPerVote = 100
+	_, err := suite.updateParams(app.RandomAddress().String(), params)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, found := suite.keeper.GetPendingParams(suite.ctx)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams_Validation() {
+	testCases := []struct {
+		name   string
+		update func(params *types.Params)
+	}{
+		{"zero tokens per vote", func(params *types.Params) { params.TokensPerVote = 0 }},
+		{"zero max votes per signer", func(params *types.Params) { params.MaxVotesPerSigner = 0 }},
+		{"zero max quorums", func(params *types.Params) { params.MaxQuorums = 0 }},
+		{"zero epoch blocks", func(params *types.Params) { params.EpochBlocks = 0 }},
+		{"zero encoded slices", func(params *types.Params) { params.EncodedSlices = 0 }},
+		{"unknown quorum strategy", func(params *types.Params) { params.QuorumStrategy = types.QuorumStrategy(100) }},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.keeper.GetParams(suite.ctx)
+			tc.update(&params)
+			msg := types.MsgUpdateParams{Authority: suite.keeper.GetAuthority(), Params: params}
+			suite.Require().Error(msg.ValidateBasic())
+			_, err := suite.updateParams(suite.keeper.GetAuthority(), params)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams_AppliedAtEpochBoundary() {
+	params := suite.keeper.GetParams(suite.ctx)
+	updated := params
+	updated.TokensPerVote = 100
+	updated.MissedSignaturesThreshold = 3
+
+	res, err := suite.updateParams(suite.keeper.GetAuthority(), updated)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.EffectiveEpoch)
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUpdateParams,
+		sdk.NewAttribute(types.AttributeKeyAuthority, suite.keeper.GetAuthority()),
+		sdk.NewAttribute(types.AttributeKeyEffectiveEpoch, "1"),
+	)))
+
+	query, err := suite.keeper.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, query.Params)
+	suite.Require().Equal(&updated, query.PendingParams)
+
+	// still the current epoch
+	suite.beginBlocks(int64(params.EpochBlocks) - 1)
+	suite.Require().Equal(uint64(0), suite.epochNumber())
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+
+	suite.beginBlocks(int64(params.EpochBlocks))
+	suite.Require().Equal(uint64(1), suite.epochNumber())
+	suite.Require().Equal(updated, suite.keeper.GetParams(suite.ctx))
+	_, found := suite.keeper.GetPendingParams(suite.ctx)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams_EpochBlocksKeepsEpochsContinuous() {
+	// move into epoch 2 so that the new epoch length does not divide the current height
+	suite.beginBlocks(25)
+	suite.Require().Equal(uint64(2), suite.epochNumber())
+
+	for _, epochBlocks := range []uint64{7, 30, 3} {
+		params := suite.keeper.GetParams(suite.ctx)
+		updated := params
+		updated.EpochBlocks = epochBlocks
+		_, err := suite.updateParams(suite.keeper.GetAuthority(), updated)
+		suite.Require().NoError(err)
+
+		// the current epoch keeps its length
+		epochNumber := suite.epochNumber()
+		anchorHeight, anchorEpoch := suite.keeper.GetEpochAnchor(suite.ctx)
+		boundary := int64(anchorHeight + (epochNumber-anchorEpoch+1)*params.EpochBlocks)
+		suite.Require().NotPanics(func() { suite.beginBlocks(boundary - 1) })
+		suite.Require().Equal(epochNumber, suite.epochNumber())
+		suite.Require().NotPanics(func() { suite.beginBlocks(boundary) })
+		suite.Require().Equal(epochNumber+1, suite.epochNumber())
+
+		// the following epochs have the new length
+		suite.Require().NotPanics(func() { suite.beginBlocks(boundary + int64(epochBlocks)*2 - 1) })
+		suite.Require().Equal(epochNumber+2, suite.epochNumber())
+		suite.Require().NotPanics(func() { suite.beginBlocks(boundary + int64(epochBlocks)*2) })
+		suite.Require().Equal(epochNumber+3, suite.epochNumber())
+	}
+}
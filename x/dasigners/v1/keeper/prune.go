@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// MaxPrunedEpochsPerBlock bounds the pruning work done in a single block, a larger backlog
+// (e.g. after the retention window is shortened) is drained over the following blocks.
+const MaxPrunedEpochsPerBlock = 8
+
+// GetEarliestEpoch returns the earliest epoch whose quorums are still in store.
+func (k Keeper) GetEarliestEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EarliestEpochKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetEarliestEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EarliestEpochKey, sdk.Uint64ToBigEndian(epoch))
+}
+
+// PruneEpochs deletes the quorums, registrations, ballots, flags and missed signatures of epochs that fell out of the retention window.
+func (k Keeper) PruneEpochs(ctx sdk.Context, epochNumber uint64) {
+	retained := k.GetParams(ctx).RetainedEpochs
+	if retained == 0 || epochNumber < retained {
+		return
+	}
+	cutoff := epochNumber - retained + 1
+	earliest := k.GetEarliestEpoch(ctx)
+	if earliest >= cutoff {
+		return
+	}
+	end := cutoff
+	if end-earliest > MaxPrunedEpochsPerBlock {
+		end = earliest + MaxPrunedEpochsPerBlock
+	}
+	for epoch := earliest; epoch < end; epoch += 1 {
+		k.deleteEpoch(ctx, epoch)
+	}
+	k.SetEarliestEpoch(ctx, end)
+	k.pruneSignerKeyHistory(ctx, end)
+}
+
+func (k Keeper) deleteEpoch(ctx sdk.Context, epoch uint64) {
+	quorumCount, err := k.GetQuorumCount(ctx, epoch)
+	if err == nil {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochQuorumsKeyPrefix)
+		for quorumId := uint64(0); quorumId < quorumCount; quorumId += 1 {
+			store.Delete(types.GetEpochQuorumKey(epoch, quorumId))
+		}
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.QuorumCountKeyPrefix).Delete(types.GetQuorumCountKey(epoch))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(epoch))
+	iterator := store.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.deleteEpochBallots(ctx, epoch)
+	k.deleteSignerFlags(ctx, epoch)
+	k.deleteMissedSignatures(ctx, epoch)
+}
+
+// pruneSignerKeyHistory deletes retired keys that are only needed by pruned epochs. The keys are
+// found through the queue by retired epoch, so only the keys being deleted are visited.
+func (k Keeper) pruneSignerKeyHistory(ctx sdk.Context, earliest uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.RetiredKeyQueueKeyPrefix, sdk.PrefixEndBytes(types.GetRetiredKeyQueueKeyPrefix(earliest)))
+	queueKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, queueKey := range queueKeys {
+		// key layout: prefix | retired epoch (8 bytes) | account
+		retiredEpoch := sdk.BigEndianToUint64(queueKey[len(types.RetiredKeyQueueKeyPrefix) : len(types.RetiredKeyQueueKeyPrefix)+8])
+		key, err := types.GetSignerKeyHistoryKey(hex.EncodeToString(queueKey[len(types.RetiredKeyQueueKeyPrefix)+8:]), retiredEpoch)
+		if err == nil {
+			store.Delete(key)
+		}
+		store.Delete(queueKey)
+	}
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (suite *KeeperTestSuite) setRetainedEpochs(retained uint64) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RetainedEpochs = retained
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestPruneEpochs() {
+	suite.setRetainedEpochs(4)
+	for epoch := uint64(0); epoch < 20; epoch += 1 {
+		suite.keeper.SetEpochQuorums(suite.ctx, epoch, types.Quorums{Quorums: []*types.Quorum{
+			{Signers: []string{hex.EncodeToString(app.RandomAddress())}},
+		}})
+	}
+
+	// at most MaxPrunedEpochsPerBlock epochs are pruned at once, the rest is left for the next calls
+	testCases := []struct {
+		name     string
+		earliest uint64
+	}{
+		{"first batch", 8},
+		{"rest of the backlog", 16},
+		{"nothing left to prune", 16},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.keeper.PruneEpochs(suite.ctx, 19)
+			suite.Require().Equal(tc.earliest, suite.keeper.GetEarliestEpoch(suite.ctx))
+
+			_, err := suite.keeper.GetQuorumCount(suite.ctx, tc.earliest-1)
+			suite.Require().ErrorIs(err, types.ErrQuorumPruned)
+			_, err = suite.keeper.GetEpochQuorum(suite.ctx, 0, 0)
+			suite.Require().ErrorIs(err, types.ErrQuorumPruned)
+			quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, tc.earliest, 0)
+			suite.Require().NoError(err)
+			suite.Require().Len(quorum.Signers, 1)
+		})
+	}
+
+	// epochs not reached yet are not found rather than pruned
+	_, err := suite.keeper.GetQuorumCount(suite.ctx, 20)
+	suite.Require().ErrorIs(err, types.ErrQuorumNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPruneEpochs_Disabled() {
+	suite.setRetainedEpochs(0)
+	suite.keeper.SetEpochQuorums(suite.ctx, 0, types.Quorums{Quorums: []*types.Quorum{}})
+	suite.keeper.PruneEpochs(suite.ctx, 100)
+	suite.Require().Equal(uint64(0), suite.keeper.GetEarliestEpoch(suite.ctx))
+	_, err := suite.keeper.GetQuorumCount(suite.ctx, 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPruneEpochs_SignerKeyHistory() {
+	testCases := []struct {
+		name     string
+		epoch    uint64   // epoch number the pruning runs at, with 4 epochs retained
+		history  []uint64 // retired epochs of the records left
+		earliest byte     // key of the signer in the earliest epoch retained
+	}{
+		{"nothing pruned", 3, []uint64{2, 5, 9}, 1},
+		{"before the first retired epoch", 4, []uint64{2, 5, 9}, 1},
+		{"at the first retired epoch", 5, []uint64{5, 9}, 2},
+		{"after the second retired epoch", 11, []uint64{9}, 3},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setRetainedEpochs(4)
+			account := hex.EncodeToString(app.RandomAddress())
+			suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, testSigner(account, 1)))
+			for _, rotation := range [][2]uint64{{2, 2}, {3, 5}, {4, 9}} {
+				suite.queueKeyRotation(account, byte(rotation[0]), rotation[1])
+				suite.keeper.ApplyKeyRotations(suite.ctx, rotation[1])
+			}
+
+			suite.keeper.PruneEpochs(suite.ctx, tc.epoch)
+
+			history := make([]uint64, 0)
+			suite.keeper.IterateSignerKeyHistory(suite.ctx, func(record types.SignerKeyRecord) bool {
+				history = append(history, record.RetiredEpoch)
+				return false
+			})
+			suite.Require().ElementsMatch(tc.history, history)
+			// the keys of the retained epochs are still served
+			suite.Require().Equal(tc.earliest, suite.signerKeyAt(account, suite.keeper.GetEarliestEpoch(suite.ctx)))
+			suite.Require().Equal(byte(4), suite.signerKeyAt(account, 9))
+		})
+	}
+}
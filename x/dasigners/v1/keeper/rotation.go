@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetKeyRotation(ctx sdk.Context, account string) (types.SignerKeyRotation, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRotationKeyPrefix)
+	key, err := types.GetKeyRotationKey(account)
+	if err != nil {
+		return types.SignerKeyRotation{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerKeyRotation{}, false, nil
+	}
+	var rotation types.SignerKeyRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return rotation, true, nil
+}
+
+// SetKeyRotation saves a pending key rotation, replacing any rotation already waiting for the signer.
+func (k Keeper) SetKeyRotation(ctx sdk.Context, rotation types.SignerKeyRotation) error {
+	if err := k.DeleteKeyRotation(ctx, rotation.Account); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRotationKeyPrefix)
+	key, err := types.GetKeyRotationKey(rotation.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&rotation))
+
+	queueKey, err := types.GetKeyRotationQueueKey(rotation.EffectiveEpoch, rotation.Account)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(queueKey, []byte{})
+	return nil
+}
+
+func (k Keeper) DeleteKeyRotation(ctx sdk.Context, account string) error {
+	rotation, found, err := k.GetKeyRotation(ctx, account)
+	if err != nil || !found {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRotationKeyPrefix)
+	key, err := types.GetKeyRotationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+
+	queueKey, err := types.GetKeyRotationQueueKey(rotation.EffectiveEpoch, account)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(queueKey)
+	return nil
+}
+
+// iterate through the pending key rotations and perform the provided function
+func (k Keeper) IterateKeyRotations(ctx sdk.Context, fn func(rotation types.SignerKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRotationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.SignerKeyRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+		if fn(rotation) {
+			break
+		}
+	}
+}
+
+// ApplyKeyRotations switches every signer with a rotation effective at or before the given epoch
+// to its new key, keeping the retired key so that earlier quorums can still be verified.
+func (k Keeper) ApplyKeyRotations(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.KeyRotationQueueKeyPrefix, sdk.PrefixEndBytes(types.GetKeyRotationQueueKeyPrefix(epoch)))
+	accounts := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// key layout: prefix | epoch (8 bytes) | account
+		accounts = append(accounts, hex.EncodeToString(iterator.Key()[len(types.KeyRotationQueueKeyPrefix)+8:]))
+	}
+	iterator.Close()
+
+	for _, account := range accounts {
+		if err := k.applyKeyRotation(ctx, account, epoch); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to rotate signer key", "signer", account, "err", err)
+		}
+	}
+}
+
+func (k Keeper) applyKeyRotation(ctx sdk.Context, account string, epoch uint64) error {
+	rotation, found, err := k.GetKeyRotation(ctx, account)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrKeyRotationNotFound
+	}
+	if err := k.DeleteKeyRotation(ctx, account); err != nil {
+		return err
+	}
+	signer, found, err := k.GetSigner(ctx, account)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrSignerNotFound
+	}
+	if err := k.SetSignerKeyRecord(ctx, types.SignerKeyRecord{
+		Account:      account,
+		PubkeyG1:     signer.PubkeyG1,
+		PubkeyG2:     signer.PubkeyG2,
+		RetiredEpoch: epoch,
+	}); err != nil {
+		return err
+	}
+	signer.PubkeyG1 = rotation.PubkeyG1
+	signer.PubkeyG2 = rotation.PubkeyG2
+	return k.SetSigner(ctx, signer)
+}
+
+// SetSignerKeyRecord saves a retired key, and queues it by retired epoch so that it is pruned along
+// with the last epoch it was active in.
+func (k Keeper) SetSignerKeyRecord(ctx sdk.Context, record types.SignerKeyRecord) error {
+	key, err := types.GetSignerKeyHistoryKey(record.Account, record.RetiredEpoch)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+
+	queueKey, err := types.GetRetiredKeyQueueKey(record.RetiredEpoch, record.Account)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(queueKey, []byte{})
+	return nil
+}
+
+// iterate through the retired signer keys and perform the provided function
+func (k Keeper) IterateSignerKeyHistory(ctx sdk.Context, fn func(record types.SignerKeyRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SignerKeyHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SignerKeyRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// GetSignerAtEpoch returns the signer with the keys that were active in the given epoch.
+func (k Keeper) GetSignerAtEpoch(ctx sdk.Context, account string, epoch uint64) (types.Signer, bool, error) {
+	signer, found, err := k.GetSigner(ctx, account)
+	if err != nil || !found {
+		return signer, found, err
+	}
+	// the first key retired after the epoch is the one that was active in it
+	prefix, err := types.GetSignerKeyHistoryPrefix(account)
+	if err != nil {
+		return types.Signer{}, false, err
+	}
+	start, err := types.GetSignerKeyHistoryKey(account, epoch+1)
+	if err != nil {
+		return types.Signer{}, false, err
+	}
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+	if iterator.Valid() {
+		var record types.SignerKeyRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		signer.PubkeyG1 = record.PubkeyG1
+		signer.PubkeyG2 = record.PubkeyG2
+	}
+	return signer, true, nil
+}
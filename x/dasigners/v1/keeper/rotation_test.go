@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// testSigner returns a signer whose keys are filled with the given byte
+func testSigner(account string, key byte) types.Signer {
+	return types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bytes.Repeat([]byte{key}, 64),
+		PubkeyG2: bytes.Repeat([]byte{key}, 128),
+	}
+}
+
+func (suite *KeeperTestSuite) queueKeyRotation(account string, key byte, effectiveEpoch uint64) {
+	suite.Require().NoError(suite.keeper.SetKeyRotation(suite.ctx, types.SignerKeyRotation{
+		Account:        account,
+		PubkeyG1:       bytes.Repeat([]byte{key}, 64),
+		PubkeyG2:       bytes.Repeat([]byte{key}, 128),
+		EffectiveEpoch: effectiveEpoch,
+	}))
+}
+
+// signerKeyAt returns the byte filling the keys of the signer in the given epoch
+func (suite *KeeperTestSuite) signerKeyAt(account string, epoch uint64) byte {
+	signer, found, err := suite.keeper.GetSignerAtEpoch(suite.ctx, account, epoch)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(testSigner(account, signer.PubkeyG1[0]), signer)
+	return signer.PubkeyG1[0]
+}
+
+func (suite *KeeperTestSuite) TestGetSignerAtEpoch() {
+	account := hex.EncodeToString(app.RandomAddress())
+	suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, testSigner(account, 1)))
+	// key 1 is retired from epoch 2, key 2 from epoch 5
+	suite.queueKeyRotation(account, 2, 2)
+	suite.keeper.ApplyKeyRotations(suite.ctx, 2)
+	suite.queueKeyRotation(account, 3, 5)
+	suite.keeper.ApplyKeyRotations(suite.ctx, 5)
+
+	testCases := []struct {
+		name  string
+		epoch uint64
+		key   byte
+	}{
+		{"before the first rotation", 0, 1},
+		{"last epoch of the first key", 1, 1},
+		{"first epoch of the second key", 2, 2},
+		{"last epoch of the second key", 4, 2},
+		{"first epoch of the current key", 5, 3},
+		{"after the last rotation", 100, 3},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.key, suite.signerKeyAt(account, tc.epoch))
+		})
+	}
+
+	_, found, err := suite.keeper.GetSignerAtEpoch(suite.ctx, hex.EncodeToString(app.RandomAddress()), 0)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestApplyKeyRotations() {
+	testCases := []struct {
+		name      string
+		rotations [][2]uint64 // key and effective epoch of each rotation queued, in order
+		epochs    []uint64    // epochs at which the rotations are applied
+		key       byte        // current key after the rotations
+		history   []uint64    // retired epochs of the recorded keys
+		pending   bool
+	}{
+		{"effective at the epoch", [][2]uint64{{2, 2}}, []uint64{2}, 2, []uint64{2}, false},
+		{"effective at a later epoch", [][2]uint64{{2, 3}}, []uint64{2}, 1, nil, true},
+		{"applied in the first epoch reached", [][2]uint64{{2, 1}}, []uint64{3}, 2, []uint64{3}, false},
+		{"replaced before the epoch", [][2]uint64{{2, 2}, {3, 3}}, []uint64{2}, 1, nil, true},
+		{"replaced rotation applied at its own epoch", [][2]uint64{{2, 2}, {3, 3}}, []uint64{2, 3}, 3, []uint64{3}, false},
+		{"applied once", [][2]uint64{{2, 2}}, []uint64{2, 3, 4}, 2, []uint64{2}, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			account := hex.EncodeToString(app.RandomAddress())
+			suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, testSigner(account, 1)))
+			for _, rotation := range tc.rotations {
+				suite.queueKeyRotation(account, byte(rotation[0]), rotation[1])
+			}
+			for _, epoch := range tc.epochs {
+				suite.keeper.ApplyKeyRotations(suite.ctx, epoch)
+			}
+
+			signer, found, err := suite.keeper.GetSigner(suite.ctx, account)
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(testSigner(account, tc.key), signer)
+
+			history := make([]uint64, 0)
+			suite.keeper.IterateSignerKeyHistory(suite.ctx, func(record types.SignerKeyRecord) bool {
+				suite.Require().Equal(account, record.Account)
+				history = append(history, record.RetiredEpoch)
+				return false
+			})
+			suite.Require().ElementsMatch(tc.history, history)
+
+			_, pending, err := suite.keeper.GetKeyRotation(suite.ctx, account)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.pending, pending)
+		})
+	}
+}
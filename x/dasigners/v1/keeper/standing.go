@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetStandingRegistration(ctx sdk.Context, account string) (types.StandingRegistration, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StandingRegistrationKeyPrefix)
+	key, err := types.GetStandingRegistrationKey(account)
+	if err != nil {
+		return types.StandingRegistration{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.StandingRegistration{}, false, nil
+	}
+	var registration types.StandingRegistration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true, nil
+}
+
+func (k Keeper) SetStandingRegistration(ctx sdk.Context, registration types.StandingRegistration) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StandingRegistrationKeyPrefix)
+	key, err := types.GetStandingRegistrationKey(registration.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&registration))
+	return nil
+}
+
+func (k Keeper) DeleteStandingRegistration(ctx sdk.Context, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StandingRegistrationKeyPrefix)
+	key, err := types.GetStandingRegistrationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
+
+// iterate through the standing registrations and perform the provided function
+func (k Keeper) IterateStandingRegistrations(ctx sdk.Context, fn func(registration types.StandingRegistration) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.StandingRegistrationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.StandingRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		if fn(registration) {
+			break
+		}
+	}
+}
+
+// registerStanding registers a standing signer for the given epoch with the seed derived from its
+// standing signature, unless the signer already registered for it explicitly.
+func (k Keeper) registerStanding(ctx sdk.Context, epoch uint64, registration types.StandingRegistration) error {
+	if epoch < registration.StartEpoch {
+		return nil
+	}
+	_, found, err := k.GetRegistration(ctx, epoch, registration.Account)
+	if err != nil || found {
+		return err
+	}
+	return k.SetRegistration(ctx, epoch, registration.Account, types.StandingBallotSeed(registration.Signature, epoch))
+}
+
+// RenewStandingRegistrations registers every standing signer for the given epoch, so that they
+// take part in its ballot without sending a registration.
+func (k Keeper) RenewStandingRegistrations(ctx sdk.Context, epoch uint64) {
+	k.IterateStandingRegistrations(ctx, func(registration types.StandingRegistration) (stop bool) {
+		if exiting, err := k.IsSignerExiting(ctx, registration.Account); err != nil || exiting {
+			return false
+		}
+		if err := k.registerStanding(ctx, epoch, registration); err != nil {
+			k.Logger(ctx).Error("[BeginBlock] failed to renew standing registration", "signer", registration.Account, "err", err)
+		}
+		return false
+	})
+}
+
+// cancelStanding removes the standing registration of a signer together with its registration
+// for the given epoch, if that registration was derived from the standing one.
+func (k Keeper) cancelStanding(ctx sdk.Context, epoch uint64, registration types.StandingRegistration) error {
+	if err := k.DeleteStandingRegistration(ctx, registration.Account); err != nil {
+		return err
+	}
+	seed, found, err := k.GetRegistration(ctx, epoch, registration.Account)
+	if err != nil || !found {
+		return err
+	}
+	if !bytes.Equal(seed, types.StandingBallotSeed(registration.Signature, epoch)) {
+		return nil
+	}
+	return k.DeleteRegistration(ctx, epoch, registration.Account)
+}
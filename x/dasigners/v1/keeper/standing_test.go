@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	etherminttypes "github.com/evmos/ethermint/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+const standingChainID = "kavatest_1-1"
+
+// createBLSSigner creates a signer backed by a validator with a deterministic BLS key, and returns
+// its account together with the secret key.
+func (suite *KeeperTestSuite) createBLSSigner(secret uint64) (string, *big.Int) {
+	addr := suite.createSigner(votes(10))
+	account := hex.EncodeToString(addr)
+	var sk fr.Element
+	sk.SetUint64(secret)
+	suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bn254util.SerializeG1(bn254util.MulByGeneratorG1(&sk)),
+		PubkeyG2: bn254util.SerializeG2(bn254util.MulByGeneratorG2(&sk)),
+	}))
+	return account, sk.BigInt(new(big.Int))
+}
+
+func (suite *KeeperTestSuite) standingSignature(account string, sk *big.Int, startEpoch uint64) []byte {
+	chainID, err := etherminttypes.ParseChainID(standingChainID)
+	suite.Require().NoError(err)
+	hash := types.StandingRegistrationHash(common.HexToAddress(account), startEpoch, chainID)
+	return bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk))
+}
+
+func (suite *KeeperTestSuite) registerStanding(account string, signature []byte) (*types.MsgRegisterStandingResponse, error) {
+	return suite.keeper.RegisterStanding(sdk.WrapSDKContext(suite.ctx), &types.MsgRegisterStanding{
+		Account:   account,
+		Signature: signature,
+	})
+}
+
+func (suite *KeeperTestSuite) cancelStanding(account string) error {
+	_, err := suite.keeper.CancelStanding(sdk.WrapSDKContext(suite.ctx), &types.MsgCancelStanding{Account: account})
+	return err
+}
+
+func (suite *KeeperTestSuite) isRegistered(epoch uint64, account string) bool {
+	_, found, err := suite.keeper.GetRegistration(suite.ctx, epoch, account)
+	suite.Require().NoError(err)
+	return found
+}
+
+func (suite *KeeperTestSuite) inQuorums(epoch uint64, account string) bool {
+	quorum, err := suite.keeper.GetEpochQuorum(suite.ctx, epoch, 0)
+	suite.Require().NoError(err)
+	for _, signer := range quorum.Signers {
+		if signer == account {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *KeeperTestSuite) TestRegisterStanding_RenewedEveryEpoch() {
+	suite.ctx = suite.ctx.WithChainID(standingChainID)
+	account, sk := suite.createBLSSigner(1000)
+	epochBlocks := int64(suite.keeper.GetParams(suite.ctx).EpochBlocks)
+
+	// the signature must commit to the start epoch
+	_, err := suite.registerStanding(account, suite.standingSignature(account, sk, 2))
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	signature := suite.standingSignature(account, sk, 1)
+	res, err := suite.registerStanding(account, signature)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.StartEpoch)
+	suite.Require().NoError(app.EventsContains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRegisterStanding,
+		sdk.NewAttribute(types.AttributeKeySigner, account),
+		sdk.NewAttribute(types.AttributeKeyStartEpoch, "1"),
+	)))
+	_, err = suite.registerStanding(account, signature)
+	suite.Require().ErrorIs(err, types.ErrStandingExists)
+
+	seed, found, err := suite.keeper.GetRegistration(suite.ctx, 1, account)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(types.StandingBallotSeed(signature, 1), seed)
+
+	// the signer stays in the quorums without registering again
+	for epoch := uint64(1); epoch <= 3; epoch += 1 {
+		suite.beginBlocks(int64(epoch) * epochBlocks)
+		suite.Require().Equal(epoch, suite.epochNumber())
+		suite.Require().True(suite.inQuorums(epoch, account))
+		suite.Require().True(suite.isRegistered(epoch+1, account))
+	}
+
+	// opting out drops the registration for the next epoch
+	suite.Require().NoError(suite.cancelStanding(account))
+	suite.Require().False(suite.isRegistered(4, account))
+	suite.Require().ErrorIs(suite.cancelStanding(account), types.ErrStandingNotFound)
+
+	suite.beginBlocks(4 * epochBlocks)
+	suite.Require().False(suite.inQuorums(4, account))
+	suite.Require().False(suite.isRegistered(5, account))
+}
+
+func (suite *KeeperTestSuite) TestRegisterStanding_KeepsExplicitRegistration() {
+	suite.ctx = suite.ctx.WithChainID(standingChainID)
+	account, sk := suite.createBLSSigner(1000)
+	explicit := []byte{0x01, 0x02}
+	suite.Require().NoError(suite.keeper.SetRegistration(suite.ctx, 1, account, explicit))
+
+	_, err := suite.registerStanding(account, suite.standingSignature(account, sk, 1))
+	suite.Require().NoError(err)
+	seed, _, err := suite.keeper.GetRegistration(suite.ctx, 1, account)
+	suite.Require().NoError(err)
+	suite.Require().Equal(explicit, seed)
+
+	// cancelling keeps the explicit registration
+	suite.Require().NoError(suite.cancelStanding(account))
+	suite.Require().True(suite.isRegistered(1, account))
+}
+
+func (suite *KeeperTestSuite) TestRegisterStanding_EndsOnDeregistration() {
+	suite.ctx = suite.ctx.WithChainID(standingChainID)
+	account, sk := suite.createBLSSigner(1000)
+	_, err := suite.registerStanding(account, suite.standingSignature(account, sk, 1))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.DeregisterSigner(sdk.WrapSDKContext(suite.ctx), &types.MsgDeregisterSigner{Account: account})
+	suite.Require().NoError(err)
+	_, found, err := suite.keeper.GetStandingRegistration(suite.ctx, account)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+	suite.Require().False(suite.isRegistered(1, account))
+
+	_, err = suite.registerStanding(account, suite.standingSignature(account, sk, 1))
+	suite.Require().ErrorIs(err, types.ErrSignerExiting)
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// Candidate is a signer taking part in the quorum assignment of an epoch.
+type Candidate struct {
+	Account string
+	// Seed is the registration signature of the signer, its ballots are the keccak chain of it
+	Seed   []byte
+	Votes  uint64
+	Bonded math.Int
+}
+
+// QuorumStrategy assigns the ballots of the epoch candidates to quorum rows. Implementations must be
+// deterministic as they run in BeginBlock.
+type QuorumStrategy interface {
+	AssignQuorums(candidates []Candidate, params types.Params) types.Quorums
+}
+
+var quorumStrategies = map[types.QuorumStrategy]QuorumStrategy{
+	types.QUORUM_STRATEGY_SORTED_BALLOTS: SortedBallotsStrategy{},
+	types.QUORUM_STRATEGY_STAKE_BALANCED: StakeBalancedStrategy{},
+}
+
+// GetQuorumStrategy returns the implementation of a quorum strategy.
+func GetQuorumStrategy(strategy types.QuorumStrategy) (QuorumStrategy, error) {
+	impl, ok := quorumStrategies[strategy]
+	if !ok {
+		return nil, fmt.Errorf("unknown quorum strategy %s", strategy)
+	}
+	return impl, nil
+}
+
+// expandBallots returns one ballot per vote of every candidate, sorted by content.
+func expandBallots(candidates []Candidate) []Ballot {
+	ballots := []Ballot{}
+	for _, candidate := range candidates {
+		content := candidate.Seed
+		for j := 0; j < int(candidate.Votes); j += 1 {
+			ballots = append(ballots, Ballot{
+				account: candidate.Account,
+				content: content,
+			})
+			content = crypto.Keccak256(content)
+		}
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		return bytes.Compare(ballots[i].content, ballots[j].content) < 0
+	})
+	return ballots
+}
+
+func emptyQuorums() types.Quorums {
+	return types.Quorums{
+		Quorums: []*types.Quorum{{
+			Signers: make([]string, 0),
+		}},
+	}
+}
+
+// SortedBallotsStrategy sorts the keccak chained ballots and chunks them by encoded slices. The last
+// quorum overlaps the previous one when the ballots are not a multiple of the encoded slices, and
+// ballots wrap around when there are fewer of them than encoded slices.
+type SortedBallotsStrategy struct{}
+
+func (SortedBallotsStrategy) AssignQuorums(candidates []Candidate, params types.Params) types.Quorums {
+	ballots := expandBallots(candidates)
+
+	quorums := types.Quorums{
+		Quorums: make([]*types.Quorum, 0),
+	}
+	if len(ballots) >= int(params.EncodedSlices) {
+		for i := 0; i+int(params.EncodedSlices) <= len(ballots); i += int(params.EncodedSlices) {
+			if int(params.MaxQuorums) < len(quorums.Quorums) {
+				break
+			}
+			quorum := types.Quorum{
+				Signers: make([]string, params.EncodedSlices),
+			}
+			for j := 0; j < int(params.EncodedSlices); j += 1 {
+				quorum.Signers[j] = ballots[i+j].account
+			}
+			quorums.Quorums = append(quorums.Quorums, &quorum)
+		}
+		if len(ballots)%int(params.EncodedSlices) != 0 && int(params.MaxQuorums) > len(quorums.Quorums) {
+			quorum := types.Quorum{
+				Signers: make([]string, 0),
+			}
+			for j := len(ballots) - int(params.EncodedSlices); j < len(ballots); j += 1 {
+				quorum.Signers = append(quorum.Signers, ballots[j].account)
+			}
+			quorums.Quorums = append(quorums.Quorums, &quorum)
+		}
+	} else if len(ballots) > 0 {
+		quorum := types.Quorum{
+			Signers: make([]string, params.EncodedSlices),
+		}
+		n := len(ballots)
+		for i := 0; i < int(params.EncodedSlices); i += 1 {
+			quorum.Signers[i] = ballots[i%n].account
+		}
+		quorums.Quorums = append(quorums.Quorums, &quorum)
+	} else {
+		quorums = emptyQuorums()
+	}
+	return quorums
+}
+
+// StakeBalancedStrategy fills the same number of rows as the sorted ballots strategy, but assigns
+// every row to the quorum backed by the least stake so far, heaviest rows first. The stake of a row
+// is the bonded tokens of its signer divided by its votes, so that the rows of signers whose votes
+// are capped weigh more.
+type StakeBalancedStrategy struct{}
+
+func (StakeBalancedStrategy) AssignQuorums(candidates []Candidate, params types.Params) types.Quorums {
+	ballots := expandBallots(candidates)
+	slices := int(params.EncodedSlices)
+	if len(ballots) == 0 || slices == 0 {
+		return emptyQuorums()
+	}
+	n := (len(ballots) + slices - 1) / slices
+	if n > int(params.MaxQuorums) {
+		n = int(params.MaxQuorums)
+	}
+	if n == 0 {
+		n = 1
+	}
+	// the leading ballots are kept, wrapping around if there are too few of them
+	rows := make([]Ballot, n*slices)
+	for i := range rows {
+		rows[i] = ballots[i%len(ballots)]
+	}
+
+	weights := make(map[string]sdk.Dec)
+	for _, candidate := range candidates {
+		if candidate.Votes > 0 {
+			weights[candidate.Account] = sdk.NewDecFromInt(candidate.Bonded).QuoInt64(int64(candidate.Votes))
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		wi, wj := weights[rows[i].account], weights[rows[j].account]
+		if !wi.Equal(wj) {
+			return wi.GT(wj)
+		}
+		return bytes.Compare(rows[i].content, rows[j].content) < 0
+	})
+
+	quorums := types.Quorums{
+		Quorums: make([]*types.Quorum, n),
+	}
+	stakes := make([]sdk.Dec, n)
+	for i := range quorums.Quorums {
+		quorums.Quorums[i] = &types.Quorum{
+			Signers: make([]string, 0, slices),
+		}
+		stakes[i] = sdk.ZeroDec()
+	}
+	for _, row := range rows {
+		target := -1
+		for i, quorum := range quorums.Quorums {
+			if len(quorum.Signers) == slices {
+				continue
+			}
+			if target < 0 || stakes[i].LT(stakes[target]) {
+				target = i
+			}
+		}
+		quorums.Quorums[target].Signers = append(quorums.Quorums[target].Signers, row.account)
+		stakes[target] = stakes[target].Add(weights[row.account])
+	}
+	return quorums
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func newCandidate(i int, votes uint64, bonded int64) keeper.Candidate {
+	return keeper.Candidate{
+		Account: fmt.Sprintf("%040x", i),
+		Seed:    crypto.Keccak256([]byte{byte(i), byte(i >> 8)}),
+		Votes:   votes,
+		Bonded:  sdkmath.NewInt(bonded),
+	}
+}
+
+func strategyParams(encodedSlices, maxQuorums uint64) types.Params {
+	return types.Params{
+		EncodedSlices: encodedSlices,
+		MaxQuorums:    maxQuorums,
+	}
+}
+
+// quorumStakes sums the stake per vote of every row of each quorum
+func quorumStakes(candidates []keeper.Candidate, quorums types.Quorums) []sdk.Dec {
+	weights := make(map[string]sdk.Dec)
+	for _, candidate := range candidates {
+		weights[candidate.Account] = sdk.NewDecFromInt(candidate.Bonded).QuoInt64(int64(candidate.Votes))
+	}
+	stakes := make([]sdk.Dec, len(quorums.Quorums))
+	for i, quorum := range quorums.Quorums {
+		stakes[i] = sdk.ZeroDec()
+		for _, signer := range quorum.Signers {
+			stakes[i] = stakes[i].Add(weights[signer])
+		}
+	}
+	return stakes
+}
+
+// stakeSpread returns (max - min) / mean of the quorum stakes
+func stakeSpread(stakes []sdk.Dec) sdk.Dec {
+	min, max, total := stakes[0], stakes[0], sdk.ZeroDec()
+	for _, stake := range stakes {
+		if stake.LT(min) {
+			min = stake
+		}
+		if stake.GT(max) {
+			max = stake
+		}
+		total = total.Add(stake)
+	}
+	if total.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return max.Sub(min).Quo(total.QuoInt64(int64(len(stakes))))
+}
+
+func rowCounts(quorums types.Quorums) map[string]int {
+	counts := make(map[string]int)
+	for _, quorum := range quorums.Quorums {
+		for _, signer := range quorum.Signers {
+			counts[signer] += 1
+		}
+	}
+	return counts
+}
+
+func TestGetQuorumStrategy(t *testing.T) {
+	strategy, err := keeper.GetQuorumStrategy(types.QUORUM_STRATEGY_SORTED_BALLOTS)
+	require.NoError(t, err)
+	require.IsType(t, keeper.SortedBallotsStrategy{}, strategy)
+
+	strategy, err = keeper.GetQuorumStrategy(types.QUORUM_STRATEGY_STAKE_BALANCED)
+	require.NoError(t, err)
+	require.IsType(t, keeper.StakeBalancedStrategy{}, strategy)
+
+	_, err = keeper.GetQuorumStrategy(types.QuorumStrategy(100))
+	require.Error(t, err)
+}
+
+func TestQuorumStrategies_Shapes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		votes    []uint64
+		params   types.Params
+		sorted   []int
+		balanced []int
+	}{
+		{
+			name:     "no ballots",
+			votes:    []uint64{0, 0},
+			params:   strategyParams(4, 10),
+			sorted:   []int{0},
+			balanced: []int{0},
+		},
+		{
+			name:     "fewer ballots than slices wrap around",
+			votes:    []uint64{1, 2},
+			params:   strategyParams(8, 10),
+			sorted:   []int{8},
+			balanced: []int{8},
+		},
+		{
+			name:     "exact multiple of slices",
+			votes:    []uint64{4, 4, 4},
+			params:   strategyParams(4, 10),
+			sorted:   []int{4, 4, 4},
+			balanced: []int{4, 4, 4},
+		},
+		{
+			name:     "remainder adds an overlapping quorum",
+			votes:    []uint64{5, 4},
+			params:   strategyParams(4, 10),
+			sorted:   []int{4, 4, 4},
+			balanced: []int{4, 4, 4},
+		},
+		{
+			name:   "max quorums",
+			votes:  []uint64{10, 10, 10},
+			params: strategyParams(4, 2),
+			// the sorted ballots strategy historically allows one quorum over the maximum
+			sorted:   []int{4, 4, 4},
+			balanced: []int{4, 4},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			candidates := make([]keeper.Candidate, len(tc.votes))
+			for i, votes := range tc.votes {
+				candidates[i] = newCandidate(i, votes, int64(votes)*1000)
+			}
+			for strategy, expected := range map[keeper.QuorumStrategy][]int{
+				keeper.SortedBallotsStrategy{}: tc.sorted,
+				keeper.StakeBalancedStrategy{}: tc.balanced,
+			} {
+				quorums := strategy.AssignQuorums(candidates, tc.params)
+				sizes := make([]int, len(quorums.Quorums))
+				for i, quorum := range quorums.Quorums {
+					sizes[i] = len(quorum.Signers)
+				}
+				require.Equal(t, expected, sizes, "%T", strategy)
+			}
+		})
+	}
+}
+
+func TestQuorumStrategies_Deterministic(t *testing.T) {
+	candidates := make([]keeper.Candidate, 20)
+	for i := range candidates {
+		candidates[i] = newCandidate(i, uint64(i%7+1), int64(i+1)*12345)
+	}
+	reversed := make([]keeper.Candidate, len(candidates))
+	for i, candidate := range candidates {
+		reversed[len(candidates)-1-i] = candidate
+	}
+	params := strategyParams(16, 10)
+	for _, strategy := range []keeper.QuorumStrategy{keeper.SortedBallotsStrategy{}, keeper.StakeBalancedStrategy{}} {
+		expected := strategy.AssignQuorums(candidates, params)
+		require.Equal(t, expected, strategy.AssignQuorums(candidates, params), "%T", strategy)
+		// the registration order does not matter
+		require.Equal(t, expected, strategy.AssignQuorums(reversed, params), "%T", strategy)
+	}
+}
+
+func TestSortedBallotsStrategy_KeccakOrder(t *testing.T) {
+	candidates := []keeper.Candidate{newCandidate(1, 2, 2000), newCandidate(2, 2, 2000)}
+	type ballot struct {
+		account string
+		content []byte
+	}
+	ballots := make([]ballot, 0)
+	for _, candidate := range candidates {
+		content := candidate.Seed
+		for i := uint64(0); i < candidate.Votes; i += 1 {
+			ballots = append(ballots, ballot{candidate.Account, content})
+			content = crypto.Keccak256(content)
+		}
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		return string(ballots[i].content) < string(ballots[j].content)
+	})
+	expected := make([]string, len(ballots))
+	for i, b := range ballots {
+		expected[i] = b.account
+	}
+
+	quorums := keeper.SortedBallotsStrategy{}.AssignQuorums(candidates, strategyParams(4, 10))
+	require.Len(t, quorums.Quorums, 1)
+	require.Equal(t, expected, quorums.Quorums[0].Signers)
+}
+
+func TestStakeBalancedStrategy_BalancesCappedSigners(t *testing.T) {
+	// the first signer is capped, each of its rows is backed by 10 times the stake of the others
+	candidates := []keeper.Candidate{newCandidate(0, 4, 40000)}
+	for i := 1; i <= 12; i += 1 {
+		candidates = append(candidates, newCandidate(i, 1, 1000))
+	}
+	params := strategyParams(4, 10)
+
+	balanced := keeper.StakeBalancedStrategy{}.AssignQuorums(candidates, params)
+	require.Len(t, balanced.Quorums, 4)
+	// one capped row per quorum
+	for _, quorum := range balanced.Quorums {
+		require.Contains(t, quorum.Signers, candidates[0].Account)
+	}
+	require.True(t, stakeSpread(quorumStakes(candidates, balanced)).IsZero())
+
+	// every ballot keeps its row
+	counts := rowCounts(balanced)
+	for _, candidate := range candidates {
+		require.Equal(t, int(candidate.Votes), counts[candidate.Account])
+	}
+}
+
+// TestQuorumStrategies_Simulation compares the stake distribution over quorums of both strategies
+// on random signer sets, with a few signers whose votes are capped.
+func TestQuorumStrategies_Simulation(t *testing.T) {
+	r := rand.New(rand.NewSource(20240101))
+	params := strategyParams(64, 8)
+	maxVotes := uint64(32)
+
+	rounds := 50
+	sortedSpread, balancedSpread := sdk.ZeroDec(), sdk.ZeroDec()
+	for round := 0; round < rounds; round += 1 {
+		n := 20 + r.Intn(80)
+		candidates := make([]keeper.Candidate, n)
+		for i := range candidates {
+			votes := uint64(1 + r.Intn(int(maxVotes)))
+			bonded := int64(votes) * 1000
+			if r.Intn(10) == 0 {
+				// capped signer holding far more stake than its votes
+				votes = maxVotes
+				bonded = int64(maxVotes) * 1000 * int64(2+r.Intn(20))
+			}
+			candidates[i] = newCandidate(round*1000+i, votes, bonded)
+		}
+
+		sorted := keeper.SortedBallotsStrategy{}.AssignQuorums(candidates, params)
+		balanced := keeper.StakeBalancedStrategy{}.AssignQuorums(candidates, params)
+		for _, quorum := range balanced.Quorums {
+			require.Len(t, quorum.Signers, int(params.EncodedSlices))
+		}
+
+		sortedSpread = sortedSpread.Add(stakeSpread(quorumStakes(candidates, sorted)))
+		balancedSpread = balancedSpread.Add(stakeSpread(quorumStakes(candidates, balanced)))
+	}
+	sortedSpread = sortedSpread.QuoInt64(int64(rounds))
+	balancedSpread = balancedSpread.QuoInt64(int64(rounds))
+	t.Logf("mean quorum stake spread: sorted ballots %s, stake balanced %s", sortedSpread, balancedSpread)
+	require.True(t, balancedSpread.LT(sortedSpread))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// AggregatePubkeys returns the aggregate G1 and G2 keys of the quorum signers selected by the bitmap,
+// using the keys that were active in the epoch. A signer holding several rows is aggregated once but
+// every row it holds counts as a hit.
+func (k Keeper) AggregatePubkeys(
+	ctx sdk.Context,
+	epoch uint64,
+	quorumId uint64,
+	quorumBitmap []byte,
+) (*bn254.G1Affine, *bn254.G2Affine, uint64, uint64, error) {
+	quorum, err := k.GetEpochQuorum(ctx, epoch, quorumId)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	if (len(quorum.Signers)+7)/8 != len(quorumBitmap) {
+		return nil, nil, 0, 0, types.ErrQuorumBitmapLengthMismatch
+	}
+	aggPubkeyG1 := new(bn254.G1Affine)
+	aggPubkeyG2 := new(bn254.G2Affine)
+	hit := 0
+	added := make(map[string]struct{})
+	for i, signer := range quorum.Signers {
+		if _, ok := added[signer]; ok {
+			hit += 1
+			continue
+		}
+		b := quorumBitmap[i/8] & (1 << (i % 8))
+		if b == 0 {
+			continue
+		}
+		hit += 1
+		added[signer] = struct{}{}
+		signer, found, err := k.GetSignerAtEpoch(ctx, signer, epoch)
+		if err != nil {
+			return nil, nil, 0, 0, err
+		}
+		if !found {
+			return nil, nil, 0, 0, types.ErrSignerNotFound
+		}
+		aggPubkeyG1.Add(aggPubkeyG1, bn254util.DeserializeG1(signer.PubkeyG1))
+		aggPubkeyG2.Add(aggPubkeyG2, bn254util.DeserializeG2(signer.PubkeyG2))
+	}
+	return aggPubkeyG1, aggPubkeyG2, uint64(hit), uint64(len(quorum.Signers)), nil
+}
+
+// VerifyQuorumSignature checks the aggregate G1 signature of the signers selected by the bitmap
+// over the message hash with a bn254 pairing check. A malformed signature is reported as invalid
+// rather than as an error.
+func (k Keeper) VerifyQuorumSignature(
+	ctx sdk.Context,
+	epoch uint64,
+	quorumId uint64,
+	quorumBitmap []byte,
+	messageHash []byte,
+	signature []byte,
+) (bool, uint64, uint64, error) {
+	if len(messageHash) != 32 {
+		return false, 0, 0, types.ErrInvalidMessageHash
+	}
+	_, aggPubkeyG2, hit, total, err := k.AggregatePubkeys(ctx, epoch, quorumId, quorumBitmap)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	if hit == 0 || len(signature) != bn254util.G1PointSize {
+		return false, hit, total, nil
+	}
+	sig := bn254util.DeserializeG1(signature)
+	if !sig.IsOnCurve() || !sig.IsInSubGroup() {
+		return false, hit, total, nil
+	}
+	var msgHash [32]byte
+	copy(msgHash[:], messageHash)
+	valid, err := bn254util.VerifySig(sig, aggPubkeyG2, msgHash)
+	if err != nil {
+		return false, hit, total, nil
+	}
+	return valid, hit, total, nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// setupBLSQuorum stores a single quorum for the current epoch whose rows are held by signers with
+// deterministic BLS keys, and returns the secret key of every row.
+func (suite *KeeperTestSuite) setupBLSQuorum(rows []int, n int) []*big.Int {
+	secrets := make([]*big.Int, n)
+	accounts := make([]string, n)
+	for i := 0; i < n; i += 1 {
+		var sk fr.Element
+		sk.SetUint64(uint64(1000 + i))
+		secrets[i] = sk.BigInt(new(big.Int))
+		accounts[i] = hex.EncodeToString(app.RandomAddress())
+		suite.Require().NoError(suite.keeper.SetSigner(suite.ctx, types.Signer{
+			Account:  accounts[i],
+			PubkeyG1: bn254util.SerializeG1(bn254util.MulByGeneratorG1(&sk)),
+			PubkeyG2: bn254util.SerializeG2(bn254util.MulByGeneratorG2(&sk)),
+		}))
+	}
+	quorum := types.Quorum{Signers: make([]string, len(rows))}
+	rowSecrets := make([]*big.Int, len(rows))
+	for i, row := range rows {
+		quorum.Signers[i] = accounts[row]
+		rowSecrets[i] = secrets[row]
+	}
+	suite.keeper.SetEpochQuorums(suite.ctx, 0, types.Quorums{Quorums: []*types.Quorum{&quorum}})
+	return rowSecrets
+}
+
+// aggregateSignature signs the message hash with the secret keys of the rows set in the bitmap,
+// each signer signing once.
+func aggregateSignature(secrets []*big.Int, bitmap []byte, messageHash [32]byte) []byte {
+	hash := bn254util.MapToCurve(messageHash)
+	signature := new(bn254.G1Affine)
+	signed := make(map[string]struct{})
+	for i, sk := range secrets {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if _, ok := signed[sk.String()]; ok {
+			continue
+		}
+		signed[sk.String()] = struct{}{}
+		signature.Add(signature, new(bn254.G1Affine).ScalarMultiplication(hash, sk))
+	}
+	return bn254util.SerializeG1(signature)
+}
+
+func (suite *KeeperTestSuite) TestVerifyAggregateSignature() {
+	var messageHash [32]byte
+	copy(messageHash[:], crypto.Keccak256([]byte("blob")))
+	// signer 0 holds two rows
+	secrets := suite.setupBLSQuorum([]int{0, 1, 0, 2, 3, 4, 5, 6, 7}, 8)
+
+	testCases := []struct {
+		name      string
+		bitmap    []byte
+		signBits  []byte
+		hash      []byte
+		signature func(sig []byte) []byte
+		valid     bool
+		hit       uint64
+		err       error
+	}{
+		{
+			name:     "all signed",
+			bitmap:   []byte{0xff, 0x01},
+			signBits: []byte{0xff, 0x01},
+			hash:     messageHash[:],
+			valid:    true,
+			hit:      9,
+		},
+		{
+			name:     "partially signed",
+			bitmap:   []byte{0x1a, 0x00},
+			signBits: []byte{0x1a, 0x00},
+			hash:     messageHash[:],
+			valid:    true,
+			hit:      3,
+		},
+		{
+			name:     "duplicated signer counts every row",
+			bitmap:   []byte{0x01, 0x00},
+			signBits: []byte{0x01, 0x00},
+			hash:     messageHash[:],
+			valid:    true,
+			hit:      2,
+		},
+		{
+			name:     "signature misses a signer",
+			bitmap:   []byte{0x1a, 0x00},
+			signBits: []byte{0x0a, 0x00},
+			hash:     messageHash[:],
+			valid:    false,
+			hit:      3,
+		},
+		{
+			name:     "signature over other message",
+			bitmap:   []byte{0xff, 0x01},
+			signBits: []byte{0xff, 0x01},
+			hash:     crypto.Keccak256([]byte("other")),
+			valid:    false,
+			hit:      9,
+		},
+		{
+			name:     "empty bitmap",
+			bitmap:   []byte{0x00, 0x00},
+			signBits: []byte{0x00, 0x00},
+			hash:     messageHash[:],
+			valid:    false,
+			hit:      0,
+		},
+		{
+			name:     "malformed signature",
+			bitmap:   []byte{0xff, 0x01},
+			signBits: []byte{0xff, 0x01},
+			hash:     messageHash[:],
+			signature: func(sig []byte) []byte {
+				sig[63] ^= 0x01
+				return sig
+			},
+			valid: false,
+			hit:   9,
+		},
+		{
+			name:     "invalid message hash",
+			bitmap:   []byte{0xff, 0x01},
+			signBits: []byte{0xff, 0x01},
+			hash:     messageHash[:31],
+			err:      types.ErrInvalidMessageHash,
+		},
+		{
+			name:     "bitmap length mismatch",
+			bitmap:   []byte{0xff},
+			signBits: []byte{0xff, 0x01},
+			hash:     messageHash[:],
+			err:      types.ErrQuorumBitmapLengthMismatch,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			signature := aggregateSignature(secrets, tc.signBits, messageHash)
+			if tc.signature != nil {
+				signature = tc.signature(signature)
+			}
+			response, err := suite.keeper.VerifyAggregateSignature(sdk.WrapSDKContext(suite.ctx), &types.QueryVerifyAggregateSignatureRequest{
+				EpochNumber:  0,
+				QuorumId:     0,
+				QuorumBitmap: tc.bitmap,
+				MessageHash:  tc.hash,
+				Signature:    signature,
+			})
+			if tc.err != nil {
+				suite.Require().ErrorIs(err, tc.err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.valid, response.Valid)
+			suite.Require().Equal(tc.hit, response.Hit)
+			suite.Require().Equal(uint64(9), response.Total)
+		})
+	}
+}
@@ -34,6 +34,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterSigner{},
 		&MsgUpdateSocket{},
 		&MsgRegisterNextEpoch{},
+		&MsgDeregisterSigner{},
+		&MsgRotateSignerKey{},
+		&MsgReportMissedSignatures{},
+		&MsgUpdateParams{},
+		&MsgRegisterStanding{},
+		&MsgCancelStanding{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
@@ -26,6 +27,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QuorumStrategy enumerates the algorithms assigning ballots to quorum rows.
+type QuorumStrategy int32
+
+const (
+	// QUORUM_STRATEGY_SORTED_BALLOTS sorts the keccak chained ballots and chunks them by encoded slices
+	QUORUM_STRATEGY_SORTED_BALLOTS QuorumStrategy = 0
+	// QUORUM_STRATEGY_STAKE_BALANCED spreads the ballots so that every quorum is backed by a similar stake
+	QUORUM_STRATEGY_STAKE_BALANCED QuorumStrategy = 1
+)
+
+var QuorumStrategy_name = map[int32]string{
+	0: "QUORUM_STRATEGY_SORTED_BALLOTS",
+	1: "QUORUM_STRATEGY_STAKE_BALANCED",
+}
+
+var QuorumStrategy_value = map[string]int32{
+	"QUORUM_STRATEGY_SORTED_BALLOTS": 0,
+	"QUORUM_STRATEGY_STAKE_BALANCED": 1,
+}
+
+func (x QuorumStrategy) String() string {
+	return proto.EnumName(QuorumStrategy_name, int32(x))
+}
+
+func (QuorumStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{0}
+}
+
 type Signer struct {
 	// account defines the hex address of signer without 0x
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -144,36 +173,467 @@ func (m *Quorums) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Quorums proto.InternalMessageInfo
 
+type SignerExit struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// request_epoch defines the epoch in which the deregistration was requested
+	RequestEpoch uint64 `protobuf:"varint,2,opt,name=request_epoch,json=requestEpoch,proto3" json:"request_epoch,omitempty"`
+	// exit_epoch defines the epoch from which the signer record is tombstoned
+	ExitEpoch uint64 `protobuf:"varint,3,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+	// tombstoned defines whether the signer has completed the exit
+	Tombstoned bool `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+}
+
+func (m *SignerExit) Reset()         { *m = SignerExit{} }
+func (m *SignerExit) String() string { return proto.CompactTextString(m) }
+func (*SignerExit) ProtoMessage()    {}
+func (*SignerExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{3}
+}
+func (m *SignerExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerExit.Merge(m, src)
+}
+func (m *SignerExit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerExit proto.InternalMessageInfo
+
+// StandingRegistration keeps a signer registered for every epoch from start_epoch until it opts out.
+type StandingRegistration struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// signature defines the signature over the standing registration hash, the ballot seed of every
+	// epoch is derived from it
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// start_epoch defines the first epoch the signer is registered for
+	StartEpoch uint64 `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+}
+
+func (m *StandingRegistration) Reset()         { *m = StandingRegistration{} }
+func (m *StandingRegistration) String() string { return proto.CompactTextString(m) }
+func (*StandingRegistration) ProtoMessage()    {}
+func (*StandingRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{4}
+}
+func (m *StandingRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StandingRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StandingRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StandingRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandingRegistration.Merge(m, src)
+}
+func (m *StandingRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *StandingRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandingRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandingRegistration proto.InternalMessageInfo
+
+type SignerKeyRotation struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pubkey_g1 defines the new public key on bn254 G1
+	PubkeyG1 []byte `protobuf:"bytes,2,opt,name=pubkey_g1,json=pubkeyG1,proto3" json:"pubkey_g1,omitempty"`
+	// pubkey_g2 defines the new public key on bn254 G2
+	PubkeyG2 []byte `protobuf:"bytes,3,opt,name=pubkey_g2,json=pubkeyG2,proto3" json:"pubkey_g2,omitempty"`
+	// effective_epoch defines the first epoch in which the new key is used
+	EffectiveEpoch uint64 `protobuf:"varint,4,opt,name=effective_epoch,json=effectiveEpoch,proto3" json:"effective_epoch,omitempty"`
+}
+
+func (m *SignerKeyRotation) Reset()         { *m = SignerKeyRotation{} }
+func (m *SignerKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*SignerKeyRotation) ProtoMessage()    {}
+func (*SignerKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{5}
+}
+func (m *SignerKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerKeyRotation.Merge(m, src)
+}
+func (m *SignerKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerKeyRotation proto.InternalMessageInfo
+
+type SignerKeyRecord struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pubkey_g1 defines the retired public key on bn254 G1
+	PubkeyG1 []byte `protobuf:"bytes,2,opt,name=pubkey_g1,json=pubkeyG1,proto3" json:"pubkey_g1,omitempty"`
+	// pubkey_g2 defines the retired public key on bn254 G2
+	PubkeyG2 []byte `protobuf:"bytes,3,opt,name=pubkey_g2,json=pubkeyG2,proto3" json:"pubkey_g2,omitempty"`
+	// retired_epoch defines the first epoch in which the key is no longer used
+	RetiredEpoch uint64 `protobuf:"varint,4,opt,name=retired_epoch,json=retiredEpoch,proto3" json:"retired_epoch,omitempty"`
+}
+
+func (m *SignerKeyRecord) Reset()         { *m = SignerKeyRecord{} }
+func (m *SignerKeyRecord) String() string { return proto.CompactTextString(m) }
+func (*SignerKeyRecord) ProtoMessage()    {}
+func (*SignerKeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{6}
+}
+func (m *SignerKeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerKeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerKeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerKeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerKeyRecord.Merge(m, src)
+}
+func (m *SignerKeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerKeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerKeyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerKeyRecord proto.InternalMessageInfo
+
+type SignerBallot struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// bonded defines the bonded tokens counted for the signer
+	Bonded github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded"`
+	// votes defines the number of ballots granted to the signer
+	Votes uint64 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	// capped defines whether votes were limited by max_votes_per_signer
+	Capped bool `protobuf:"varint,4,opt,name=capped,proto3" json:"capped,omitempty"`
+	// seats defines the number of quorum rows assigned to the signer
+	Seats uint64 `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (m *SignerBallot) Reset()         { *m = SignerBallot{} }
+func (m *SignerBallot) String() string { return proto.CompactTextString(m) }
+func (*SignerBallot) ProtoMessage()    {}
+func (*SignerBallot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{7}
+}
+func (m *SignerBallot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerBallot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerBallot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerBallot.Merge(m, src)
+}
+func (m *SignerBallot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerBallot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerBallot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerBallot proto.InternalMessageInfo
+
+type SignerBallots struct {
+	Ballots []*SignerBallot `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
+}
+
+func (m *SignerBallots) Reset()         { *m = SignerBallots{} }
+func (m *SignerBallots) String() string { return proto.CompactTextString(m) }
+func (*SignerBallots) ProtoMessage()    {}
+func (*SignerBallots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{8}
+}
+func (m *SignerBallots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerBallots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerBallots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerBallots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerBallots.Merge(m, src)
+}
+func (m *SignerBallots) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerBallots) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerBallots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerBallots proto.InternalMessageInfo
+
+type SignerFlag struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// epoch defines the epoch in which the signer was flagged
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// reason defines why the signer was flagged
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height defines the block height at which the signer was flagged
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SignerFlag) Reset()         { *m = SignerFlag{} }
+func (m *SignerFlag) String() string { return proto.CompactTextString(m) }
+func (*SignerFlag) ProtoMessage()    {}
+func (*SignerFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{9}
+}
+func (m *SignerFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerFlag.Merge(m, src)
+}
+func (m *SignerFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerFlag proto.InternalMessageInfo
+
+type SignedBitmap struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	QuorumId    uint64 `protobuf:"varint,2,opt,name=quorum_id,json=quorumId,proto3" json:"quorum_id,omitempty"`
+	// quorum_bitmap marks the quorum rows whose signers took part in the signature
+	QuorumBitmap []byte `protobuf:"bytes,3,opt,name=quorum_bitmap,json=quorumBitmap,proto3" json:"quorum_bitmap,omitempty"`
+	// message_hash defines the 32 bytes hash signed by the quorum
+	MessageHash []byte `protobuf:"bytes,4,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	// signature defines the aggregate G1 signature of the signers selected by the bitmap
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedBitmap) Reset()         { *m = SignedBitmap{} }
+func (m *SignedBitmap) String() string { return proto.CompactTextString(m) }
+func (*SignedBitmap) ProtoMessage()    {}
+func (*SignedBitmap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{10}
+}
+func (m *SignedBitmap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedBitmap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedBitmap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedBitmap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedBitmap.Merge(m, src)
+}
+func (m *SignedBitmap) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedBitmap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedBitmap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedBitmap proto.InternalMessageInfo
+
+type MissedSignatures struct {
+	// account defines the hex address of signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// epoch defines the epoch of the quorums the signatures were missed in
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// count defines the number of reported signatures the signer missed
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MissedSignatures) Reset()         { *m = MissedSignatures{} }
+func (m *MissedSignatures) String() string { return proto.CompactTextString(m) }
+func (*MissedSignatures) ProtoMessage()    {}
+func (*MissedSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7328dc8ffac059e, []int{11}
+}
+func (m *MissedSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedSignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedSignatures.Merge(m, src)
+}
+func (m *MissedSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedSignatures proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("zgc.dasigners.v1.QuorumStrategy", QuorumStrategy_name, QuorumStrategy_value)
 	proto.RegisterType((*Signer)(nil), "zgc.dasigners.v1.Signer")
 	proto.RegisterType((*Quorum)(nil), "zgc.dasigners.v1.Quorum")
 	proto.RegisterType((*Quorums)(nil), "zgc.dasigners.v1.Quorums")
+	proto.RegisterType((*SignerExit)(nil), "zgc.dasigners.v1.SignerExit")
+	proto.RegisterType((*StandingRegistration)(nil), "zgc.dasigners.v1.StandingRegistration")
+	proto.RegisterType((*SignerKeyRotation)(nil), "zgc.dasigners.v1.SignerKeyRotation")
+	proto.RegisterType((*SignerKeyRecord)(nil), "zgc.dasigners.v1.SignerKeyRecord")
+	proto.RegisterType((*SignerBallot)(nil), "zgc.dasigners.v1.SignerBallot")
+	proto.RegisterType((*SignerBallots)(nil), "zgc.dasigners.v1.SignerBallots")
+	proto.RegisterType((*SignerFlag)(nil), "zgc.dasigners.v1.SignerFlag")
+	proto.RegisterType((*SignedBitmap)(nil), "zgc.dasigners.v1.SignedBitmap")
+	proto.RegisterType((*MissedSignatures)(nil), "zgc.dasigners.v1.MissedSignatures")
 }
 
 func init() { proto.RegisterFile("zgc/dasigners/v1/dasigners.proto", fileDescriptor_b7328dc8ffac059e) }
 
 var fileDescriptor_b7328dc8ffac059e = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
-	0x14, 0x86, 0xe3, 0xcb, 0x55, 0x28, 0x6e, 0x87, 0x2a, 0xaa, 0x2a, 0x43, 0x25, 0x2b, 0xca, 0xc4,
-	0xd2, 0x98, 0xa4, 0x73, 0x97, 0x2e, 0x9d, 0x3a, 0x94, 0x6e, 0x5d, 0x90, 0x63, 0x52, 0x13, 0x01,
-	0x39, 0x34, 0xb6, 0x51, 0xe1, 0x29, 0xfa, 0x58, 0x8c, 0x8c, 0x1d, 0x5b, 0x78, 0x91, 0x0a, 0x3b,
-	0x88, 0xc2, 0x76, 0xbe, 0xff, 0xfb, 0xa5, 0x23, 0x1f, 0xe3, 0x70, 0x29, 0x05, 0x1b, 0x72, 0x55,
-	0xc8, 0x32, 0xaf, 0x14, 0x9b, 0x27, 0x07, 0x88, 0x67, 0x15, 0x68, 0x08, 0x2e, 0x97, 0x52, 0xc4,
-	0x87, 0x70, 0x9e, 0x74, 0xda, 0x02, 0xd4, 0x14, 0xd4, 0xc0, 0x7a, 0xe6, 0xc0, 0x95, 0x3b, 0x57,
-	0x12, 0x24, 0xb8, 0x7c, 0x37, 0xd5, 0x69, 0x5b, 0x02, 0xc8, 0x49, 0xce, 0x2c, 0x65, 0xe6, 0x8d,
-	0xf1, 0x72, 0x51, 0x2b, 0x7a, 0xaa, 0x86, 0xa6, 0xe2, 0xba, 0x80, 0xd2, 0xf9, 0x48, 0x63, 0xff,
-	0xc5, 0x6e, 0x0e, 0x08, 0x6e, 0x72, 0x21, 0xc0, 0x94, 0x9a, 0xa0, 0x10, 0x75, 0x5b, 0xfd, 0x3d,
-	0x06, 0xd7, 0xd8, 0x57, 0x20, 0xc6, 0xb9, 0x26, 0xff, 0xac, 0xa8, 0x29, 0xb8, 0xc1, 0xad, 0x99,
-	0xc9, 0xc6, 0xf9, 0x62, 0x20, 0x13, 0xd2, 0x08, 0x51, 0xf7, 0xa2, 0x7f, 0xe6, 0x82, 0xc7, 0xe4,
-	0xaf, 0x4c, 0xc9, 0xff, 0x23, 0x99, 0x46, 0x11, 0xf6, 0x9f, 0x0d, 0x54, 0x66, 0xba, 0xdb, 0x5a,
-	0xbf, 0x9c, 0xa0, 0xb0, 0xb1, 0xdb, 0x5a, 0x63, 0x74, 0x8f, 0x9b, 0xae, 0xa3, 0x82, 0x14, 0x37,
-	0xdf, 0xdd, 0x68, 0x4b, 0xe7, 0x29, 0x89, 0x4f, 0x8f, 0x16, 0xbb, 0x6e, 0x7f, 0x5f, 0x7c, 0x78,
-	0x5a, 0xfd, 0x50, 0x6f, 0xb5, 0xa1, 0x68, 0xbd, 0xa1, 0xe8, 0x7b, 0x43, 0xd1, 0xe7, 0x96, 0x7a,
-	0xeb, 0x2d, 0xf5, 0xbe, 0xb6, 0xd4, 0x7b, 0x65, 0xb2, 0xd0, 0x23, 0x93, 0xc5, 0x02, 0xa6, 0xac,
-	0x27, 0x27, 0x3c, 0x53, 0xac, 0x27, 0x6f, 0xc5, 0x88, 0x17, 0x25, 0xfb, 0x38, 0xfe, 0x2f, 0xbd,
-	0x98, 0xe5, 0x2a, 0xf3, 0xed, 0xb9, 0xee, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x80, 0xdc,
-	0x60, 0xd0, 0x01, 0x00, 0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x9b, 0x7f, 0x9b, 0x97, 0xb4, 0x0d, 0x56, 0x84, 0xdc, 0x05, 0xbc, 0xc1, 0x48, 0x50,
+	0x21, 0x6d, 0xdc, 0x84, 0x0b, 0x07, 0x38, 0x24, 0x34, 0x94, 0xa8, 0xdd, 0xae, 0x3a, 0x49, 0x25,
+	0xe0, 0x80, 0x35, 0xb6, 0x67, 0xc7, 0xd6, 0x26, 0x9e, 0xd4, 0x33, 0x8e, 0x36, 0xfd, 0x04, 0xa8,
+	0xa7, 0x7e, 0x07, 0xbe, 0x42, 0x3f, 0x01, 0xa7, 0x3d, 0x56, 0x9c, 0x10, 0x87, 0x15, 0xec, 0x7e,
+	0x11, 0xe4, 0x99, 0xc9, 0x26, 0x59, 0x50, 0x10, 0x87, 0x9e, 0xe2, 0xdf, 0xef, 0xfd, 0xe2, 0xf7,
+	0x7b, 0xf3, 0xde, 0x1b, 0x43, 0xfb, 0x25, 0x0d, 0xdc, 0x10, 0xf3, 0x98, 0x26, 0x24, 0xe5, 0xee,
+	0xa2, 0xbb, 0x06, 0x9d, 0x79, 0xca, 0x04, 0x33, 0x9b, 0x2f, 0x69, 0xd0, 0x59, 0x93, 0x8b, 0xee,
+	0xfe, 0xbd, 0x80, 0xf1, 0x19, 0xe3, 0x9e, 0x8c, 0xbb, 0x0a, 0x28, 0xf1, 0x7e, 0x8b, 0x32, 0xca,
+	0x14, 0x9f, 0x3f, 0x69, 0xf6, 0x1e, 0x65, 0x8c, 0x4e, 0x89, 0x2b, 0x91, 0x9f, 0x9d, 0xb8, 0x38,
+	0x59, 0xea, 0x90, 0x7d, 0x33, 0x14, 0x66, 0x29, 0x16, 0x31, 0x4b, 0x54, 0xdc, 0x11, 0x50, 0x19,
+	0xcb, 0xcc, 0xa6, 0x05, 0x55, 0x1c, 0x04, 0x2c, 0x4b, 0x84, 0x65, 0xb4, 0x8d, 0xfb, 0x35, 0xb4,
+	0x82, 0xe6, 0xfb, 0x50, 0xe1, 0x2c, 0x38, 0x25, 0xc2, 0xba, 0x25, 0x03, 0x1a, 0x99, 0x1f, 0x40,
+	0x6d, 0x9e, 0xf9, 0xa7, 0x64, 0xe9, 0xd1, 0xae, 0x55, 0x6c, 0x1b, 0xf7, 0x1b, 0x68, 0x4f, 0x11,
+	0x8f, 0xba, 0x9b, 0xc1, 0x9e, 0x55, 0xda, 0x0a, 0xf6, 0x1c, 0x07, 0x2a, 0xcf, 0x32, 0x96, 0x66,
+	0xb3, 0x3c, 0xab, 0xae, 0xdc, 0x32, 0xda, 0xc5, 0x3c, 0xab, 0x86, 0xce, 0xd7, 0x50, 0x55, 0x1a,
+	0x6e, 0xf6, 0xa0, 0xfa, 0x42, 0x3d, 0x4a, 0x51, 0xbd, 0x67, 0x75, 0x6e, 0x1e, 0x5a, 0x47, 0x69,
+	0xd1, 0x4a, 0xe8, 0xbc, 0x32, 0x00, 0x54, 0x65, 0xc3, 0xb3, 0x58, 0xec, 0xa8, 0xee, 0x13, 0xb8,
+	0x9d, 0x92, 0x17, 0x19, 0xe1, 0xc2, 0x23, 0x73, 0x16, 0x44, 0xb2, 0xc8, 0x12, 0x6a, 0x68, 0x72,
+	0x98, 0x73, 0xe6, 0x47, 0x00, 0xe4, 0x2c, 0x5e, 0x29, 0x8a, 0x52, 0x51, 0xcb, 0x19, 0x15, 0xb6,
+	0x01, 0x04, 0x9b, 0xf9, 0x5c, 0xb0, 0x84, 0x84, 0xb2, 0xda, 0x3d, 0xb4, 0xc1, 0x38, 0x0c, 0x5a,
+	0x63, 0x81, 0x93, 0x30, 0x4e, 0x28, 0x22, 0x34, 0xe6, 0x42, 0xf5, 0x60, 0x87, 0xab, 0x0f, 0xa1,
+	0x96, 0x17, 0x87, 0x45, 0x96, 0x12, 0xe9, 0xa8, 0x81, 0xd6, 0x84, 0x79, 0x00, 0x75, 0x2e, 0x70,
+	0xba, 0xed, 0x07, 0x24, 0x25, 0x0d, 0x39, 0xaf, 0x0d, 0x78, 0x4f, 0x55, 0xff, 0x98, 0x2c, 0x11,
+	0x13, 0xff, 0x95, 0x6e, 0xab, 0x95, 0xb7, 0x76, 0xb5, 0x72, 0xbb, 0xcf, 0x3d, 0xf3, 0x33, 0xb8,
+	0x4b, 0x4e, 0x4e, 0x48, 0x20, 0xe2, 0x05, 0xd1, 0x76, 0x4a, 0xd2, 0xce, 0x9d, 0x6b, 0x5a, 0x59,
+	0x7a, 0x65, 0xc0, 0xdd, 0xb5, 0x25, 0x12, 0xb0, 0x34, 0x7c, 0x27, 0x86, 0x64, 0x3f, 0x45, 0x9c,
+	0x92, 0x70, 0xcb, 0x4e, 0x43, 0x93, 0xca, 0xcc, 0xaf, 0x06, 0x34, 0x94, 0x99, 0x01, 0x9e, 0x4e,
+	0xd9, 0xae, 0xf9, 0x98, 0x40, 0xc5, 0x67, 0x49, 0x48, 0x42, 0x35, 0xfd, 0x83, 0xaf, 0xce, 0x2f,
+	0x0e, 0x0a, 0x7f, 0x5c, 0x1c, 0x7c, 0x4a, 0x63, 0x11, 0x65, 0x7e, 0x27, 0x60, 0x33, 0xbd, 0xa3,
+	0xfa, 0xe7, 0x90, 0x87, 0xa7, 0xae, 0x58, 0xce, 0x09, 0xef, 0x8c, 0x12, 0xf1, 0xdb, 0x9b, 0x43,
+	0xd0, 0x2b, 0x3c, 0x4a, 0x04, 0xd2, 0xef, 0x32, 0x5b, 0x50, 0x5e, 0x30, 0x41, 0xb8, 0xee, 0x9d,
+	0x02, 0xf9, 0xa6, 0x05, 0x78, 0x3e, 0xbf, 0x9e, 0x21, 0x8d, 0x72, 0x35, 0x27, 0x58, 0x70, 0xab,
+	0xac, 0xd4, 0x12, 0x38, 0x23, 0xb8, 0xbd, 0x59, 0x03, 0x37, 0xbf, 0x84, 0xaa, 0xaf, 0x1e, 0xf5,
+	0x9e, 0xd8, 0xff, 0xdc, 0x93, 0xcd, 0x7f, 0xa0, 0x95, 0xdc, 0x99, 0xae, 0x96, 0xe5, 0xdb, 0x29,
+	0xa6, 0x3b, 0x0e, 0xa3, 0x05, 0xe5, 0xcd, 0x25, 0x51, 0x20, 0xb7, 0x9d, 0x12, 0xcc, 0x59, 0x22,
+	0xab, 0xa9, 0x21, 0x8d, 0x72, 0x3e, 0x22, 0x31, 0x8d, 0x84, 0x2c, 0xa7, 0x88, 0x34, 0x72, 0xde,
+	0xac, 0x4e, 0x3f, 0x1c, 0xc4, 0x62, 0x86, 0xe7, 0xe6, 0xc7, 0xd0, 0x90, 0x6f, 0xf2, 0x92, 0x6c,
+	0xe6, 0x93, 0x54, 0x66, 0x2d, 0xa1, 0xba, 0xe4, 0x9e, 0x4a, 0x2a, 0xef, 0xb9, 0x5a, 0x6d, 0x2f,
+	0x0e, 0x75, 0xf6, 0x3d, 0x45, 0x8c, 0xc2, 0xbc, 0xe7, 0x3a, 0xe8, 0xcb, 0x17, 0xea, 0xa1, 0x68,
+	0x28, 0x72, 0x9d, 0x64, 0x46, 0x38, 0xc7, 0x94, 0x78, 0x11, 0xe6, 0x91, 0xbe, 0x94, 0xea, 0x9a,
+	0xfb, 0x0e, 0xf3, 0x68, 0x7b, 0xeb, 0xca, 0x37, 0xb6, 0xce, 0xf9, 0x1e, 0x9a, 0x47, 0x31, 0xe7,
+	0x24, 0x1c, 0xaf, 0x28, 0xfe, 0xbf, 0x8f, 0xaa, 0x05, 0x65, 0xa5, 0xd6, 0x7d, 0x97, 0xe0, 0xf3,
+	0x9f, 0xe0, 0x8e, 0xba, 0xbf, 0xc6, 0xf9, 0xcd, 0x40, 0xe8, 0xd2, 0x74, 0xc0, 0x7e, 0xf6, 0xfc,
+	0x18, 0x3d, 0x3f, 0xf2, 0xc6, 0x13, 0xd4, 0x9f, 0x0c, 0x1f, 0xfd, 0xe0, 0x8d, 0x8f, 0xd1, 0x64,
+	0xf8, 0xd0, 0x1b, 0xf4, 0x9f, 0x3c, 0x39, 0x9e, 0x8c, 0x9b, 0x85, 0x7f, 0xd5, 0x4c, 0xfa, 0x8f,
+	0x87, 0xb9, 0xa4, 0xff, 0xf4, 0x9b, 0xe1, 0xc3, 0xa6, 0xb1, 0x5f, 0xfa, 0xf9, 0x17, 0xbb, 0x30,
+	0x38, 0x3a, 0xff, 0xcb, 0x2e, 0x9c, 0x5f, 0xda, 0xc6, 0xdb, 0x4b, 0xdb, 0xf8, 0xf3, 0xd2, 0x36,
+	0x5e, 0x5f, 0xd9, 0x85, 0xb7, 0x57, 0x76, 0xe1, 0xf7, 0x2b, 0xbb, 0xf0, 0xa3, 0xbb, 0x31, 0xc9,
+	0x0f, 0xe8, 0x14, 0xfb, 0xdc, 0x7d, 0x40, 0x0f, 0x83, 0x08, 0xc7, 0x89, 0x7b, 0xb6, 0xfd, 0xf1,
+	0x92, 0x63, 0xed, 0x57, 0xe4, 0xb7, 0xe3, 0x8b, 0xbf, 0x07, 0x00, 0xf6, 0x9c, 0x14, 0x1e, 0xdd,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Signer) Marshal() (dAtA []byte, err error) {
@@ -296,79 +756,1924 @@ func (m *Quorums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDasigners(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDasigners(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SignerExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Signer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SignerExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovDasigners(uint64(l))
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Socket)
-	if l > 0 {
-		n += 1 + l + sovDasigners(uint64(l))
+	if m.ExitEpoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.ExitEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PubkeyG1)
-	if l > 0 {
-		n += 1 + l + sovDasigners(uint64(l))
+	if m.RequestEpoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.RequestEpoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.PubkeyG2)
-	if l > 0 {
-		n += 1 + l + sovDasigners(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Quorum) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StandingRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StandingRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandingRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovDasigners(uint64(l))
+	if m.StartEpoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveEpoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.EffectiveEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubkeyG2) > 0 {
+		i -= len(m.PubkeyG2)
+		copy(dAtA[i:], m.PubkeyG2)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.PubkeyG2)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubkeyG1) > 0 {
+		i -= len(m.PubkeyG1)
+		copy(dAtA[i:], m.PubkeyG1)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.PubkeyG1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerKeyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerKeyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerKeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetiredEpoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.RetiredEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubkeyG2) > 0 {
+		i -= len(m.PubkeyG2)
+		copy(dAtA[i:], m.PubkeyG2)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.PubkeyG2)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubkeyG1) > 0 {
+		i -= len(m.PubkeyG1)
+		copy(dAtA[i:], m.PubkeyG1)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.PubkeyG1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerBallot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerBallot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seats != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.Seats))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Votes != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Bonded.Size()
+		i -= size
+		if _, err := m.Bonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDasigners(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerBallots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerBallots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerBallots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDasigners(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedBitmap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedBitmap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedBitmap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuorumBitmap) > 0 {
+		i -= len(m.QuorumBitmap)
+		copy(dAtA[i:], m.QuorumBitmap)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.QuorumBitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QuorumId != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.QuorumId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDasigners(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDasigners(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDasigners(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDasigners(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Signer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.Socket)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.PubkeyG1)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.PubkeyG2)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	return n
+}
+
+func (m *Quorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovDasigners(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Quorums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quorums) > 0 {
+		for _, e := range m.Quorums {
+			l = e.Size()
+			n += 1 + l + sovDasigners(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.RequestEpoch != 0 {
+		n += 1 + sovDasigners(uint64(m.RequestEpoch))
+	}
+	if m.ExitEpoch != 0 {
+		n += 1 + sovDasigners(uint64(m.ExitEpoch))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	return n
+}
+
+func (m *StandingRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovDasigners(uint64(m.StartEpoch))
+	}
+	return n
+}
+
+func (m *SignerKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.PubkeyG1)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.PubkeyG2)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.EffectiveEpoch != 0 {
+		n += 1 + sovDasigners(uint64(m.EffectiveEpoch))
+	}
+	return n
+}
+
+func (m *SignerKeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.PubkeyG1)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.PubkeyG2)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.RetiredEpoch != 0 {
+		n += 1 + sovDasigners(uint64(m.RetiredEpoch))
+	}
+	return n
+}
+
+func (m *SignerBallot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = m.Bonded.Size()
+	n += 1 + l + sovDasigners(uint64(l))
+	if m.Votes != 0 {
+		n += 1 + sovDasigners(uint64(m.Votes))
+	}
+	if m.Capped {
+		n += 2
+	}
+	if m.Seats != 0 {
+		n += 1 + sovDasigners(uint64(m.Seats))
+	}
+	return n
+}
+
+func (m *SignerBallots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovDasigners(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDasigners(uint64(m.Epoch))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDasigners(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SignedBitmap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovDasigners(uint64(m.EpochNumber))
+	}
+	if m.QuorumId != 0 {
+		n += 1 + sovDasigners(uint64(m.QuorumId))
+	}
+	l = len(m.QuorumBitmap)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	return n
+}
+
+func (m *MissedSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDasigners(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDasigners(uint64(m.Epoch))
+	}
+	if m.Count != 0 {
+		n += 1 + sovDasigners(uint64(m.Count))
+	}
+	return n
+}
+
+func sovDasigners(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDasigners(x uint64) (n int) {
+	return sovDasigners(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Signer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Socket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyG1 = append(m.PubkeyG1[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyG1 == nil {
+				m.PubkeyG1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyG2 = append(m.PubkeyG2[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyG2 == nil {
+				m.PubkeyG2 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quorum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quorum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quorum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quorums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quorums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quorums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorums = append(m.Quorums, &Quorum{})
+			if err := m.Quorums[len(m.Quorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerExit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerExit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerExit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEpoch", wireType)
+			}
+			m.RequestEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitEpoch", wireType)
+			}
+			m.ExitEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StandingRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StandingRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StandingRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyG1 = append(m.PubkeyG1[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyG1 == nil {
+				m.PubkeyG1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyG2 = append(m.PubkeyG2[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyG2 == nil {
+				m.PubkeyG2 = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveEpoch", wireType)
+			}
+			m.EffectiveEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerKeyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerKeyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerKeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyG1 = append(m.PubkeyG1[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyG1 == nil {
+				m.PubkeyG1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyG2 = append(m.PubkeyG2[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyG2 == nil {
+				m.PubkeyG2 = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredEpoch", wireType)
+			}
+			m.RetiredEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerBallot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerBallot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerBallot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Capped = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seats", wireType)
+			}
+			m.Seats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerBallots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDasigners
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerBallots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerBallots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballots = append(m.Ballots, &SignerBallot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDasigners(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Quorums) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Quorums) > 0 {
-		for _, e := range m.Quorums {
-			l = e.Size()
-			n += 1 + l + sovDasigners(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDasigners(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDasigners(x uint64) (n int) {
-	return sovDasigners(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Signer) Unmarshal(dAtA []byte) error {
+func (m *SignerFlag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -391,10 +2696,10 @@ func (m *Signer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Signer: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerFlag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Signer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerFlag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -430,10 +2735,10 @@ func (m *Signer) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDasigners
@@ -443,29 +2748,16 @@ func (m *Signer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDasigners
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDasigners
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Socket = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDasigners
@@ -475,31 +2767,29 @@ func (m *Signer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDasigners
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDasigners
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubkeyG1 = append(m.PubkeyG1[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubkeyG1 == nil {
-				m.PubkeyG1 = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyG2", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDasigners
@@ -509,26 +2799,11 @@ func (m *Signer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDasigners
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDasigners
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubkeyG2 = append(m.PubkeyG2[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubkeyG2 == nil {
-				m.PubkeyG2 = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDasigners(dAtA[iNdEx:])
@@ -550,7 +2825,7 @@ func (m *Signer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Quorum) Unmarshal(dAtA []byte) error {
+func (m *SignedBitmap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -573,17 +2848,55 @@ func (m *Quorum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Quorum: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedBitmap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Quorum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedBitmap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumId", wireType)
+			}
+			m.QuorumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumBitmap", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDasigners
@@ -593,23 +2906,93 @@ func (m *Quorum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDasigners
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDasigners
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.QuorumBitmap = append(m.QuorumBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumBitmap == nil {
+				m.QuorumBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDasigners
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -632,7 +3015,7 @@ func (m *Quorum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Quorums) Unmarshal(dAtA []byte) error {
+func (m *MissedSignatures) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -655,17 +3038,17 @@ func (m *Quorums) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Quorums: wiretype end group for non-group")
+			return fmt.Errorf("proto: MissedSignatures: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Quorums: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MissedSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDasigners
@@ -675,26 +3058,62 @@ func (m *Quorums) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDasigners
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDasigners
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorums = append(m.Quorums, &Quorum{})
-			if err := m.Quorums[len(m.Quorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDasigners
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDasigners(dAtA[iNdEx:])
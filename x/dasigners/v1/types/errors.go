@@ -12,4 +12,15 @@ var (
 	ErrQuorumBitmapLengthMismatch = errorsmod.Register(ModuleName, 7, "quorum bitmap length mismatch")
 	ErrInsufficientBonded         = errorsmod.Register(ModuleName, 8, "insufficient bonded amount")
 	ErrRowIndexOutOfBound         = errorsmod.Register(ModuleName, 9, "row index out of bound")
+	ErrSignerExiting              = errorsmod.Register(ModuleName, 10, "signer is exiting")
+	ErrSignerTombstoned           = errorsmod.Register(ModuleName, 11, "signer is tombstoned")
+	ErrSignerExitNotFound         = errorsmod.Register(ModuleName, 12, "signer exit not found")
+	ErrKeyRotationNotFound        = errorsmod.Register(ModuleName, 13, "key rotation not found")
+	ErrQuorumPruned               = errorsmod.Register(ModuleName, 14, "quorum for epoch has been pruned")
+	ErrBallotNotFound             = errorsmod.Register(ModuleName, 15, "ballot not found")
+	ErrInvalidMessageHash         = errorsmod.Register(ModuleName, 16, "invalid message hash")
+	ErrEvidenceReported           = errorsmod.Register(ModuleName, 17, "evidence already reported")
+	ErrStandingNotFound           = errorsmod.Register(ModuleName, 18, "standing registration not found")
+	ErrStandingExists             = errorsmod.Register(ModuleName, 19, "standing registration already exists")
+	ErrInsufficientSigners        = errorsmod.Register(ModuleName, 20, "evidence signed by too few quorum signers")
 )
@@ -2,10 +2,32 @@ package types
 
 // Module event types
 const (
-	EventTypeUpdateSigner = "update_signer"
+	EventTypeUpdateSigner     = "update_signer"
+	EventTypeDeregisterSigner = "deregister_signer"
+	EventTypeSignerExited     = "signer_exited"
+	EventTypeRotateSignerKey  = "rotate_signer_key"
+	EventTypeFlagSigner       = "flag_signer"
+	EventTypeMissedSignatures = "missed_signatures"
+	EventTypeJailSigner       = "jail_signer"
+	EventTypeUpdateParams     = "update_params"
+	EventTypeRegisterStanding = "register_standing"
+	EventTypeCancelStanding   = "cancel_standing"
 
-	AttributeKeySigner      = "signer"
-	AttributeKeySocket      = "socket"
-	AttributeKeyPublicKeyG1 = "pubkey_g1"
-	AttributeKeyPublicKeyG2 = "pubkey_g2"
+	AttributeKeySigner         = "signer"
+	AttributeKeySocket         = "socket"
+	AttributeKeyPublicKeyG1    = "pubkey_g1"
+	AttributeKeyPublicKeyG2    = "pubkey_g2"
+	AttributeKeyExitEpoch      = "exit_epoch"
+	AttributeKeyEffectiveEpoch = "effective_epoch"
+	AttributeKeyEpoch          = "epoch"
+	AttributeKeyReason         = "reason"
+	AttributeKeyReporter       = "reporter"
+	AttributeKeyMissedCount    = "missed_count"
+	AttributeKeyAuthority      = "authority"
+	AttributeKeyStartEpoch     = "start_epoch"
+
+	FlagReasonSlashed  = "slashed"
+	FlagReasonJailed   = "jailed"
+	FlagReasonUnbonded = "unbonded"
+	FlagReasonMissed   = "missed_signatures"
 )
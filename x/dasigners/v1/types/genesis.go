@@ -1,32 +1,78 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+)
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(params Params, epoch uint64, signers []*Signer, quorumsByEpoch []*Quorums) *GenesisState {
+func NewGenesisState(
+	params Params,
+	epoch uint64,
+	signers []*Signer,
+	quorumsByEpoch []*Quorums,
+	signerExits []*SignerExit,
+	keyRotations []*SignerKeyRotation,
+	keyHistory []*SignerKeyRecord,
+	earliestEpoch uint64,
+	ballotsByEpoch []*SignerBallots,
+	signerFlags []*SignerFlag,
+	missedSignatures []*MissedSignatures,
+	reportedEvidence []*SignedBitmap,
+	pendingParams *Params,
+	epochAnchorHeight uint64,
+	epochAnchorEpoch uint64,
+	standingRegistrations []*StandingRegistration,
+) *GenesisState {
 	return &GenesisState{
-		Params:         params,
-		EpochNumber:    epoch,
-		Signers:        signers,
-		QuorumsByEpoch: quorumsByEpoch,
+		Params:                params,
+		EpochNumber:           epoch,
+		Signers:               signers,
+		QuorumsByEpoch:        quorumsByEpoch,
+		SignerExits:           signerExits,
+		KeyRotations:          keyRotations,
+		KeyHistory:            keyHistory,
+		EarliestEpoch:         earliestEpoch,
+		BallotsByEpoch:        ballotsByEpoch,
+		SignerFlags:           signerFlags,
+		MissedSignatures:      missedSignatures,
+		ReportedEvidence:      reportedEvidence,
+		PendingParams:         pendingParams,
+		EpochAnchorHeight:     epochAnchorHeight,
+		EpochAnchorEpoch:      epochAnchorEpoch,
+		StandingRegistrations: standingRegistrations,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state for the module.
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(Params{
-		TokensPerVote:     10,
-		MaxVotesPerSigner: 1024,
-		MaxQuorums:        10,
-		EpochBlocks:       5760,
-		EncodedSlices:     3072,
+		TokensPerVote:             10,
+		MaxVotesPerSigner:         1024,
+		MaxQuorums:                10,
+		EpochBlocks:               5760,
+		EncodedSlices:             3072,
+		ExitDelayEpochs:           2,
+		MissedSignaturesThreshold: 16,
 	}, 0, make([]*Signer, 0), []*Quorums{{
 		Quorums: make([]*Quorum, 0),
-	}})
+	}}, make([]*SignerExit, 0), make([]*SignerKeyRotation, 0), make([]*SignerKeyRecord, 0), 0, make([]*SignerBallots, 0), make([]*SignerFlag, 0), make([]*MissedSignatures, 0), make([]*SignedBitmap, 0), nil, 0, 0, make([]*StandingRegistration, 0))
 }
 
 // Validate performs basic validation of genesis data.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.PendingParams != nil {
+		if err := gs.PendingParams.Validate(); err != nil {
+			return err
+		}
+	}
+	if gs.EpochAnchorEpoch > gs.EpochNumber {
+		return fmt.Errorf("epoch anchor exceeds epoch number")
+	}
 	registered := make(map[string]struct{})
 	for _, signer := range gs.Signers {
 		if err := signer.Validate(); err != nil {
@@ -34,9 +80,46 @@ func (gs GenesisState) Validate() error {
 		}
 		registered[signer.Account] = struct{}{}
 	}
-	if len(gs.QuorumsByEpoch) != int(gs.EpochNumber)+1 {
+	if gs.EarliestEpoch > gs.EpochNumber {
+		return fmt.Errorf("earliest epoch exceeds epoch number")
+	}
+	if len(gs.QuorumsByEpoch) != int(gs.EpochNumber-gs.EarliestEpoch)+1 {
 		return fmt.Errorf("epoch history missing")
 	}
+	if len(gs.BallotsByEpoch) != 0 && len(gs.BallotsByEpoch) != len(gs.QuorumsByEpoch) {
+		return fmt.Errorf("ballot history mismatch")
+	}
+	for _, ballots := range gs.BallotsByEpoch {
+		for _, ballot := range ballots.Ballots {
+			if _, ok := registered[ballot.Account]; !ok {
+				return fmt.Errorf("historical signer detail missing")
+			}
+		}
+	}
+	for _, flag := range gs.SignerFlags {
+		if _, ok := registered[flag.Account]; !ok {
+			return fmt.Errorf("flagged signer detail missing")
+		}
+		if flag.Epoch > gs.EpochNumber {
+			return fmt.Errorf("signer flagged in future epoch")
+		}
+	}
+	for _, missed := range gs.MissedSignatures {
+		if _, ok := registered[missed.Account]; !ok {
+			return fmt.Errorf("missed signer detail missing")
+		}
+		if missed.Epoch < gs.EarliestEpoch || missed.Epoch > gs.EpochNumber {
+			return fmt.Errorf("missed signatures out of retained epochs")
+		}
+	}
+	for _, evidence := range gs.ReportedEvidence {
+		if err := evidence.Validate(); err != nil {
+			return err
+		}
+		if evidence.EpochNumber < gs.EarliestEpoch || evidence.EpochNumber > gs.EpochNumber {
+			return fmt.Errorf("reported evidence out of retained epochs")
+		}
+	}
 	for _, quorums := range gs.QuorumsByEpoch {
 		for _, quorum := range quorums.Quorums {
 			for _, signer := range quorum.Signers {
@@ -49,5 +132,57 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 	}
+	exited := make(map[string]struct{})
+	for _, exit := range gs.SignerExits {
+		if _, ok := registered[exit.Account]; !ok {
+			return fmt.Errorf("exiting signer detail missing")
+		}
+		if _, ok := exited[exit.Account]; ok {
+			return fmt.Errorf("duplicate signer exit")
+		}
+		if exit.ExitEpoch < exit.RequestEpoch {
+			return fmt.Errorf("invalid signer exit epoch")
+		}
+		exited[exit.Account] = struct{}{}
+	}
+	standing := make(map[string]struct{})
+	for _, registration := range gs.StandingRegistrations {
+		if _, ok := registered[registration.Account]; !ok {
+			return fmt.Errorf("standing signer detail missing")
+		}
+		if _, ok := exited[registration.Account]; ok {
+			return fmt.Errorf("exiting signer has standing registration")
+		}
+		if _, ok := standing[registration.Account]; ok {
+			return fmt.Errorf("duplicate standing registration")
+		}
+		if len(registration.Signature) != bn254util.G1PointSize {
+			return fmt.Errorf("invalid standing registration signature")
+		}
+		standing[registration.Account] = struct{}{}
+	}
+	rotating := make(map[string]struct{})
+	for _, rotation := range gs.KeyRotations {
+		signer := Signer{Account: rotation.Account, PubkeyG1: rotation.PubkeyG1, PubkeyG2: rotation.PubkeyG2}
+		if err := signer.Validate(); err != nil {
+			return err
+		}
+		if _, ok := registered[rotation.Account]; !ok {
+			return fmt.Errorf("rotating signer detail missing")
+		}
+		if _, ok := rotating[rotation.Account]; ok {
+			return fmt.Errorf("duplicate key rotation")
+		}
+		rotating[rotation.Account] = struct{}{}
+	}
+	for _, record := range gs.KeyHistory {
+		signer := Signer{Account: record.Account, PubkeyG1: record.PubkeyG1, PubkeyG2: record.PubkeyG2}
+		if err := signer.Validate(); err != nil {
+			return err
+		}
+		if _, ok := registered[record.Account]; !ok {
+			return fmt.Errorf("historical signer detail missing")
+		}
+	}
 	return nil
 }
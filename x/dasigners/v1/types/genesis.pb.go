@@ -32,6 +32,14 @@ type Params struct {
 	MaxQuorums        uint64 `protobuf:"varint,3,opt,name=max_quorums,json=maxQuorums,proto3" json:"max_quorums,omitempty"`
 	EpochBlocks       uint64 `protobuf:"varint,4,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
 	EncodedSlices     uint64 `protobuf:"varint,5,opt,name=encoded_slices,json=encodedSlices,proto3" json:"encoded_slices,omitempty"`
+	ExitDelayEpochs   uint64 `protobuf:"varint,6,opt,name=exit_delay_epochs,json=exitDelayEpochs,proto3" json:"exit_delay_epochs,omitempty"`
+	// retained_epochs defines how many recent epochs of quorums are kept, 0 keeps all history
+	RetainedEpochs uint64 `protobuf:"varint,7,opt,name=retained_epochs,json=retainedEpochs,proto3" json:"retained_epochs,omitempty"`
+	// missed_signatures_threshold defines how many reported signatures a signer may miss in an epoch
+	// before it is jailed for the next epoch, 0 disables jailing
+	MissedSignaturesThreshold uint64 `protobuf:"varint,8,opt,name=missed_signatures_threshold,json=missedSignaturesThreshold,proto3" json:"missed_signatures_threshold,omitempty"`
+	// quorum_strategy defines how the ballots of an epoch are assigned to quorum rows
+	QuorumStrategy QuorumStrategy `protobuf:"varint,9,opt,name=quorum_strategy,json=quorumStrategy,proto3,enum=zgc.dasigners.v1.QuorumStrategy" json:"quorum_strategy,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -102,6 +110,34 @@ func (m *Params) GetEncodedSlices() uint64 {
 	return 0
 }
 
+func (m *Params) GetExitDelayEpochs() uint64 {
+	if m != nil {
+		return m.ExitDelayEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetRetainedEpochs() uint64 {
+	if m != nil {
+		return m.RetainedEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetMissedSignaturesThreshold() uint64 {
+	if m != nil {
+		return m.MissedSignaturesThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumStrategy() QuorumStrategy {
+	if m != nil {
+		return m.QuorumStrategy
+	}
+	return QUORUM_STRATEGY_SORTED_BALLOTS
+}
+
 // GenesisState defines the dasigners module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to deposit.
@@ -110,8 +146,32 @@ type GenesisState struct {
 	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	// signers defines all signers information
 	Signers []*Signer `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
-	// quorums_by_epoch defines chosen quorums by epoch
+	// quorums_by_epoch defines chosen quorums by epoch, starting from earliest_epoch
 	QuorumsByEpoch []*Quorums `protobuf:"bytes,4,rep,name=quorums_by_epoch,json=quorumsByEpoch,proto3" json:"quorums_by_epoch,omitempty"`
+	// signer_exits defines the exit status of deregistered signers
+	SignerExits []*SignerExit `protobuf:"bytes,5,rep,name=signer_exits,json=signerExits,proto3" json:"signer_exits,omitempty"`
+	// key_rotations defines the key rotations waiting for the next epoch
+	KeyRotations []*SignerKeyRotation `protobuf:"bytes,6,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	// key_history defines the retired keys still needed to verify historical quorums
+	KeyHistory []*SignerKeyRecord `protobuf:"bytes,7,rep,name=key_history,json=keyHistory,proto3" json:"key_history,omitempty"`
+	// earliest_epoch defines the earliest epoch whose quorums have not been pruned
+	EarliestEpoch uint64 `protobuf:"varint,8,opt,name=earliest_epoch,json=earliestEpoch,proto3" json:"earliest_epoch,omitempty"`
+	// ballots_by_epoch defines the ballot breakdown by epoch, aligned with quorums_by_epoch
+	BallotsByEpoch []*SignerBallots `protobuf:"bytes,9,rep,name=ballots_by_epoch,json=ballotsByEpoch,proto3" json:"ballots_by_epoch,omitempty"`
+	// signer_flags defines the flagged signers of retained epochs
+	SignerFlags []*SignerFlag `protobuf:"bytes,10,rep,name=signer_flags,json=signerFlags,proto3" json:"signer_flags,omitempty"`
+	// missed_signatures defines the missed signature counters of retained epochs
+	MissedSignatures []*MissedSignatures `protobuf:"bytes,11,rep,name=missed_signatures,json=missedSignatures,proto3" json:"missed_signatures,omitempty"`
+	// reported_evidence defines the signed bitmaps already reported in retained epochs
+	ReportedEvidence []*SignedBitmap `protobuf:"bytes,12,rep,name=reported_evidence,json=reportedEvidence,proto3" json:"reported_evidence,omitempty"`
+	// pending_params defines the parameters taking effect at the start of the next epoch
+	PendingParams *Params `protobuf:"bytes,13,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
+	// epoch_anchor_height and epoch_anchor_epoch define the height at which the epoch length last
+	// changed and the epoch starting at that height
+	EpochAnchorHeight uint64 `protobuf:"varint,14,opt,name=epoch_anchor_height,json=epochAnchorHeight,proto3" json:"epoch_anchor_height,omitempty"`
+	EpochAnchorEpoch  uint64 `protobuf:"varint,15,opt,name=epoch_anchor_epoch,json=epochAnchorEpoch,proto3" json:"epoch_anchor_epoch,omitempty"`
+	// standing_registrations defines the signers registered for every epoch until they opt out
+	StandingRegistrations []*StandingRegistration `protobuf:"bytes,16,rep,name=standing_registrations,json=standingRegistrations,proto3" json:"standing_registrations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -175,6 +235,90 @@ func (m *GenesisState) GetQuorumsByEpoch() []*Quorums {
 	return nil
 }
 
+func (m *GenesisState) GetSignerExits() []*SignerExit {
+	if m != nil {
+		return m.SignerExits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyRotations() []*SignerKeyRotation {
+	if m != nil {
+		return m.KeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyHistory() []*SignerKeyRecord {
+	if m != nil {
+		return m.KeyHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEarliestEpoch() uint64 {
+	if m != nil {
+		return m.EarliestEpoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBallotsByEpoch() []*SignerBallots {
+	if m != nil {
+		return m.BallotsByEpoch
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignerFlags() []*SignerFlag {
+	if m != nil {
+		return m.SignerFlags
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedSignatures() []*MissedSignatures {
+	if m != nil {
+		return m.MissedSignatures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReportedEvidence() []*SignedBitmap {
+	if m != nil {
+		return m.ReportedEvidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingParams() *Params {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochAnchorHeight() uint64 {
+	if m != nil {
+		return m.EpochAnchorHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochAnchorEpoch() uint64 {
+	if m != nil {
+		return m.EpochAnchorEpoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStandingRegistrations() []*StandingRegistration {
+	if m != nil {
+		return m.StandingRegistrations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "zgc.dasigners.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "zgc.dasigners.v1.GenesisState")
@@ -183,35 +327,59 @@ func init() {
 func init() { proto.RegisterFile("zgc/dasigners/v1/genesis.proto", fileDescriptor_896efa766aaca3be) }
 
 var fileDescriptor_896efa766aaca3be = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xb3, 0x24, 0x04, 0xc9, 0x29, 0xa5, 0x58, 0x3d, 0x6c, 0x7a, 0xd8, 0x84, 0x4a, 0x20,
-	0x2e, 0xac, 0xdb, 0x22, 0xf1, 0x00, 0x41, 0x08, 0x71, 0x41, 0x25, 0x91, 0x38, 0x70, 0x59, 0x79,
-	0x9d, 0xc1, 0x59, 0x35, 0xde, 0x59, 0xd6, 0xde, 0x28, 0xe9, 0x53, 0xf0, 0x58, 0x3d, 0x70, 0xe8,
-	0x91, 0x13, 0x42, 0xc9, 0x8b, 0xa0, 0x1d, 0x9b, 0x56, 0xb4, 0xdc, 0xec, 0xff, 0xff, 0x66, 0xf4,
-	0xcf, 0xd8, 0x2c, 0xb9, 0xd4, 0x4a, 0xcc, 0xa5, 0x2d, 0x74, 0x09, 0xb5, 0x15, 0xab, 0x53, 0xa1,
-	0xa1, 0x04, 0x5b, 0xd8, 0xb4, 0xaa, 0xd1, 0x21, 0x3f, 0xb8, 0xd4, 0x2a, 0xbd, 0xf1, 0xd3, 0xd5,
-	0xe9, 0xd1, 0x50, 0xa1, 0x35, 0x68, 0x33, 0xf2, 0x85, 0xbf, 0x78, 0xf8, 0xe8, 0x50, 0xa3, 0x46,
-	0xaf, 0xb7, 0xa7, 0xa0, 0x0e, 0x35, 0xa2, 0x5e, 0x82, 0xa0, 0x5b, 0xde, 0x7c, 0x15, 0xb2, 0xdc,
-	0x04, 0x6b, 0x74, 0xd7, 0x72, 0x85, 0x01, 0xeb, 0xa4, 0xa9, 0x02, 0x30, 0xbe, 0x17, 0xef, 0x36,
-	0x0b, 0x11, 0xc7, 0x3f, 0x22, 0xd6, 0x3f, 0x97, 0xb5, 0x34, 0x96, 0xbf, 0x60, 0x4f, 0x1c, 0x5e,
-	0x40, 0x69, 0xb3, 0x0a, 0xea, 0x6c, 0x85, 0x0e, 0xe2, 0x68, 0x1c, 0xbd, 0xec, 0x4d, 0x1f, 0x7b,
-	0xf9, 0x1c, 0xea, 0xcf, 0xe8, 0x80, 0x0b, 0x76, 0x68, 0xe4, 0x9a, 0x00, 0x8f, 0xfa, 0x8e, 0xf1,
-	0x03, 0x82, 0x9f, 0x1a, 0xb9, 0x6e, 0xb1, 0x16, 0x9f, 0x91, 0xc1, 0x47, 0x6c, 0xd0, 0x16, 0x7c,
-	0x6b, 0xb0, 0x6e, 0x8c, 0x8d, 0xbb, 0xc4, 0x31, 0x23, 0xd7, 0x9f, 0xbc, 0xc2, 0x9f, 0xb1, 0x3d,
-	0xa8, 0x50, 0x2d, 0xb2, 0x7c, 0x89, 0xea, 0xc2, 0xc6, 0x3d, 0x22, 0x06, 0xa4, 0x4d, 0x48, 0xe2,
-	0xcf, 0xd9, 0x3e, 0x94, 0x0a, 0xe7, 0x30, 0xcf, 0xec, 0xb2, 0x50, 0x60, 0xe3, 0x87, 0x3e, 0x5b,
-	0x50, 0x67, 0x24, 0x1e, 0x6f, 0x23, 0xb6, 0xf7, 0xde, 0xbf, 0xc0, 0xcc, 0x49, 0x07, 0xfc, 0x0d,
-	0xeb, 0x57, 0x34, 0x1e, 0xcd, 0x32, 0x38, 0x8b, 0xd3, 0xbb, 0x2f, 0x92, 0xfa, 0xf1, 0x27, 0xbd,
-	0xab, 0x5f, 0xa3, 0xce, 0x34, 0xd0, 0xb7, 0x91, 0xca, 0xc6, 0xe4, 0x37, 0xc3, 0xf9, 0x48, 0x1f,
-	0x49, 0xe2, 0x67, 0xec, 0x51, 0xe8, 0x12, 0x77, 0xc7, 0xdd, 0xff, 0xf7, 0xf6, 0x1b, 0x98, 0xfe,
-	0x05, 0xf9, 0x5b, 0x76, 0x10, 0xd6, 0x90, 0xe5, 0x9b, 0x8c, 0xba, 0xc5, 0x3d, 0x2a, 0x1e, 0xde,
-	0x2f, 0x0e, 0xeb, 0x99, 0xee, 0x87, 0x92, 0xc9, 0xe6, 0x1d, 0x6d, 0xe4, 0xc3, 0xd5, 0x36, 0x89,
-	0xae, 0xb7, 0x49, 0xf4, 0x7b, 0x9b, 0x44, 0xdf, 0x77, 0x49, 0xe7, 0x7a, 0x97, 0x74, 0x7e, 0xee,
-	0x92, 0xce, 0x17, 0xa1, 0x0b, 0xb7, 0x68, 0xf2, 0x54, 0xa1, 0x11, 0x27, 0x7a, 0x29, 0x73, 0x2b,
-	0x4e, 0xf4, 0x2b, 0xb5, 0x90, 0x45, 0x29, 0xd6, 0xff, 0x7e, 0x04, 0xb7, 0xa9, 0xc0, 0xe6, 0x7d,
-	0xfa, 0x05, 0xaf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xff, 0xe5, 0x90, 0xe2, 0xc8, 0x02, 0x00,
-	0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdf, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0x63, 0x12, 0x92, 0x74, 0xec, 0xd8, 0xce, 0x50, 0xd0, 0xa6, 0x20, 0xc7, 0x0d, 0xa2,
+	0x54, 0x08, 0xbc, 0x6d, 0x90, 0xb8, 0xa4, 0xc2, 0x10, 0x48, 0x54, 0x01, 0x65, 0x8d, 0xb8, 0x40,
+	0x42, 0xab, 0xf1, 0xee, 0xe9, 0xec, 0xc8, 0xbb, 0x3b, 0xdb, 0x39, 0xe3, 0xc8, 0xee, 0x15, 0x8f,
+	0xc0, 0x83, 0xf0, 0x20, 0xbd, 0xec, 0x25, 0x57, 0x08, 0x25, 0x2f, 0x82, 0xf6, 0xcc, 0x38, 0xff,
+	0x1c, 0xe7, 0x6e, 0xe7, 0x3b, 0xbf, 0xf3, 0xcd, 0xcc, 0x39, 0x67, 0x6c, 0xd6, 0x7b, 0x2d, 0x93,
+	0x30, 0x15, 0xa8, 0x64, 0x09, 0x06, 0xc3, 0xd3, 0xa7, 0xa1, 0x84, 0x12, 0x50, 0xe1, 0xa0, 0x32,
+	0xda, 0x6a, 0xde, 0x7d, 0x2d, 0x93, 0xc1, 0x45, 0x7c, 0x70, 0xfa, 0xf4, 0xc1, 0x5e, 0xa2, 0xb1,
+	0xd0, 0x18, 0x53, 0x3c, 0x74, 0x0b, 0x07, 0x3f, 0xb8, 0x2f, 0xb5, 0xd4, 0x4e, 0xaf, 0xbf, 0xbc,
+	0xba, 0x27, 0xb5, 0x96, 0x39, 0x84, 0xb4, 0x1a, 0x4f, 0x5f, 0x86, 0xa2, 0x9c, 0xfb, 0xd0, 0xfe,
+	0xcd, 0x90, 0x55, 0x05, 0xa0, 0x15, 0x45, 0xe5, 0x81, 0xfe, 0xd2, 0xf1, 0x2e, 0xcf, 0x42, 0xc4,
+	0xc1, 0xdf, 0xeb, 0x6c, 0xf3, 0x85, 0x30, 0xa2, 0x40, 0xfe, 0x88, 0x75, 0xac, 0x9e, 0x40, 0x89,
+	0x71, 0x05, 0x26, 0x3e, 0xd5, 0x16, 0x82, 0x46, 0xbf, 0xf1, 0x78, 0x23, 0xda, 0x71, 0xf2, 0x0b,
+	0x30, 0xbf, 0x69, 0x0b, 0x3c, 0x64, 0xf7, 0x0b, 0x31, 0x23, 0xc0, 0xa1, 0xce, 0x31, 0x78, 0x87,
+	0xe0, 0xdd, 0x42, 0xcc, 0x6a, 0xac, 0xc6, 0x47, 0x14, 0xe0, 0xfb, 0xac, 0x59, 0x27, 0xbc, 0x9a,
+	0x6a, 0x33, 0x2d, 0x30, 0x58, 0x27, 0x8e, 0x15, 0x62, 0xf6, 0x8b, 0x53, 0xf8, 0x43, 0xd6, 0x82,
+	0x4a, 0x27, 0x59, 0x3c, 0xce, 0x75, 0x32, 0xc1, 0x60, 0x83, 0x88, 0x26, 0x69, 0x43, 0x92, 0xf8,
+	0x27, 0xac, 0x0d, 0x65, 0xa2, 0x53, 0x48, 0x63, 0xcc, 0x55, 0x02, 0x18, 0xbc, 0xeb, 0xce, 0xe6,
+	0xd5, 0x11, 0x89, 0xfc, 0x33, 0xb6, 0x0b, 0x33, 0x65, 0xe3, 0x14, 0x72, 0x31, 0x8f, 0xc9, 0x00,
+	0x83, 0x4d, 0x22, 0x3b, 0x75, 0xe0, 0xbb, 0x5a, 0x3f, 0x22, 0x99, 0x7f, 0xca, 0x3a, 0x06, 0xac,
+	0x50, 0x25, 0xa4, 0x0b, 0x72, 0x8b, 0xc8, 0xf6, 0x42, 0xf6, 0xe0, 0xd7, 0xec, 0xc3, 0x42, 0x21,
+	0xd6, 0x5b, 0x2b, 0x59, 0x0a, 0x3b, 0x35, 0x80, 0xb1, 0xcd, 0x0c, 0x60, 0xa6, 0xf3, 0x34, 0xd8,
+	0xa6, 0xa4, 0x3d, 0x87, 0x8c, 0x2e, 0x88, 0x5f, 0x17, 0x00, 0x3f, 0x61, 0x1d, 0x77, 0xf7, 0x18,
+	0xad, 0x11, 0x16, 0xe4, 0x3c, 0xb8, 0xd7, 0x6f, 0x3c, 0x6e, 0x1f, 0xf6, 0x07, 0x37, 0xc7, 0x63,
+	0xe0, 0x4a, 0x32, 0xf2, 0x5c, 0xd4, 0x7e, 0x75, 0x6d, 0x7d, 0xf0, 0xe7, 0x36, 0x6b, 0xfd, 0xe0,
+	0x26, 0x6c, 0x64, 0x85, 0x05, 0xfe, 0x15, 0xdb, 0xac, 0xa8, 0x7d, 0xd4, 0xab, 0xe6, 0x61, 0xb0,
+	0x6c, 0xe9, 0xda, 0x3b, 0xdc, 0x78, 0xf3, 0xef, 0xfe, 0x5a, 0xe4, 0xe9, 0xcb, 0x92, 0x97, 0xd3,
+	0x62, 0x7c, 0xd1, 0x3c, 0x57, 0xf2, 0x9f, 0x48, 0xe2, 0x87, 0x6c, 0xcb, 0xbb, 0x04, 0xeb, 0xfd,
+	0xf5, 0xdb, 0xbd, 0x5d, 0x87, 0xa3, 0x05, 0xc8, 0xbf, 0x65, 0x5d, 0xdf, 0xe6, 0x78, 0xec, 0xeb,
+	0x1f, 0x6c, 0x50, 0xf2, 0xde, 0xaa, 0xbb, 0xe2, 0xe2, 0x92, 0x38, 0x74, 0x9d, 0xe1, 0xcf, 0x58,
+	0xcb, 0x51, 0x71, 0xdd, 0xb2, 0xba, 0xd3, 0xb5, 0xc1, 0x47, 0xab, 0x76, 0x3f, 0x9a, 0x29, 0x1b,
+	0x35, 0xf1, 0xe2, 0x1b, 0xf9, 0x31, 0xdb, 0x99, 0xc0, 0x3c, 0x36, 0xda, 0x0a, 0xab, 0x74, 0x59,
+	0x4f, 0x40, 0xed, 0xf0, 0xf1, 0x2a, 0x87, 0xe7, 0x30, 0x8f, 0x3c, 0x1b, 0xb5, 0x26, 0x97, 0x0b,
+	0xe4, 0x43, 0xd6, 0xac, 0x9d, 0x32, 0x85, 0x56, 0x9b, 0x79, 0xb0, 0x45, 0x3e, 0x0f, 0xef, 0xf2,
+	0x81, 0x44, 0x9b, 0x34, 0x62, 0x13, 0x98, 0x1f, 0xbb, 0x24, 0x1a, 0x5d, 0x61, 0x72, 0x05, 0x68,
+	0x7d, 0x45, 0xb6, 0xfd, 0xe8, 0x7a, 0xd5, 0xdd, 0xfa, 0x84, 0x75, 0xc7, 0x22, 0xcf, 0xb5, 0xbd,
+	0x52, 0xba, 0x7b, 0xb4, 0xdf, 0xfe, 0xaa, 0xfd, 0x86, 0x8e, 0x8f, 0xda, 0x3e, 0x71, 0xb9, 0x80,
+	0x2f, 0x73, 0x21, 0x31, 0x60, 0x77, 0x17, 0xf0, 0xfb, 0x5c, 0xc8, 0x45, 0x01, 0xeb, 0x6f, 0xe4,
+	0x3f, 0xb3, 0xdd, 0xa5, 0x89, 0x0f, 0x9a, 0xe4, 0x72, 0xb0, 0xec, 0xf2, 0xe3, 0x8d, 0xc9, 0x8f,
+	0xba, 0x37, 0xdf, 0x02, 0x7f, 0xce, 0x76, 0x0d, 0x54, 0xda, 0xd8, 0xfa, 0xad, 0x9d, 0xaa, 0x14,
+	0xca, 0x04, 0x82, 0x16, 0x19, 0xf6, 0x56, 0x1c, 0x2b, 0x1d, 0x2a, 0x5b, 0x88, 0x2a, 0xea, 0x2e,
+	0x12, 0x8f, 0x7c, 0x1e, 0x7f, 0xc6, 0xda, 0x15, 0x94, 0xa9, 0x2a, 0x65, 0xec, 0x67, 0x7f, 0xe7,
+	0xee, 0xd9, 0x8f, 0x76, 0x3c, 0xef, 0x96, 0x7c, 0xc0, 0xde, 0x73, 0xc3, 0x2f, 0xca, 0x24, 0xd3,
+	0x26, 0xce, 0x40, 0xc9, 0xcc, 0x06, 0x6d, 0xf7, 0x03, 0x46, 0xa1, 0x6f, 0x28, 0x72, 0x4c, 0x01,
+	0xfe, 0x39, 0xe3, 0xd7, 0x78, 0xd7, 0x9c, 0x0e, 0xe1, 0xdd, 0x2b, 0xb8, 0xab, 0xfe, 0x1f, 0xec,
+	0x03, 0xb4, 0xc2, 0x9d, 0xcf, 0x80, 0x54, 0xf4, 0xe8, 0x69, 0x0c, 0xbb, 0x74, 0xe1, 0x47, 0xb7,
+	0x5c, 0xd8, 0xf3, 0xd1, 0x15, 0x3c, 0x7a, 0x1f, 0x6f, 0x51, 0x71, 0x78, 0xf2, 0xe6, 0xac, 0xd7,
+	0x78, 0x7b, 0xd6, 0x6b, 0xfc, 0x77, 0xd6, 0x6b, 0xfc, 0x75, 0xde, 0x5b, 0x7b, 0x7b, 0xde, 0x5b,
+	0xfb, 0xe7, 0xbc, 0xb7, 0xf6, 0x7b, 0x28, 0x95, 0xcd, 0xa6, 0xe3, 0x41, 0xa2, 0x8b, 0xf0, 0x89,
+	0xcc, 0xc5, 0x18, 0xc3, 0x27, 0xf2, 0x8b, 0x24, 0x13, 0xaa, 0x0c, 0x67, 0xd7, 0xff, 0x06, 0xec,
+	0xbc, 0x02, 0x1c, 0x6f, 0xd2, 0x7f, 0xc0, 0x97, 0xff, 0x0f, 0x00, 0xfd, 0xf7, 0xe3, 0x4b, 0xc6,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -234,6 +402,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QuorumStrategy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QuorumStrategy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MissedSignaturesThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedSignaturesThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RetainedEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetainedEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExitDelayEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExitDelayEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EncodedSlices != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.EncodedSlices))
 		i--
@@ -282,6 +470,147 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StandingRegistrations) > 0 {
+		for iNdEx := len(m.StandingRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StandingRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.EpochAnchorEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochAnchorEpoch))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.EpochAnchorHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochAnchorHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PendingParams != nil {
+		{
+			size, err := m.PendingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ReportedEvidence) > 0 {
+		for iNdEx := len(m.ReportedEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportedEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MissedSignatures) > 0 {
+		for iNdEx := len(m.MissedSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SignerFlags) > 0 {
+		for iNdEx := len(m.SignerFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BallotsByEpoch) > 0 {
+		for iNdEx := len(m.BallotsByEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BallotsByEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.EarliestEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EarliestEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.KeyHistory) > 0 {
+		for iNdEx := len(m.KeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SignerExits) > 0 {
+		for iNdEx := len(m.SignerExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.QuorumsByEpoch) > 0 {
 		for iNdEx := len(m.QuorumsByEpoch) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -360,6 +689,18 @@ func (m *Params) Size() (n int) {
 	if m.EncodedSlices != 0 {
 		n += 1 + sovGenesis(uint64(m.EncodedSlices))
 	}
+	if m.ExitDelayEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.ExitDelayEpochs))
+	}
+	if m.RetainedEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.RetainedEpochs))
+	}
+	if m.MissedSignaturesThreshold != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedSignaturesThreshold))
+	}
+	if m.QuorumStrategy != 0 {
+		n += 1 + sovGenesis(uint64(m.QuorumStrategy))
+	}
 	return n
 }
 
@@ -386,6 +727,67 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SignerExits) > 0 {
+		for _, e := range m.SignerExits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyHistory) > 0 {
+		for _, e := range m.KeyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EarliestEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.EarliestEpoch))
+	}
+	if len(m.BallotsByEpoch) > 0 {
+		for _, e := range m.BallotsByEpoch {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignerFlags) > 0 {
+		for _, e := range m.SignerFlags {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedSignatures) > 0 {
+		for _, e := range m.MissedSignatures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReportedEvidence) > 0 {
+		for _, e := range m.ReportedEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingParams != nil {
+		l = m.PendingParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochAnchorHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochAnchorHeight))
+	}
+	if m.EpochAnchorEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochAnchorEpoch))
+	}
+	if len(m.StandingRegistrations) > 0 {
+		for _, e := range m.StandingRegistrations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,33 +921,109 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitDelayEpochs", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			m.ExitDelayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitDelayEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedEpochs", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+			m.RetainedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetainedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignaturesThreshold", wireType)
+			}
+			m.MissedSignaturesThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSignaturesThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumStrategy", wireType)
+			}
+			m.QuorumStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumStrategy |= QuorumStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
@@ -689,6 +1167,371 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerExits = append(m.SignerExits, &SignerExit{})
+			if err := m.SignerExits[len(m.SignerExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotations = append(m.KeyRotations, &SignerKeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHistory = append(m.KeyHistory, &SignerKeyRecord{})
+			if err := m.KeyHistory[len(m.KeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestEpoch", wireType)
+			}
+			m.EarliestEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotsByEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotsByEpoch = append(m.BallotsByEpoch, &SignerBallots{})
+			if err := m.BallotsByEpoch[len(m.BallotsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerFlags = append(m.SignerFlags, &SignerFlag{})
+			if err := m.SignerFlags[len(m.SignerFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedSignatures = append(m.MissedSignatures, &MissedSignatures{})
+			if err := m.MissedSignatures[len(m.MissedSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportedEvidence = append(m.ReportedEvidence, &SignedBitmap{})
+			if err := m.ReportedEvidence[len(m.ReportedEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingParams == nil {
+				m.PendingParams = &Params{}
+			}
+			if err := m.PendingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAnchorHeight", wireType)
+			}
+			m.EpochAnchorHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochAnchorHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAnchorEpoch", wireType)
+			}
+			m.EpochAnchorEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochAnchorEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandingRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandingRegistrations = append(m.StandingRegistrations, &StandingRegistration{})
+			if err := m.StandingRegistrations[len(m.StandingRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
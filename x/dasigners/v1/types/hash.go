@@ -27,6 +27,22 @@ func PubkeyRegistrationHash(operatorAddress common.Address, chainId *big.Int) *b
 	return bn254util.MapToCurve(msgHash32)
 }
 
+func PubkeyRotationHash(operatorAddress common.Address, chainId *big.Int) *bn254.G1Affine {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, operatorAddress.Bytes()...)
+	// make sure chainId is 32 bytes
+	toHash = append(toHash, common.LeftPadBytes(chainId.Bytes(), 32)...)
+	toHash = append(toHash, []byte("0G_BN254_Pubkey_Rotation")...)
+
+	msgHash := crypto.Keccak256(toHash)
+	// convert to [32]byte
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+
+	// hash to G1
+	return bn254util.MapToCurve(msgHash32)
+}
+
 func EpochRegistrationHash(operatorAddress common.Address, epoch uint64, chainId *big.Int) *bn254.G1Affine {
 	toHash := make([]byte, 0)
 	toHash = append(toHash, operatorAddress.Bytes()...)
@@ -41,3 +57,25 @@ func EpochRegistrationHash(operatorAddress common.Address, epoch uint64, chainId
 	// hash to G1
 	return bn254util.MapToCurve(msgHash32)
 }
+
+func StandingRegistrationHash(operatorAddress common.Address, startEpoch uint64, chainId *big.Int) *bn254.G1Affine {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, operatorAddress.Bytes()...)
+	toHash = append(toHash, sdk.Uint64ToBigEndian(startEpoch)...)
+	toHash = append(toHash, common.LeftPadBytes(chainId.Bytes(), 32)...)
+	toHash = append(toHash, []byte("0G_BN254_Standing_Registration")...)
+
+	msgHash := crypto.Keccak256(toHash)
+	// convert to [32]byte
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+
+	// hash to G1
+	return bn254util.MapToCurve(msgHash32)
+}
+
+// StandingBallotSeed derives the registration seed of an epoch from a standing registration
+// signature, in place of the signature over the epoch registration hash.
+func StandingBallotSeed(signature []byte, epoch uint64) []byte {
+	return crypto.Keccak256(signature, sdk.Uint64ToBigEndian(epoch))
+}
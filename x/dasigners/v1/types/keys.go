@@ -23,10 +23,31 @@ var (
 	EpochQuorumsKeyPrefix = []byte{0x01}
 	RegistrationKeyPrefix = []byte{0x02}
 	QuorumCountKeyPrefix  = []byte{0x03}
+	SignerExitKeyPrefix   = []byte{0x04}
+	ExitQueueKeyPrefix    = []byte{0x07}
+
+	KeyRotationKeyPrefix      = []byte{0x08}
+	KeyRotationQueueKeyPrefix = []byte{0x09}
+	SignerKeyHistoryKeyPrefix = []byte{0x0a}
+	EpochBallotKeyPrefix      = []byte{0x0c}
+	RetiredKeyQueueKeyPrefix  = []byte{0x16}
+
+	SignerDelegationKeyPrefix = []byte{0x0d}
+	ValidatorSignerKeyPrefix  = []byte{0x0e}
+	SignerBondedKeyPrefix     = []byte{0x0f}
+	SignerFlagKeyPrefix       = []byte{0x10}
+
+	MissedSignaturesKeyPrefix = []byte{0x11}
+	ReportedEvidenceKeyPrefix = []byte{0x12}
+
+	StandingRegistrationKeyPrefix = []byte{0x15}
 
 	// keys
-	ParamsKey      = []byte{0x05}
-	EpochNumberKey = []byte{0x06}
+	ParamsKey        = []byte{0x05}
+	EpochNumberKey   = []byte{0x06}
+	EarliestEpochKey = []byte{0x0b}
+	PendingParamsKey = []byte{0x13}
+	EpochAnchorKey   = []byte{0x14}
 )
 
 func GetSignerKeyFromAccount(account string) ([]byte, error) {
@@ -50,3 +71,121 @@ func GetEpochRegistrationKeyPrefix(epoch uint64) []byte {
 func GetRegistrationKey(account string) ([]byte, error) {
 	return hex.DecodeString(account)
 }
+
+func GetSignerExitKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetStandingRegistrationKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetExitQueueKeyPrefix(epoch uint64) []byte {
+	return append(ExitQueueKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetExitQueueKey(epoch uint64, account string) ([]byte, error) {
+	key, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetExitQueueKeyPrefix(epoch), key...), nil
+}
+
+func GetKeyRotationKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetKeyRotationQueueKeyPrefix(epoch uint64) []byte {
+	return append(KeyRotationQueueKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetKeyRotationQueueKey(epoch uint64, account string) ([]byte, error) {
+	key, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetKeyRotationQueueKeyPrefix(epoch), key...), nil
+}
+
+func GetSignerKeyHistoryPrefix(account string) ([]byte, error) {
+	key, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(SignerKeyHistoryKeyPrefix, key...), nil
+}
+
+func GetSignerKeyHistoryKey(account string, retiredEpoch uint64) ([]byte, error) {
+	prefix, err := GetSignerKeyHistoryPrefix(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(prefix, sdk.Uint64ToBigEndian(retiredEpoch)...), nil
+}
+
+func GetRetiredKeyQueueKeyPrefix(retiredEpoch uint64) []byte {
+	return append(RetiredKeyQueueKeyPrefix, sdk.Uint64ToBigEndian(retiredEpoch)...)
+}
+
+func GetRetiredKeyQueueKey(retiredEpoch uint64, account string) ([]byte, error) {
+	key, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetRetiredKeyQueueKeyPrefix(retiredEpoch), key...), nil
+}
+
+func GetEpochBallotKeyPrefix(epoch uint64) []byte {
+	return append(EpochBallotKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetEpochBallotKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetSignerDelegationPrefix(signer sdk.AccAddress) []byte {
+	return append(SignerDelegationKeyPrefix, signer.Bytes()...)
+}
+
+func GetSignerDelegationKey(signer sdk.AccAddress, validator sdk.ValAddress) []byte {
+	return append(GetSignerDelegationPrefix(signer), validator.Bytes()...)
+}
+
+func GetValidatorSignerPrefix(validator sdk.ValAddress) []byte {
+	return append(ValidatorSignerKeyPrefix, validator.Bytes()...)
+}
+
+func GetValidatorSignerKey(validator sdk.ValAddress, signer sdk.AccAddress) []byte {
+	return append(GetValidatorSignerPrefix(validator), signer.Bytes()...)
+}
+
+func GetSignerBondedKey(signer sdk.AccAddress) []byte {
+	return append(SignerBondedKeyPrefix, signer.Bytes()...)
+}
+
+func GetSignerFlagKeyPrefix(epoch uint64) []byte {
+	return append(SignerFlagKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetSignerFlagKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetMissedSignaturesKeyPrefix(epoch uint64) []byte {
+	return append(MissedSignaturesKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetMissedSignaturesKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetReportedEvidenceKeyPrefix(epoch uint64) []byte {
+	return append(ReportedEvidenceKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetReportedEvidenceKey returns the key of a signed bitmap under its epoch prefix, the signature
+// itself is left out so that the same attestation cannot be reported twice.
+func GetReportedEvidenceKey(quorumId uint64, messageHash []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(quorumId), messageHash...)
+}
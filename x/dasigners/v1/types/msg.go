@@ -9,7 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _, _, _ sdk.Msg = &MsgRegisterSigner{}, &MsgUpdateSocket{}, &MsgRegisterNextEpoch{}
+var _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgRegisterSigner{}, &MsgUpdateSocket{}, &MsgRegisterNextEpoch{}, &MsgDeregisterSigner{}, &MsgRotateSignerKey{}, &MsgReportMissedSignatures{}, &MsgUpdateParams{}, &MsgRegisterStanding{}, &MsgCancelStanding{}
 
 // GetSigners returns the expected signers for a MsgRegister message.
 func (msg *MsgRegisterSigner) GetSigners() []sdk.AccAddress {
@@ -94,3 +94,167 @@ func (msg *MsgRegisterNextEpoch) ValidateBasic() error {
 func (msg MsgRegisterNextEpoch) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// GetSigners returns the expected signers for a MsgDeregisterSigner message.
+func (msg *MsgDeregisterSigner) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgDeregisterSigner) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeregisterSigner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotateSignerKey message.
+func (msg *MsgRotateSignerKey) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgRotateSignerKey) ValidateBasic() error {
+	signer := Signer{
+		Account:  msg.Account,
+		PubkeyG1: msg.PubkeyG1,
+		PubkeyG2: msg.PubkeyG2,
+	}
+	if err := signer.Validate(); err != nil {
+		return err
+	}
+	if len(msg.Signature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRotateSignerKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgReportMissedSignatures message.
+func (msg *MsgReportMissedSignatures) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Reporter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgReportMissedSignatures) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Reporter); err != nil {
+		return err
+	}
+	if len(msg.Evidence) == 0 {
+		return fmt.Errorf("empty evidence")
+	}
+	for _, evidence := range msg.Evidence {
+		if err := evidence.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgReportMissedSignatures) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	return msg.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterStanding message.
+func (msg *MsgRegisterStanding) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgRegisterStanding) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	if len(msg.Signature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRegisterStanding) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCancelStanding message.
+func (msg *MsgCancelStanding) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgCancelStanding) ValidateBasic() error {
+	return ValidateHexAddress(msg.Account)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCancelStanding) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,26 @@
+package types
+
+import "fmt"
+
+// Validate performs basic validation of the module parameters.
+func (p Params) Validate() error {
+	if p.TokensPerVote == 0 {
+		return fmt.Errorf("tokens per vote must be positive")
+	}
+	if p.MaxVotesPerSigner == 0 {
+		return fmt.Errorf("max votes per signer must be positive")
+	}
+	if p.MaxQuorums == 0 {
+		return fmt.Errorf("max quorums must be positive")
+	}
+	if p.EpochBlocks == 0 {
+		return fmt.Errorf("epoch blocks must be positive")
+	}
+	if p.EncodedSlices == 0 {
+		return fmt.Errorf("encoded slices must be positive")
+	}
+	if _, ok := QuorumStrategy_name[int32(p.QuorumStrategy)]; !ok {
+		return fmt.Errorf("unknown quorum strategy %d", p.QuorumStrategy)
+	}
+	return nil
+}
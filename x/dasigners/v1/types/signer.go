@@ -32,6 +32,19 @@ func (s *Signer) Validate() error {
 	return nil
 }
 
+func (b *SignedBitmap) Validate() error {
+	if len(b.QuorumBitmap) == 0 {
+		return fmt.Errorf("empty quorum bitmap")
+	}
+	if len(b.MessageHash) != 32 {
+		return fmt.Errorf("invalid message hash length")
+	}
+	if len(b.Signature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid signature length")
+	}
+	return nil
+}
+
 func (s *Signer) ValidateSignature(hash *bn254.G1Affine, signature *bn254.G1Affine) bool {
 	pubkeyG1 := bn254util.DeserializeG1(s.PubkeyG1)
 	pubkeyG2 := bn254util.DeserializeG2(s.PubkeyG2)
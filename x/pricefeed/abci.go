@@ -21,8 +21,11 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) && !errors.Is(err, types.ErrMarketHalted) {
 			panic(err)
 		}
+
+		// Record the price accumulator used for time weighted average prices.
+		k.UpdatePriceSnapshot(ctx, market.MarketID)
 	}
 }
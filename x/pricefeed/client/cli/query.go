@@ -2,11 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/0glabs/0g-chain/x/pricefeed/types"
 )
@@ -29,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdOracles(),
 		GetCmdMarkets(),
 		GetCmdQueryParams(),
+		GetCmdTWAP(),
 	}
 
 	for _, cmd := range cmds {
@@ -202,3 +206,41 @@ func GetCmdQueryParams() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdTWAP queries the time weighted average price of an asset
+func GetCmdTWAP() *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [window]",
+		Short:   "get the time weighted average price for the input market over a trailing window",
+		Example: fmt.Sprintf("%s query %s twap btc:usd 1h", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			if window < time.Second {
+				return fmt.Errorf("window must be at least one second, got %s", window)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryTWAPRequest{
+				MarketId:      args[0],
+				WindowSeconds: uint64(window / time.Second),
+			}
+
+			res, err := queryClient.TWAP(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
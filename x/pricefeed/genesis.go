@@ -1,6 +1,8 @@
 package pricefeed
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0glabs/0g-chain/x/pricefeed/keeper"
@@ -21,11 +23,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			}
 		}
 	}
+	// Restore the circuit breaker state the current prices are checked against
+	for _, rp := range gs.ReferencePrices {
+		k.SetReferencePrice(ctx, rp)
+	}
+	for _, hm := range gs.HaltedMarkets {
+		k.SetHaltedMarket(ctx, hm)
+	}
 	params := k.GetParams(ctx)
 
-	// Set the current price (if any) based on what's now in the store
+	// Set the current price (if any) based on what's now in the store, halted markets keep their
+	// count of updates that passed the checks until the next update
 	for _, market := range params.Markets {
-		if !market.Active {
+		if !market.Active || k.IsMarketHalted(ctx, market.MarketID) {
 			continue
 		}
 		rps := k.GetRawPrices(ctx, market.MarketID)
@@ -34,7 +44,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			continue
 		}
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil {
+		if err != nil && !errors.Is(err, types.ErrMarketHalted) {
 			panic(err)
 		}
 	}
@@ -51,5 +61,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, k.GetReferencePrices(ctx), k.GetHaltedMarkets(ctx))
 }
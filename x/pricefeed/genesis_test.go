@@ -11,6 +11,7 @@ import (
 	"github.com/0glabs/0g-chain/app"
 	"github.com/0glabs/0g-chain/x/pricefeed"
 	"github.com/0glabs/0g-chain/x/pricefeed/keeper"
+	"github.com/0glabs/0g-chain/x/pricefeed/types"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -57,6 +58,34 @@ func (suite *GenesisTestSuite) TestInitExportGenState() {
 	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
 }
 
+func (suite *GenesisTestSuite) TestInitExportGenState_HaltedMarket() {
+	gs := NewPricefeedGen()
+	for i := range gs.Params.Markets {
+		gs.Params.Markets[i].MaxPriceDeviationBps = 500
+		gs.Params.Markets[i].ResumeAfterUpdates = 3
+	}
+	// the market was halted by a move the posted price still reflects
+	gs.ReferencePrices[0].Price = sdk.MustNewDecFromStr("4000.00")
+	gs.HaltedMarkets = []types.HaltedMarket{{MarketID: "btc:usd", CleanUpdates: 1}}
+
+	suite.NotPanics(func() {
+		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
+	})
+
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "btc:usd")
+	suite.ErrorIs(err, types.ErrMarketHalted)
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, "xrp:usd")
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.25"), price.Price)
+
+	exportedGs := pricefeed.ExportGenesis(suite.ctx, suite.keeper)
+	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
+
+	// the next update is still checked against the reference price
+	suite.ErrorIs(suite.keeper.SetCurrentPrices(suite.ctx, "btc:usd"), types.ErrMarketHalted)
+	suite.Equal(types.HaltedMarkets{{MarketID: "btc:usd", CleanUpdates: 0}}, suite.keeper.GetHaltedMarkets(suite.ctx))
+}
+
 func (suite *GenesisTestSuite) TestParamPricesGenState() {
 	gs := NewPricefeedGen()
 
@@ -31,6 +31,10 @@ func NewPricefeedGen() types.GenesisState {
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
 		},
+		ReferencePrices: []types.CurrentPrice{
+			types.NewCurrentPrice("btc:usd", sdk.MustNewDecFromStr("8000.00")),
+			types.NewCurrentPrice("xrp:usd", sdk.MustNewDecFromStr("0.25")),
+		},
 	}
 }
 
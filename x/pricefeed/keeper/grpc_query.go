@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -120,3 +121,27 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+// TWAP implements the gRPC service handler for querying the time weighted average price of a market.
+func (s queryServer) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	if req.WindowSeconds == 0 || req.WindowSeconds > uint64(types.MaxTWAPWindow/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be between 1 and %d seconds", uint64(types.MaxTWAPWindow/time.Second))
+	}
+
+	price, err := s.keeper.GetTWAP(ctx, req.MarketId, time.Duration(req.WindowSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{
+		MarketID:      req.MarketId,
+		WindowSeconds: req.WindowSeconds,
+		Price:         price,
+	}, nil
+}
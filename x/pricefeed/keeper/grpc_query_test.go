@@ -242,6 +242,32 @@ func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	suite.NoError(res.Markets[1].VerboseEqual(params.Markets[1].ToMarketResponse()))
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcTWAP() {
+	suite.setTestParams()
+	suite.setTstPrice()
+	suite.keeper.UpdatePriceSnapshot(suite.ctx, "tstusd")
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	suite.keeper.UpdatePriceSnapshot(suite.ctx, "tstusd")
+
+	res, err := suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd", WindowSeconds: 60})
+	suite.NoError(err)
+	suite.Equal(&types.QueryTWAPResponse{
+		MarketID:      "tstusd",
+		WindowSeconds: 60,
+		Price:         sdk.MustNewDecFromStr("0.34"),
+	}, res)
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd", WindowSeconds: 61})
+	suite.ErrorIs(err, types.ErrInsufficientPriceHistory)
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd"})
+	suite.Equal("rpc error: code = InvalidArgument desc = window must be between 1 and 86400 seconds", err.Error())
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "invalid", WindowSeconds: 60})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
 func (suite *grpcQueryTestSuite) setTstPrice() {
 	_, err := suite.keeper.SetPrice(
 		suite.ctx, suite.addrs[0], "tstusd",
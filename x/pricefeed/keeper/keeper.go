@@ -79,26 +79,38 @@ func (k Keeper) SetPrice(
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs.
+//
+// The market is halted, and ErrMarketHalted returned, when fewer than the market's minimum number of
+// oracles have an unexpired price or when the median moved more than the market's maximum deviation
+// from the reference price, the last median that passed the checks. A median failing the checks is
+// not stored, and the reference price outlives the current price when all the posted prices expire,
+// so a halted market resumes only once the market's number of consecutive updates pass both checks. A
+// lasting move beyond the maximum deviation keeps the market halted until governance updates the
+// market params.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
 	// store current price
 	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
+	prevPrice, err := k.getCurrentPrice(ctx, marketID)
 	if err != nil {
 		validPrevPrice = false
 	}
 
+	referencePrice, validReferencePrice := k.GetReferencePrice(ctx, marketID)
+
 	prices := k.GetRawPrices(ctx, marketID)
 
 	var notExpiredPrices []types.CurrentPrice
+	liveOracles := make(map[string]bool)
 	// filter out expired prices
 	for _, v := range prices {
 		if v.Expiry.After(ctx.BlockTime()) {
 			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+			liveOracles[v.OracleAddress.String()] = true
 		}
 	}
 
@@ -113,6 +125,13 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 
 	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
 
+	switch {
+	case len(liveOracles) < int(market.MinOracles):
+		return k.haltMarket(ctx, marketID, types.AttributeValueReasonInsufficientOracles, len(liveOracles))
+	case validReferencePrice && market.ExceedsMaxDeviation(referencePrice.Price, medianPrice):
+		return k.haltMarket(ctx, marketID, types.AttributeValueReasonPriceDeviation, len(liveOracles))
+	}
+
 	// check case that market price was not set in genesis
 	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
 		// only emit event if price has changed
@@ -127,10 +146,100 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 
 	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.SetReferencePrice(ctx, currentPrice)
+
+	if k.IsMarketHalted(ctx, marketID) {
+		cleanUpdates := k.getCleanUpdates(ctx, marketID) + 1
+		if cleanUpdates < uint64(market.ResumeAfterUpdates) {
+			k.SetHaltedMarket(ctx, types.HaltedMarket{MarketID: marketID, CleanUpdates: cleanUpdates})
+			return errorsmod.Wrapf(types.ErrMarketHalted, "%s: %d of %d updates passed", marketID, cleanUpdates, market.ResumeAfterUpdates)
+		}
+		ctx.KVStore(k.key).Delete(types.HaltedMarketKey(marketID))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketResumed,
+				sdk.NewAttribute(types.AttributeMarketID, marketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+			),
+		)
+	}
 
 	return nil
 }
 
+// haltMarket flags a market as halted, resetting the count of updates that passed the checks since it
+// was halted, and emits an event with the reason
+func (k Keeper) haltMarket(ctx sdk.Context, marketID, reason string, liveOracles int) error {
+	k.SetHaltedMarket(ctx, types.HaltedMarket{MarketID: marketID, CleanUpdates: 0})
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketHalted,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeReason, reason),
+			sdk.NewAttribute(types.AttributeLiveOracles, fmt.Sprintf("%d", liveOracles)),
+		),
+	)
+	return errorsmod.Wrapf(types.ErrMarketHalted, "%s: %s", marketID, reason)
+}
+
+// getCleanUpdates returns how many consecutive updates of a halted market passed the checks
+func (k Keeper) getCleanUpdates(ctx sdk.Context, marketID string) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.key).Get(types.HaltedMarketKey(marketID)))
+}
+
+// IsMarketHalted returns true if the market was halted by the deviation or oracle count checks
+func (k Keeper) IsMarketHalted(ctx sdk.Context, marketID string) bool {
+	return ctx.KVStore(k.key).Has(types.HaltedMarketKey(marketID))
+}
+
+// SetHaltedMarket flags a market as halted, with the count of updates that passed the checks since
+func (k Keeper) SetHaltedMarket(ctx sdk.Context, halted types.HaltedMarket) {
+	ctx.KVStore(k.key).Set(types.HaltedMarketKey(halted.MarketID), sdk.Uint64ToBigEndian(halted.CleanUpdates))
+}
+
+// GetHaltedMarkets returns the markets halted by the circuit breaker
+func (k Keeper) GetHaltedMarkets(ctx sdk.Context) types.HaltedMarkets {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.HaltedMarketPrefix)
+	defer iterator.Close()
+	halted := types.HaltedMarkets{}
+	for ; iterator.Valid(); iterator.Next() {
+		halted = append(halted, types.HaltedMarket{
+			MarketID:     string(iterator.Key()[len(types.HaltedMarketPrefix):]),
+			CleanUpdates: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return halted
+}
+
+// GetReferencePrice returns the last price of a market that passed the circuit breaker checks
+func (k Keeper) GetReferencePrice(ctx sdk.Context, marketID string) (types.CurrentPrice, bool) {
+	bz := ctx.KVStore(k.key).Get(types.ReferencePriceKey(marketID))
+	if bz == nil {
+		return types.CurrentPrice{}, false
+	}
+	var price types.CurrentPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// SetReferencePrice sets the price the next medians of a market are checked against
+func (k Keeper) SetReferencePrice(ctx sdk.Context, price types.CurrentPrice) {
+	ctx.KVStore(k.key).Set(types.ReferencePriceKey(price.MarketID), k.cdc.MustMarshal(&price))
+}
+
+// GetReferencePrices returns the reference prices of all markets
+func (k Keeper) GetReferencePrices(ctx sdk.Context) types.CurrentPrices {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.ReferencePricePrefix)
+	defer iterator.Close()
+	prices := types.CurrentPrices{}
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.CurrentPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+	return prices
+}
+
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
@@ -163,8 +272,16 @@ func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current median price of all oracles for a specific market. It returns
+// ErrMarketHalted while the market is halted so callers do not act on a suspicious price.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	if k.IsMarketHalted(ctx, marketID) {
+		return types.CurrentPrice{}, errorsmod.Wrap(types.ErrMarketHalted, marketID)
+	}
+	return k.getCurrentPrice(ctx, marketID)
+}
+
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
@@ -236,3 +236,195 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
+
+func TestKeeper_SetCurrentPrices_PriceDeviation(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, MaxPriceDeviationBps: 1000},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	setPrice := func(price string) error {
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		return keeper.SetCurrentPrices(ctx, "tstusd")
+	}
+
+	require.NoError(t, setPrice("1.00"))
+	// a 10% move is within the allowed deviation
+	require.NoError(t, setPrice("1.10"))
+	require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+
+	// a 20% move trips the circuit breaker
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err := setPrice("1.32")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+	require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	require.NoError(t, app.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketHalted,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeReason, types.AttributeValueReasonPriceDeviation),
+		sdk.NewAttribute(types.AttributeLiveOracles, "1"),
+	)))
+
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+
+	// a sustained jump is still compared against the last price that passed the checks
+	for i := 0; i < 3; i += 1 {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+		require.ErrorIs(t, setPrice("1.30"), types.ErrMarketHalted)
+		require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	}
+
+	// the market resumes once the price is within the allowed deviation of the last good price again
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, setPrice("1.15"))
+	require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	require.NoError(t, app.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketResumed,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.MustNewDecFromStr("1.15").String()),
+	)))
+
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.15"), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_ResumeAfterUpdates(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, MaxPriceDeviationBps: 1000, ResumeAfterUpdates: 3}
+	keeper.SetParams(ctx, types.Params{Markets: []types.Market{market}})
+
+	setPrice := func(price string) error {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		return keeper.SetCurrentPrices(ctx, "tstusd")
+	}
+
+	require.NoError(t, setPrice("1.00"))
+	require.ErrorIs(t, setPrice("2.00"), types.ErrMarketHalted)
+
+	// the market stays halted until three consecutive updates pass, a failing update starts over
+	require.ErrorIs(t, setPrice("1.01"), types.ErrMarketHalted)
+	require.ErrorIs(t, setPrice("1.02"), types.ErrMarketHalted)
+	require.ErrorIs(t, setPrice("2.00"), types.ErrMarketHalted)
+	require.ErrorIs(t, setPrice("1.03"), types.ErrMarketHalted)
+	require.ErrorIs(t, setPrice("1.04"), types.ErrMarketHalted)
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+	require.NoError(t, setPrice("1.05"))
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.05"), price.Price)
+
+	// a lasting move beyond the maximum deviation resumes through governance updating the market
+	for i := 0; i < 5; i += 1 {
+		require.ErrorIs(t, setPrice("2.00"), types.ErrMarketHalted)
+	}
+	market.MaxPriceDeviationBps = 0
+	keeper.SetParams(ctx, types.Params{Markets: []types.Market{market}})
+	for i := 0; i < 3; i += 1 {
+		require.Equal(t, i == 2, setPrice("2.00") == nil)
+	}
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.00"), price.Price)
+}
+
+func TestKeeper_SetCurrentPrices_HaltedThroughExpiry(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, MaxPriceDeviationBps: 1000}
+	keeper.SetParams(ctx, types.Params{Markets: []types.Market{market}})
+
+	setPrice := func(price string) error {
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		return keeper.SetCurrentPrices(ctx, "tstusd")
+	}
+
+	require.NoError(t, setPrice("1.00"))
+	require.ErrorIs(t, setPrice("2.00"), types.ErrMarketHalted)
+
+	// letting all the posted prices expire clears the current price, not the reference price
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 2))
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	reference, found := keeper.GetReferencePrice(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("1.00"), reference.Price)
+
+	// so the next price is still checked against it and the market stays halted
+	require.ErrorIs(t, setPrice("2.00"), types.ErrMarketHalted)
+	require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+
+	require.NoError(t, setPrice("1.05"))
+	reference, found = keeper.GetReferencePrice(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("1.05"), reference.Price)
+}
+
+func TestKeeper_SetCurrentPrices_MinOracles(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, MinOracles: 2},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.33"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("0.35"), ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+
+	// expire the second oracle's price, leaving a single live oracle
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute * 2))
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+	require.NoError(t, app.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketHalted,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeReason, types.AttributeValueReasonInsufficientOracles),
+		sdk.NewAttribute(types.AttributeLiveOracles, "1"),
+	)))
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrMarketHalted)
+
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("0.34"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.335"), price.Price)
+}
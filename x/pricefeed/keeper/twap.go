@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/pricefeed/types"
+)
+
+// UpdatePriceSnapshot accumulates the previous current price of a market over the time elapsed since the
+// last snapshot and records a new snapshot with the current price. Halted markets and markets without a
+// valid price are recorded with a zero price, which excludes them from time weighted averages.
+func (k Keeper) UpdatePriceSnapshot(ctx sdk.Context, marketID string) {
+	price := sdk.ZeroDec()
+	if currentPrice, err := k.GetCurrentPrice(ctx, marketID); err == nil {
+		price = currentPrice.Price
+	}
+
+	cumulativePrice, pricedSeconds := sdk.ZeroDec(), sdk.ZeroDec()
+	if last, found := k.getSnapshotAtOrBefore(ctx, marketID, ctx.BlockTime()); found {
+		if !ctx.BlockTime().After(last.Time) {
+			return
+		}
+		cumulativePrice, pricedSeconds = last.Accumulate(ctx.BlockTime())
+	}
+
+	snapshot := types.NewPriceSnapshot(marketID, ctx.BlockTime(), price, cumulativePrice, pricedSeconds)
+	ctx.KVStore(k.key).Set(types.PriceSnapshotKey(marketID, snapshot.Time), k.cdc.MustMarshal(&snapshot))
+
+	k.prunePriceSnapshots(ctx, marketID, ctx.BlockTime().Add(-types.MaxTWAPWindow))
+}
+
+// GetTWAP returns the time weighted average price of a market over the window ending at the current block
+// time. Periods during which the market was halted or had no valid price are left out of the average.
+func (k Keeper) GetTWAP(ctx sdk.Context, marketID string, window time.Duration) (sdk.Dec, error) {
+	if _, found := k.GetMarket(ctx, marketID); !found {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if window <= 0 || window > types.MaxTWAPWindow {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidTWAPWindow, "%s must be positive and at most %s", window, types.MaxTWAPWindow)
+	}
+
+	end, found := k.getSnapshotAtOrBefore(ctx, marketID, ctx.BlockTime())
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrInsufficientPriceHistory, marketID)
+	}
+	startTime := ctx.BlockTime().Add(-window)
+	start, found := k.getSnapshotAtOrBefore(ctx, marketID, startTime)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInsufficientPriceHistory, "%s: no price recorded before %s", marketID, startTime)
+	}
+
+	endCumulativePrice, endPricedSeconds := end.Accumulate(ctx.BlockTime())
+	startCumulativePrice, startPricedSeconds := start.Accumulate(startTime)
+
+	pricedSeconds := endPricedSeconds.Sub(startPricedSeconds)
+	if !pricedSeconds.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrNoValidPrice, marketID)
+	}
+	return endCumulativePrice.Sub(startCumulativePrice).Quo(pricedSeconds), nil
+}
+
+// getSnapshotAtOrBefore returns the latest price snapshot of a market recorded at or before t
+func (k Keeper) getSnapshotAtOrBefore(ctx sdk.Context, marketID string, t time.Time) (types.PriceSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	iterator := store.ReverseIterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(t)))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.PriceSnapshot{}, false
+	}
+	var snapshot types.PriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// prunePriceSnapshots deletes the snapshots of a market recorded before cutoff, except the latest of them
+// which is still needed to compute averages over windows starting at cutoff.
+func (k Keeper) prunePriceSnapshots(ctx sdk.Context, marketID string, cutoff time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	iterator := store.ReverseIterator(nil, sdk.FormatTimeBytes(cutoff))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return
+	}
+	var keys [][]byte
+	for iterator.Next(); iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetPriceSnapshots returns all price snapshots of a market, oldest first
+func (k Keeper) GetPriceSnapshots(ctx sdk.Context, marketID string) []types.PriceSnapshot {
+	var snapshots []types.PriceSnapshot
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
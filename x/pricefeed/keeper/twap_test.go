@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/0glabs/0g-chain/app"
+	"github.com/0glabs/0g-chain/x/pricefeed"
+	"github.com/0glabs/0g-chain/x/pricefeed/types"
+)
+
+func TestKeeper_GetTWAP(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC().Truncate(time.Second)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	// endBlock posts a price valid for one minute, if any, and runs the end blocker at the given offset
+	endBlock := func(offset time.Duration, price string) {
+		ctx = ctx.WithBlockTime(start.Add(offset))
+		if price != "" {
+			_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Minute))
+			require.NoError(t, err)
+		}
+		pricefeed.EndBlocker(ctx, keeper)
+	}
+
+	endBlock(0, "1.00")
+	endBlock(60*time.Second, "2.00")
+	endBlock(120*time.Second, "2.00")
+
+	testCases := []struct {
+		name    string
+		window  time.Duration
+		expErr  error
+		expTWAP sdk.Dec
+	}{
+		{"full history", 120 * time.Second, nil, sdk.MustNewDecFromStr("1.5")},
+		{"window aligned with a snapshot", 60 * time.Second, nil, sdk.MustNewDecFromStr("2")},
+		{"window between snapshots", 90 * time.Second, nil, sdk.MustNewDecFromStr("1.666666666666666667")},
+		{"window older than history", 121 * time.Second, types.ErrInsufficientPriceHistory, sdk.Dec{}},
+		{"zero window", 0, types.ErrInvalidTWAPWindow, sdk.Dec{}},
+		{"window over maximum", types.MaxTWAPWindow + time.Second, types.ErrInvalidTWAPWindow, sdk.Dec{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := keeper.GetTWAP(ctx, "tstusd", tc.window)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTWAP, twap)
+		})
+	}
+
+	_, err := keeper.GetTWAP(ctx, "invalid", time.Minute)
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+
+	// periods without a valid price are left out of the average
+	endBlock(300*time.Second, "")
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	endBlock(360*time.Second, "4.00")
+	endBlock(420*time.Second, "4.00")
+
+	twap, err := keeper.GetTWAP(ctx, "tstusd", 420*time.Second)
+	require.NoError(t, err)
+	// 60s at 1, 240s at 2 then 60s at 4, the 60s without a valid price are skipped
+	require.Equal(t, sdk.MustNewDecFromStr("2.166666666666666667"), twap)
+
+	// a window starting while the market had no valid price only averages the priced time
+	twap, err = keeper.GetTWAP(ctx, "tstusd", 90*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), twap)
+}
+
+func TestKeeper_PrunePriceSnapshots(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Now().UTC().Truncate(time.Second)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(start)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), start.Add(48*time.Hour))
+	require.NoError(t, err)
+
+	for _, offset := range []time.Duration{0, time.Hour, 2 * time.Hour, types.MaxTWAPWindow + 90*time.Minute} {
+		ctx = ctx.WithBlockTime(start.Add(offset))
+		pricefeed.EndBlocker(ctx, keeper)
+	}
+
+	// the snapshot at one hour is the latest before the cutoff and is kept for full window averages
+	snapshots := keeper.GetPriceSnapshots(ctx, "tstusd")
+	require.Len(t, snapshots, 3)
+	require.Equal(t, start.Add(time.Hour), snapshots[0].Time)
+
+	twap, err := keeper.GetTWAP(ctx, "tstusd", types.MaxTWAPWindow)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), twap)
+}
@@ -17,4 +17,10 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrMarketHalted error for markets whose price failed the deviation or oracle count checks
+	ErrMarketHalted = errorsmod.Register(ModuleName, 8, "market is halted")
+	// ErrInvalidTWAPWindow error for time weighted average price windows out of range
+	ErrInvalidTWAPWindow = errorsmod.Register(ModuleName, 9, "invalid twap window")
+	// ErrInsufficientPriceHistory error for time weighted average prices over windows older than the recorded history
+	ErrInsufficientPriceHistory = errorsmod.Register(ModuleName, 10, "insufficient price history")
 )
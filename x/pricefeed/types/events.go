@@ -5,10 +5,17 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketHalted       = "market_halted"
+	EventTypeMarketResumed      = "market_resumed"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeReason        = "reason"
+	AttributeLiveOracles   = "live_oracles"
+
+	AttributeValueReasonPriceDeviation      = "price_deviation"
+	AttributeValueReasonInsufficientOracles = "insufficient_oracles"
 )
@@ -1,10 +1,18 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, rp []CurrentPrice, hm []HaltedMarket) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:          p,
+		PostedPrices:    pp,
+		ReferencePrices: rp,
+		HaltedMarkets:   hm,
 	}
 }
 
@@ -13,6 +21,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]CurrentPrice{},
+		[]HaltedMarket{},
 	)
 }
 
@@ -22,6 +32,30 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := gs.ReferencePrices.Validate(); err != nil {
+		return err
+	}
+	if err := gs.HaltedMarkets.Validate(); err != nil {
+		return err
+	}
 
 	return gs.PostedPrices.Validate()
 }
+
+// HaltedMarkets is a slice of HaltedMarket
+type HaltedMarkets []HaltedMarket
+
+// Validate checks there are no blank or duplicated markets.
+func (hms HaltedMarkets) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, hm := range hms {
+		if strings.TrimSpace(hm.MarketID) == "" {
+			return errors.New("market id cannot be blank")
+		}
+		if seenMarkets[hm.MarketID] {
+			return fmt.Errorf("duplicated halted market id %s", hm.MarketID)
+		}
+		seenMarkets[hm.MarketID] = true
+	}
+	return nil
+}
@@ -28,6 +28,11 @@ type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	// reference_prices defines the last price of each market that passed the circuit breaker checks, the
+	// next medians are checked against it
+	ReferencePrices CurrentPrices `protobuf:"bytes,3,rep,name=reference_prices,json=referencePrices,proto3,castrepeated=CurrentPrices" json:"reference_prices"`
+	// halted_markets defines the markets halted by the circuit breaker
+	HaltedMarkets HaltedMarkets `protobuf:"bytes,4,rep,name=halted_markets,json=haltedMarkets,proto3,castrepeated=HaltedMarkets" json:"halted_markets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,8 +82,77 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetReferencePrices() CurrentPrices {
+	if m != nil {
+		return m.ReferencePrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHaltedMarkets() HaltedMarkets {
+	if m != nil {
+		return m.HaltedMarkets
+	}
+	return nil
+}
+
+// HaltedMarket defines a market halted by the circuit breaker.
+type HaltedMarket struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// clean_updates defines how many consecutive updates passed the checks since the market was halted
+	CleanUpdates uint64 `protobuf:"varint,2,opt,name=clean_updates,json=cleanUpdates,proto3" json:"clean_updates,omitempty"`
+}
+
+func (m *HaltedMarket) Reset()         { *m = HaltedMarket{} }
+func (m *HaltedMarket) String() string { return proto.CompactTextString(m) }
+func (*HaltedMarket) ProtoMessage()    {}
+func (*HaltedMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_066844a93a71fcce, []int{1}
+}
+func (m *HaltedMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HaltedMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltedMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HaltedMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltedMarket.Merge(m, src)
+}
+func (m *HaltedMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *HaltedMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltedMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltedMarket proto.InternalMessageInfo
+
+func (m *HaltedMarket) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *HaltedMarket) GetCleanUpdates() uint64 {
+	if m != nil {
+		return m.CleanUpdates
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zgc.pricefeed.v1beta1.GenesisState")
+	proto.RegisterType((*HaltedMarket)(nil), "zgc.pricefeed.v1beta1.HaltedMarket")
 }
 
 func init() {
@@ -86,24 +160,32 @@ func init() {
 }
 
 var fileDescriptor_066844a93a71fcce = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xae, 0x4a, 0x4f, 0xd6,
-	0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0xad, 0x4a, 0x4f, 0xd6, 0x83, 0x2b, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x14, 0xb1, 0x9b, 0x58, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa2, 0xb4, 0x8a, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x43, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x35, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0xac, 0x1e, 0x56, 0x1b, 0xf5, 0x02, 0xc0, 0x8a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0x6a, 0x11, 0x8a, 0xe5, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc2, 0x65, 0x06, 0x58, 0x6d, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd0, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xbf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x83, 0xf4, 0x9c, 0xc4, 0xa4, 0x62, 0x7d, 0x83, 0x74, 0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c,
-	0xfd, 0x0a, 0xa4, 0xa0, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x81, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x8d, 0xd5, 0x5f, 0xd1, 0x7a, 0x01, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x8e, 0xd2, 0x40,
+	0x18, 0xc7, 0x3b, 0xbb, 0x64, 0xb3, 0x3b, 0xdb, 0xaa, 0x69, 0x76, 0x13, 0x42, 0xe2, 0x80, 0x70,
+	0xc1, 0x44, 0x5b, 0xc0, 0xa3, 0xb7, 0x6a, 0xa2, 0x1c, 0x34, 0xa4, 0xc6, 0x8b, 0x89, 0x92, 0x69,
+	0xfb, 0x31, 0x6d, 0x84, 0xb6, 0x99, 0x19, 0x8c, 0xf2, 0x14, 0x3e, 0x86, 0xf1, 0x49, 0x38, 0x72,
+	0xf4, 0x84, 0x58, 0x5e, 0xc1, 0x07, 0x30, 0x9d, 0xa9, 0xd8, 0x03, 0x78, 0xeb, 0xfc, 0xbf, 0xdf,
+	0xfc, 0x7f, 0xe9, 0xe4, 0xc3, 0xbd, 0x15, 0x0b, 0xdd, 0x9c, 0x27, 0x21, 0xcc, 0x00, 0x22, 0xf7,
+	0xd3, 0x30, 0x00, 0x49, 0x87, 0x2e, 0x83, 0x14, 0x44, 0x22, 0x9c, 0x9c, 0x67, 0x32, 0xb3, 0x6f,
+	0x57, 0x2c, 0x74, 0x0e, 0x90, 0x53, 0x41, 0xad, 0x1b, 0x96, 0xb1, 0x4c, 0x11, 0x6e, 0xf9, 0xa5,
+	0xe1, 0xd6, 0x83, 0xe3, 0x8d, 0x42, 0x66, 0x1c, 0x34, 0xd2, 0xfd, 0x7d, 0x86, 0xcd, 0x17, 0xda,
+	0xf0, 0x46, 0x52, 0x09, 0xf6, 0x53, 0x7c, 0x91, 0x53, 0x4e, 0x17, 0xa2, 0x89, 0x3a, 0xa8, 0x7f,
+	0x3d, 0xba, 0xef, 0x1c, 0x35, 0x3a, 0x13, 0x05, 0x79, 0x8d, 0xf5, 0xb6, 0x6d, 0xf8, 0xd5, 0x15,
+	0xfb, 0x3d, 0xb6, 0xf2, 0x4c, 0x48, 0x88, 0xa6, 0xea, 0x82, 0x68, 0x9e, 0x75, 0xce, 0xfb, 0xd7,
+	0xa3, 0xee, 0xa9, 0x0e, 0xc5, 0x4e, 0xca, 0xdc, 0xbb, 0x29, 0x8b, 0xbe, 0xff, 0x6c, 0x9b, 0xb5,
+	0x50, 0xf8, 0x66, 0x5e, 0x3b, 0xd9, 0x80, 0xef, 0x71, 0x98, 0x01, 0x87, 0x34, 0x84, 0xbf, 0x86,
+	0x73, 0x65, 0xe8, 0x9d, 0x30, 0x3c, 0x5b, 0x72, 0x0e, 0xa9, 0xd4, 0x8a, 0xdb, 0x4a, 0x61, 0xd5,
+	0x53, 0xe1, 0xdf, 0x3d, 0x74, 0x56, 0x1a, 0x8a, 0xef, 0xc4, 0x74, 0x5e, 0xfe, 0xc5, 0x82, 0xf2,
+	0x8f, 0x20, 0x45, 0xb3, 0xf1, 0x5f, 0xc9, 0x4b, 0x05, 0xbf, 0x52, 0xec, 0x3f, 0x49, 0x3d, 0x15,
+	0xbe, 0x15, 0xd7, 0x8f, 0xdd, 0x0f, 0xd8, 0xac, 0xcf, 0xed, 0x87, 0xf8, 0x4a, 0xbb, 0xa6, 0x49,
+	0xa4, 0x1e, 0xfe, 0xca, 0x33, 0x8b, 0x6d, 0xfb, 0x52, 0x8f, 0xc7, 0xcf, 0xfd, 0x4b, 0x3d, 0x1e,
+	0x47, 0x76, 0x0f, 0x5b, 0xe1, 0x1c, 0x68, 0x3a, 0x5d, 0xe6, 0x11, 0x95, 0xea, 0x8d, 0x51, 0xbf,
+	0xe1, 0x9b, 0x2a, 0x7c, 0xab, 0x33, 0xef, 0xf5, 0xee, 0x17, 0x41, 0xdf, 0x0a, 0x82, 0xd6, 0x05,
+	0x41, 0x9b, 0x82, 0xa0, 0x5d, 0x41, 0xd0, 0xd7, 0x3d, 0x31, 0x36, 0x7b, 0x62, 0xfc, 0xd8, 0x13,
+	0xe3, 0xdd, 0x23, 0x96, 0xc8, 0x78, 0x19, 0x38, 0x61, 0xb6, 0x70, 0x07, 0x6c, 0x4e, 0x03, 0xe1,
+	0x0e, 0xd8, 0xe3, 0x30, 0xa6, 0x49, 0xea, 0x7e, 0xae, 0x2d, 0x8d, 0xfc, 0x92, 0x83, 0x08, 0x2e,
+	0xd4, 0xb6, 0x3c, 0xf9, 0x33, 0x00, 0xb4, 0x6a, 0xf0, 0x57, 0xa4, 0x02, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +224,22 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.ReferencePrices) != len(that1.ReferencePrices) {
+		return fmt.Errorf("ReferencePrices this(%v) Not Equal that(%v)", len(this.ReferencePrices), len(that1.ReferencePrices))
+	}
+	for i := range this.ReferencePrices {
+		if !this.ReferencePrices[i].Equal(&that1.ReferencePrices[i]) {
+			return fmt.Errorf("ReferencePrices this[%v](%v) Not Equal that[%v](%v)", i, this.ReferencePrices[i], i, that1.ReferencePrices[i])
+		}
+	}
+	if len(this.HaltedMarkets) != len(that1.HaltedMarkets) {
+		return fmt.Errorf("HaltedMarkets this(%v) Not Equal that(%v)", len(this.HaltedMarkets), len(that1.HaltedMarkets))
+	}
+	for i := range this.HaltedMarkets {
+		if !this.HaltedMarkets[i].Equal(&that1.HaltedMarkets[i]) {
+			return fmt.Errorf("HaltedMarkets this[%v](%v) Not Equal that[%v](%v)", i, this.HaltedMarkets[i], i, that1.HaltedMarkets[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +272,82 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ReferencePrices) != len(that1.ReferencePrices) {
+		return false
+	}
+	for i := range this.ReferencePrices {
+		if !this.ReferencePrices[i].Equal(&that1.ReferencePrices[i]) {
+			return false
+		}
+	}
+	if len(this.HaltedMarkets) != len(that1.HaltedMarkets) {
+		return false
+	}
+	for i := range this.HaltedMarkets {
+		if !this.HaltedMarkets[i].Equal(&that1.HaltedMarkets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HaltedMarket) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HaltedMarket)
+	if !ok {
+		that2, ok := that.(HaltedMarket)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HaltedMarket")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HaltedMarket but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HaltedMarket but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.CleanUpdates != that1.CleanUpdates {
+		return fmt.Errorf("CleanUpdates this(%v) Not Equal that(%v)", this.CleanUpdates, that1.CleanUpdates)
+	}
+	return nil
+}
+func (this *HaltedMarket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HaltedMarket)
+	if !ok {
+		that2, ok := that.(HaltedMarket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.CleanUpdates != that1.CleanUpdates {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +370,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HaltedMarkets) > 0 {
+		for iNdEx := len(m.HaltedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HaltedMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReferencePrices) > 0 {
+		for iNdEx := len(m.ReferencePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferencePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -223,6 +425,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HaltedMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltedMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltedMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CleanUpdates != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CleanUpdates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -248,6 +485,34 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ReferencePrices) > 0 {
+		for _, e := range m.ReferencePrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedMarkets) > 0 {
+		for _, e := range m.HaltedMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HaltedMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CleanUpdates != 0 {
+		n += 1 + sovGenesis(uint64(m.CleanUpdates))
+	}
 	return n
 }
 
@@ -353,6 +618,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferencePrices = append(m.ReferencePrices, CurrentPrice{})
+			if err := m.ReferencePrices[len(m.ReferencePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedMarkets = append(m.HaltedMarkets, HaltedMarket{})
+			if err := m.HaltedMarkets[len(m.HaltedMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HaltedMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HaltedMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HaltedMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanUpdates", wireType)
+			}
+			m.CleanUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CleanUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -32,9 +32,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
 			),
 			expPass: true,
 		},
@@ -42,9 +44,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -52,10 +56,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -64,6 +70,8 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams([]Market{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -75,6 +83,48 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid circuit breaker state",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]CurrentPrice{NewCurrentPrice("xrp", sdk.OneDec())},
+				[]HaltedMarket{{MarketID: "xrp", CleanUpdates: 1}},
+			),
+			expPass: true,
+		},
+		{
+			msg: "zero reference price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]CurrentPrice{NewCurrentPrice("xrp", sdk.ZeroDec())},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated reference price",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]CurrentPrice{NewCurrentPrice("xrp", sdk.OneDec()), NewCurrentPrice("xrp", sdk.OneDec())},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated halted market",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				nil,
+				[]HaltedMarket{{MarketID: "xrp"}, {MarketID: "xrp"}},
 			),
 			expPass: false,
 		},
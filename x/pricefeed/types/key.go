@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName The name that will be used throughout the module
@@ -25,6 +29,15 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// HaltedMarketPrefix prefix for the markets halted by the circuit breaker
+	HaltedMarketPrefix = []byte{0x02}
+
+	// PriceSnapshotPrefix prefix for the price accumulator snapshots of an asset
+	PriceSnapshotPrefix = []byte{0x03}
+
+	// ReferencePricePrefix prefix for the last price of an asset that passed the circuit breaker checks
+	ReferencePricePrefix = []byte{0x04}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -32,6 +45,29 @@ func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
+// HaltedMarketKey returns the key for the halted flag of a market
+func HaltedMarketKey(marketID string) []byte {
+	return append(HaltedMarketPrefix, []byte(marketID)...)
+}
+
+// ReferencePriceKey returns the key for the reference price of a market
+func ReferencePriceKey(marketID string) []byte {
+	return append(ReferencePricePrefix, []byte(marketID)...)
+}
+
+// PriceSnapshotIteratorKey returns the prefix for the price snapshots of a single market
+func PriceSnapshotIteratorKey(marketID string) []byte {
+	return append(
+		PriceSnapshotPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceSnapshotKey returns the key for the price snapshot of a market at a given time
+func PriceSnapshotKey(marketID string, t time.Time) []byte {
+	return append(PriceSnapshotIteratorKey(marketID), sdk.FormatTimeBytes(t)...)
+}
+
 // RawPriceIteratorKey returns the prefix for the raw price for a single market
 func RawPriceIteratorKey(marketID string) []byte {
 	return append(
@@ -41,12 +41,30 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if int(m.MinOracles) > len(m.Oracles) {
+		return fmt.Errorf("min oracles %d exceeds the number of oracles %d", m.MinOracles, len(m.Oracles))
+	}
 	return nil
 }
 
+// ExceedsMaxDeviation returns true if the change from prevPrice to price is larger than the maximum
+// deviation allowed for the market. It always returns false when the check is disabled or there is
+// no previous price to compare against.
+func (m Market) ExceedsMaxDeviation(prevPrice, price sdk.Dec) bool {
+	if m.MaxPriceDeviationBps == 0 || !prevPrice.IsPositive() {
+		return false
+	}
+	deviation := price.Sub(prevPrice).Abs().Quo(prevPrice)
+	return deviation.GT(sdk.NewDecWithPrec(int64(m.MaxPriceDeviationBps), 4))
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	res := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	res.MaxPriceDeviationBps = m.MaxPriceDeviationBps
+	res.MinOracles = m.MinOracles
+	res.ResumeAfterUpdates = m.ResumeAfterUpdates
+	return res
 }
 
 // Markets is a slice of Market
@@ -95,6 +113,24 @@ func NewCurrentPrice(marketID string, price sdk.Dec) CurrentPrice {
 // CurrentPrices is a slice of CurrentPrice
 type CurrentPrices []CurrentPrice
 
+// Validate checks if all the current prices are positive and there are no duplicated markets.
+func (cps CurrentPrices) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, cp := range cps {
+		if strings.TrimSpace(cp.MarketID) == "" {
+			return errors.New("market id cannot be blank")
+		}
+		if seenMarkets[cp.MarketID] {
+			return fmt.Errorf("duplicated price for market id %s", cp.MarketID)
+		}
+		if cp.Price.IsNil() || !cp.Price.IsPositive() {
+			return fmt.Errorf("price for market id %s must be positive", cp.MarketID)
+		}
+		seenMarkets[cp.MarketID] = true
+	}
+	return nil
+}
+
 // NewCurrentPriceResponse returns an instance of CurrentPriceResponse
 func NewCurrentPriceResponse(marketID string, price sdk.Dec) CurrentPriceResponse {
 	return CurrentPriceResponse{MarketID: marketID, Price: price}
@@ -78,6 +78,29 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid circuit breaker",
+			Market{
+				MarketID:             "market",
+				BaseAsset:            "xrp",
+				QuoteAsset:           "bnb",
+				Oracles:              []sdk.AccAddress{addr},
+				MaxPriceDeviationBps: 500,
+				MinOracles:           1,
+			},
+			true,
+		},
+		{
+			"min oracles exceeds oracles",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				MinOracles: 2,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -492,6 +492,86 @@ func (m *QueryMarketsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMarketsResponse proto.InternalMessageInfo
 
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// window_seconds is the length of the trailing window to average over
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee24f62d2f5d373, []int{12}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
+
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	MarketID      string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	WindowSeconds uint64                                 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Price         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ee24f62d2f5d373, []int{13}
+}
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
 // PostedPriceResponse defines a price for market posted by a specific oracle.
 type PostedPriceResponse struct {
 	MarketID      string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -504,7 +584,7 @@ func (m *PostedPriceResponse) Reset()         { *m = PostedPriceResponse{} }
 func (m *PostedPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*PostedPriceResponse) ProtoMessage()    {}
 func (*PostedPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ee24f62d2f5d373, []int{12}
+	return fileDescriptor_1ee24f62d2f5d373, []int{14}
 }
 func (m *PostedPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +645,7 @@ func (m *CurrentPriceResponse) Reset()         { *m = CurrentPriceResponse{} }
 func (m *CurrentPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*CurrentPriceResponse) ProtoMessage()    {}
 func (*CurrentPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ee24f62d2f5d373, []int{13}
+	return fileDescriptor_1ee24f62d2f5d373, []int{15}
 }
 func (m *CurrentPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,18 +683,21 @@ func (m *CurrentPriceResponse) GetMarketID() string {
 
 // MarketResponse defines an asset in the pricefeed.
 type MarketResponse struct {
-	MarketID   string   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BaseAsset  string   `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
-	QuoteAsset string   `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
-	Oracles    []string `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
-	Active     bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	MarketID             string   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BaseAsset            string   `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset           string   `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Oracles              []string `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	Active               bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	MaxPriceDeviationBps uint32   `protobuf:"varint,6,opt,name=max_price_deviation_bps,json=maxPriceDeviationBps,proto3" json:"max_price_deviation_bps,omitempty"`
+	MinOracles           uint32   `protobuf:"varint,7,opt,name=min_oracles,json=minOracles,proto3" json:"min_oracles,omitempty"`
+	ResumeAfterUpdates   uint32   `protobuf:"varint,8,opt,name=resume_after_updates,json=resumeAfterUpdates,proto3" json:"resume_after_updates,omitempty"`
 }
 
 func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
 func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketResponse) ProtoMessage()    {}
 func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ee24f62d2f5d373, []int{14}
+	return fileDescriptor_1ee24f62d2f5d373, []int{16}
 }
 func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,6 +761,27 @@ func (m *MarketResponse) GetActive() bool {
 	return false
 }
 
+func (m *MarketResponse) GetMaxPriceDeviationBps() uint32 {
+	if m != nil {
+		return m.MaxPriceDeviationBps
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMinOracles() uint32 {
+	if m != nil {
+		return m.MinOracles
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetResumeAfterUpdates() uint32 {
+	if m != nil {
+		return m.ResumeAfterUpdates
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "zgc.pricefeed.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "zgc.pricefeed.v1beta1.QueryParamsResponse")
@@ -691,6 +795,8 @@ func init() {
 	proto.RegisterType((*QueryOraclesResponse)(nil), "zgc.pricefeed.v1beta1.QueryOraclesResponse")
 	proto.RegisterType((*QueryMarketsRequest)(nil), "zgc.pricefeed.v1beta1.QueryMarketsRequest")
 	proto.RegisterType((*QueryMarketsResponse)(nil), "zgc.pricefeed.v1beta1.QueryMarketsResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "zgc.pricefeed.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "zgc.pricefeed.v1beta1.QueryTWAPResponse")
 	proto.RegisterType((*PostedPriceResponse)(nil), "zgc.pricefeed.v1beta1.PostedPriceResponse")
 	proto.RegisterType((*CurrentPriceResponse)(nil), "zgc.pricefeed.v1beta1.CurrentPriceResponse")
 	proto.RegisterType((*MarketResponse)(nil), "zgc.pricefeed.v1beta1.MarketResponse")
@@ -699,63 +805,73 @@ func init() {
 func init() { proto.RegisterFile("zgc/pricefeed/v1beta1/query.proto", fileDescriptor_1ee24f62d2f5d373) }
 
 var fileDescriptor_1ee24f62d2f5d373 = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0xc7, 0x33, 0xdb, 0xcd, 0xdb, 0x14, 0x8a, 0x98, 0x26, 0x25, 0x0a, 0x1b, 0xbb, 0x44, 0x2c,
-	0x4a, 0x36, 0x8d, 0x9d, 0x6d, 0xa5, 0x0a, 0x15, 0x2e, 0x0d, 0x2b, 0xa1, 0x1e, 0x78, 0xb3, 0x38,
-	0x54, 0x5c, 0xa2, 0x89, 0x33, 0x75, 0xad, 0x6e, 0x32, 0x5e, 0xcf, 0x64, 0xb7, 0x5b, 0x84, 0x90,
-	0x90, 0x10, 0x70, 0x41, 0x95, 0x10, 0x47, 0x24, 0x8e, 0x08, 0x89, 0xef, 0xd1, 0x63, 0x25, 0x2e,
-	0x88, 0xc3, 0xb6, 0x64, 0xb9, 0xc1, 0x87, 0x40, 0x9e, 0x79, 0x6c, 0xe2, 0xad, 0x93, 0x26, 0xea,
-	0x69, 0xd7, 0x8f, 0x9f, 0x97, 0xdf, 0xf3, 0x9f, 0xf1, 0x3f, 0xf8, 0x8d, 0x07, 0x9e, 0x6b, 0x07,
-	0xa1, 0xef, 0xb2, 0x3b, 0x8c, 0x8d, 0xec, 0xc3, 0xdd, 0x21, 0x93, 0x74, 0xd7, 0x3e, 0x98, 0xb2,
-	0xf0, 0xd8, 0x0a, 0x42, 0x2e, 0x39, 0xa9, 0x3e, 0xf0, 0x5c, 0x2b, 0x49, 0xb1, 0x20, 0xa5, 0x5e,
-	0xf1, 0xb8, 0xc7, 0x55, 0x86, 0x1d, 0xfd, 0xa7, 0x93, 0xeb, 0x5b, 0x1e, 0xe7, 0xde, 0x3e, 0xb3,
-	0x69, 0xe0, 0xdb, 0x74, 0x32, 0xe1, 0x92, 0x4a, 0x9f, 0x4f, 0x04, 0xbc, 0x35, 0xe1, 0xad, 0x7a,
-	0x1a, 0x4e, 0xef, 0xd8, 0xd2, 0x1f, 0x33, 0x21, 0xe9, 0x38, 0x80, 0x84, 0x05, 0x38, 0x42, 0xf2,
-	0x90, 0xe9, 0x94, 0x66, 0x05, 0x93, 0x4f, 0x22, 0xba, 0x8f, 0x69, 0x48, 0xc7, 0xc2, 0x61, 0x07,
-	0x53, 0x26, 0x64, 0xf3, 0x36, 0xbe, 0x98, 0x8a, 0x8a, 0x80, 0x4f, 0x04, 0x23, 0xef, 0xe0, 0x42,
-	0xa0, 0x22, 0x35, 0x74, 0x19, 0xb5, 0xce, 0x5f, 0x6d, 0x58, 0x99, 0xcb, 0x58, 0xba, 0xac, 0xbf,
-	0xf9, 0xe8, 0xc4, 0xcc, 0x39, 0x50, 0x72, 0x63, 0xf3, 0xdb, 0x9f, 0xcd, 0x5c, 0xf3, 0x3a, 0x7e,
-	0x55, 0x77, 0x8e, 0x8a, 0x60, 0x1c, 0x79, 0x1d, 0x97, 0xc7, 0x34, 0xbc, 0xc7, 0xe4, 0xc0, 0x1f,
-	0xa9, 0xd6, 0x65, 0xa7, 0xa4, 0x03, 0xb7, 0x46, 0x50, 0xe7, 0xc6, 0x9c, 0xba, 0x0e, 0x80, 0xde,
-	0xc7, 0x79, 0x35, 0x1d, 0x78, 0x3a, 0x0b, 0x78, 0xde, 0x9b, 0x86, 0x21, 0x9b, 0xc8, 0x54, 0x2d,
-	0xd0, 0xe9, 0x7a, 0x18, 0x52, 0x99, 0x1f, 0x92, 0x88, 0xf1, 0x65, 0x2c, 0x06, 0x44, 0x61, 0xf6,
-	0x10, 0x17, 0x54, 0x6d, 0x24, 0xc6, 0xb9, 0x75, 0x87, 0x37, 0xa2, 0xe1, 0xbf, 0x3e, 0x31, 0xab,
-	0x59, 0x6f, 0x85, 0x03, 0x9d, 0x01, 0xeb, 0x06, 0xae, 0x2a, 0x00, 0x87, 0x1e, 0xa5, 0xc8, 0x56,
-	0xd1, 0xed, 0x1b, 0x84, 0x2f, 0x9d, 0x2d, 0x86, 0x05, 0x3c, 0x8c, 0x43, 0x7a, 0x34, 0x48, 0x2d,
-	0xb1, 0xb3, 0xe8, 0x44, 0xb9, 0x90, 0x6c, 0x94, 0xde, 0x61, 0x0b, 0x76, 0xa8, 0x64, 0xbc, 0x14,
-	0x4e, 0x39, 0x8c, 0x07, 0x02, 0xc9, 0xdb, 0x20, 0xe3, 0x47, 0x21, 0x75, 0xf7, 0xd7, 0xda, 0xe1,
-	0x3a, 0xae, 0xa4, 0x2b, 0x61, 0x81, 0x1a, 0x2e, 0x72, 0x1d, 0x52, 0xf4, 0x65, 0x27, 0x7e, 0x84,
-	0xba, 0x2a, 0x4c, 0xfc, 0x40, 0xb5, 0x4b, 0xce, 0xf3, 0x10, 0xda, 0x25, 0x61, 0x68, 0x77, 0x1b,
-	0x17, 0xf5, 0xe0, 0x58, 0x8c, 0xed, 0x05, 0x62, 0xe8, 0xc2, 0x44, 0x87, 0xd7, 0x40, 0x87, 0x57,
-	0xd2, 0x71, 0xe1, 0xc4, 0xed, 0x00, 0xe7, 0x1f, 0x84, 0x2f, 0x66, 0x48, 0x45, 0xda, 0xcf, 0x28,
-	0xd0, 0x7f, 0x69, 0x76, 0x62, 0x96, 0x74, 0xbb, 0x5b, 0x7b, 0xff, 0xeb, 0x41, 0xb6, 0xf1, 0x05,
-	0xbd, 0xe2, 0x80, 0x8e, 0x46, 0x21, 0x13, 0xa2, 0xb6, 0xa1, 0x14, 0x7b, 0x59, 0x47, 0x6f, 0xea,
-	0x20, 0xd9, 0x8b, 0x3f, 0x8b, 0x73, 0xaa, 0x9b, 0x15, 0x01, 0xfe, 0x79, 0x62, 0xbe, 0xe5, 0xf9,
-	0xf2, 0xee, 0x74, 0x68, 0xb9, 0x7c, 0x6c, 0xbb, 0x5c, 0x8c, 0xb9, 0x80, 0x3f, 0x5d, 0x31, 0xba,
-	0x67, 0xcb, 0xe3, 0x80, 0x09, 0x6b, 0x8f, 0xb9, 0xf0, 0x4d, 0x90, 0x77, 0x71, 0x81, 0xdd, 0x0f,
-	0xfc, 0xf0, 0xb8, 0xb6, 0xa9, 0xbe, 0xae, 0xba, 0xa5, 0xfd, 0xc6, 0x8a, 0xfd, 0xc6, 0xfa, 0x34,
-	0xf6, 0x9b, 0x7e, 0x29, 0x1a, 0xf1, 0xf0, 0x89, 0x89, 0x1c, 0xa8, 0x89, 0x2e, 0x5e, 0x25, 0xeb,
-	0x72, 0xaf, 0xb3, 0x6e, 0xb2, 0xc7, 0xc6, 0x0b, 0xec, 0xd1, 0xfc, 0x0d, 0xe1, 0x0b, 0xe9, 0xa3,
-	0x59, 0x87, 0xa1, 0x81, 0xf1, 0x90, 0x0a, 0x36, 0xa0, 0x42, 0x30, 0x09, 0x72, 0x97, 0xa3, 0xc8,
-	0xcd, 0x28, 0x40, 0x4c, 0x7c, 0xfe, 0x60, 0xca, 0x65, 0xfc, 0x5e, 0x09, 0xee, 0x60, 0x15, 0xd2,
-	0x09, 0x73, 0x97, 0x74, 0x33, 0x75, 0x49, 0xc9, 0x25, 0x5c, 0xa0, 0xae, 0xf4, 0x0f, 0x59, 0x2d,
-	0x7f, 0x19, 0xb5, 0x4a, 0x0e, 0x3c, 0x5d, 0xfd, 0xb7, 0x80, 0xf3, 0xea, 0x82, 0x92, 0xaf, 0x11,
-	0x2e, 0x68, 0x2f, 0x25, 0xed, 0x05, 0x77, 0xf1, 0x59, 0xf3, 0xae, 0xef, 0xac, 0x92, 0xaa, 0x85,
-	0x68, 0xbe, 0xf9, 0xd5, 0xef, 0x7f, 0xff, 0xb0, 0x61, 0x90, 0x2d, 0xbb, 0xe7, 0x65, 0xfc, 0x52,
-	0x68, 0xeb, 0x26, 0xdf, 0x23, 0x9c, 0x57, 0x87, 0x48, 0x5a, 0x4b, 0x7b, 0xcf, 0x79, 0x7a, 0xbd,
-	0xbd, 0x42, 0x26, 0x40, 0xf4, 0x14, 0xc4, 0x0e, 0x69, 0x2d, 0x80, 0x50, 0x2e, 0x62, 0x7f, 0x9e,
-	0x9c, 0xd8, 0x17, 0x5a, 0x18, 0x15, 0x26, 0xcf, 0x9f, 0xb3, 0xa2, 0x30, 0x29, 0x73, 0x7c, 0xae,
-	0x30, 0x7a, 0xf8, 0x4f, 0x08, 0x97, 0x13, 0x63, 0x25, 0x57, 0x96, 0xf5, 0x3f, 0x6b, 0xde, 0xf5,
-	0xee, 0x8a, 0xd9, 0x00, 0x74, 0x4d, 0x01, 0x75, 0x49, 0x27, 0x1b, 0x28, 0xa4, 0x47, 0x19, 0x3a,
-	0xfd, 0x88, 0x70, 0x11, 0x5c, 0x93, 0x2c, 0xdd, 0x3e, 0x6d, 0xca, 0xf5, 0xce, 0x4a, 0xb9, 0x40,
-	0xb6, 0xab, 0xc8, 0x3a, 0xa4, 0x9d, 0x4d, 0x06, 0xd7, 0x3d, 0xc5, 0xf5, 0x1d, 0xc2, 0x45, 0xb0,
-	0xdf, 0xe5, 0x5c, 0x69, 0xeb, 0x5e, 0xce, 0x75, 0xc6, 0xcf, 0x9b, 0xdb, 0x8a, 0xcb, 0x24, 0x8d,
-	0x6c, 0x2e, 0x30, 0xe7, 0xfe, 0x87, 0x4f, 0xff, 0x32, 0xd0, 0x2f, 0x33, 0x03, 0x3d, 0x9a, 0x19,
-	0xe8, 0xf1, 0xcc, 0x40, 0x4f, 0x67, 0x06, 0x7a, 0x78, 0x6a, 0xe4, 0x1e, 0x9f, 0x1a, 0xb9, 0x3f,
-	0x4e, 0x8d, 0xdc, 0x67, 0x57, 0xe6, 0xfc, 0xa6, 0xe7, 0xed, 0xd3, 0xa1, 0xb0, 0x7b, 0x5e, 0xd7,
-	0xbd, 0x4b, 0xfd, 0x89, 0x7d, 0x7f, 0xae, 0xb3, 0x72, 0x9e, 0x61, 0x41, 0xd9, 0xe3, 0xb5, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x77, 0xf1, 0xdf, 0x84, 0x0c, 0x0a, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x6f, 0x1b, 0x55,
+	0x17, 0xc7, 0x33, 0xa9, 0xe3, 0x97, 0xd3, 0x27, 0x7d, 0xe8, 0x8d, 0xd3, 0x5a, 0x26, 0xb1, 0x83,
+	0x45, 0x8a, 0x13, 0x37, 0x33, 0x4e, 0x2b, 0x2a, 0x54, 0xd8, 0xc4, 0x44, 0x42, 0x5d, 0x00, 0x65,
+	0x28, 0xa2, 0x42, 0x48, 0xa3, 0xeb, 0x99, 0x9b, 0xe9, 0xa8, 0x99, 0x97, 0xcc, 0x1d, 0xc7, 0x49,
+	0x11, 0x42, 0x42, 0x42, 0xc0, 0x06, 0x55, 0x42, 0x2c, 0x91, 0x58, 0x22, 0x76, 0xac, 0xf8, 0x0a,
+	0x5d, 0x56, 0x62, 0x83, 0x58, 0xa4, 0xc5, 0x61, 0xc7, 0x17, 0x60, 0x89, 0xe6, 0xde, 0x33, 0x96,
+	0xa7, 0x1d, 0x3b, 0xb6, 0x60, 0x65, 0xcf, 0xb9, 0xe7, 0x9c, 0xff, 0xef, 0x9c, 0x99, 0x7b, 0x0e,
+	0xbc, 0xf4, 0xc0, 0x36, 0xb5, 0x20, 0x74, 0x4c, 0xb6, 0xc7, 0x98, 0xa5, 0x1d, 0x6e, 0x77, 0x59,
+	0x44, 0xb7, 0xb5, 0x83, 0x1e, 0x0b, 0x8f, 0xd5, 0x20, 0xf4, 0x23, 0x9f, 0x2c, 0x3f, 0xb0, 0x4d,
+	0x75, 0xe8, 0xa2, 0xa2, 0x4b, 0xb5, 0x6c, 0xfb, 0xb6, 0x2f, 0x3c, 0xb4, 0xf8, 0x9f, 0x74, 0xae,
+	0xae, 0xd8, 0xbe, 0x6f, 0xef, 0x33, 0x8d, 0x06, 0x8e, 0x46, 0x3d, 0xcf, 0x8f, 0x68, 0xe4, 0xf8,
+	0x1e, 0xc7, 0xd3, 0x3a, 0x9e, 0x8a, 0xa7, 0x6e, 0x6f, 0x4f, 0x8b, 0x1c, 0x97, 0xf1, 0x88, 0xba,
+	0x01, 0x3a, 0x8c, 0xc1, 0xe1, 0x91, 0x1f, 0x32, 0xe9, 0xd2, 0x28, 0x03, 0x79, 0x2f, 0xa6, 0xbb,
+	0x4d, 0x43, 0xea, 0x72, 0x9d, 0x1d, 0xf4, 0x18, 0x8f, 0x1a, 0x77, 0x61, 0x29, 0x65, 0xe5, 0x81,
+	0xef, 0x71, 0x46, 0x5e, 0x87, 0x7c, 0x20, 0x2c, 0x15, 0x65, 0x4d, 0x69, 0x9e, 0xbf, 0xb6, 0xaa,
+	0x66, 0x16, 0xa3, 0xca, 0xb0, 0x4e, 0xee, 0xd1, 0x49, 0x7d, 0x4e, 0xc7, 0x90, 0x9b, 0xb9, 0xaf,
+	0x7e, 0xa8, 0xcf, 0x35, 0x6e, 0xc0, 0x45, 0x99, 0x39, 0x0e, 0x42, 0x39, 0xf2, 0x22, 0x94, 0x5c,
+	0x1a, 0xde, 0x67, 0x91, 0xe1, 0x58, 0x22, 0x75, 0x49, 0x2f, 0x4a, 0xc3, 0x2d, 0x0b, 0xe3, 0x4c,
+	0x20, 0xa3, 0x71, 0x08, 0xf4, 0x16, 0x2c, 0x08, 0x75, 0xe4, 0x69, 0x8d, 0xe1, 0x79, 0xb3, 0x17,
+	0x86, 0xcc, 0x8b, 0x52, 0xb1, 0x48, 0x27, 0xe3, 0x51, 0xa4, 0x3c, 0x2a, 0x32, 0x6c, 0xc6, 0x67,
+	0xb0, 0x94, 0xb2, 0xa2, 0x76, 0x17, 0xf2, 0x22, 0x36, 0x6e, 0xc6, 0xb9, 0x59, 0xc5, 0x57, 0x63,
+	0xf1, 0x9f, 0x9e, 0xd4, 0x97, 0xb3, 0x4e, 0xb9, 0x8e, 0x99, 0x11, 0xeb, 0x26, 0x2c, 0x0b, 0x00,
+	0x9d, 0xf6, 0x53, 0x64, 0xd3, 0xf4, 0xed, 0x4b, 0x05, 0x2e, 0x3d, 0x1b, 0x8c, 0x05, 0xd8, 0x00,
+	0x21, 0xed, 0x1b, 0xa9, 0x22, 0x36, 0xc7, 0xbd, 0x51, 0x9f, 0x47, 0xcc, 0x4a, 0xd7, 0xb0, 0x82,
+	0x35, 0x94, 0x33, 0x0e, 0xb9, 0x5e, 0x0a, 0x13, 0x41, 0x24, 0x79, 0x0d, 0xdb, 0xf8, 0x6e, 0x48,
+	0xcd, 0xfd, 0x99, 0x6a, 0xb8, 0x01, 0xe5, 0x74, 0x24, 0x16, 0x50, 0x81, 0x82, 0x2f, 0x4d, 0x82,
+	0xbe, 0xa4, 0x27, 0x8f, 0x18, 0xb7, 0x8c, 0x8a, 0x6f, 0x8b, 0x74, 0xc3, 0xf7, 0x79, 0x08, 0xe5,
+	0xb4, 0x19, 0xd3, 0xdd, 0x85, 0x82, 0x14, 0x4e, 0x9a, 0xb1, 0x3e, 0xa6, 0x19, 0x32, 0x70, 0xd8,
+	0x87, 0xcb, 0xd8, 0x87, 0xff, 0xa7, 0xed, 0x5c, 0x4f, 0xd2, 0x21, 0xce, 0xc7, 0xf0, 0x82, 0xd0,
+	0xbd, 0xf3, 0xe1, 0xce, 0xed, 0x69, 0xaa, 0x27, 0xeb, 0x70, 0xa1, 0xef, 0x78, 0x96, 0xdf, 0x37,
+	0x38, 0x33, 0x7d, 0xcf, 0xe2, 0x95, 0xf9, 0x35, 0xa5, 0x99, 0xd3, 0x17, 0xa5, 0xf5, 0x7d, 0x69,
+	0xc4, 0xec, 0x3f, 0x2b, 0x70, 0x71, 0x24, 0x3d, 0xd6, 0xb4, 0xf1, 0x5c, 0xfe, 0xce, 0xff, 0x06,
+	0x27, 0xf5, 0xa2, 0x44, 0xbd, 0xb5, 0x3b, 0xb3, 0x1a, 0xd9, 0x4d, 0xae, 0xdc, 0x39, 0x91, 0x4d,
+	0x8d, 0x8b, 0xff, 0xfd, 0xa4, 0x7e, 0xc5, 0x76, 0xa2, 0x7b, 0xbd, 0xae, 0x6a, 0xfa, 0xae, 0x66,
+	0xfa, 0xdc, 0xf5, 0x39, 0xfe, 0x6c, 0x71, 0xeb, 0xbe, 0x16, 0x1d, 0x07, 0x8c, 0xab, 0xbb, 0xcc,
+	0x4c, 0xdf, 0xb7, 0xbf, 0x14, 0x58, 0xca, 0xf8, 0x78, 0x66, 0xa4, 0x96, 0x2f, 0xdd, 0xa0, 0x96,
+	0x15, 0x32, 0x2e, 0xa9, 0x4b, 0xfa, 0xa2, 0xb4, 0xee, 0x48, 0xe3, 0x7f, 0x43, 0x4d, 0xde, 0x80,
+	0x3c, 0x3b, 0x0a, 0x9c, 0xf0, 0xb8, 0x92, 0x13, 0xf3, 0xa6, 0xaa, 0xca, 0x09, 0xac, 0x26, 0x13,
+	0x58, 0xbd, 0x93, 0x4c, 0xe0, 0x4e, 0x31, 0x96, 0x78, 0xf8, 0xa4, 0xae, 0xe8, 0x18, 0x13, 0x5f,
+	0xc5, 0x72, 0xd6, 0x75, 0x9f, 0xa5, 0xdc, 0x61, 0x1d, 0xf3, 0xff, 0xa2, 0x8e, 0xc6, 0x2f, 0xf3,
+	0x70, 0x21, 0xfd, 0xb1, 0xce, 0xc2, 0xb0, 0x0a, 0xd0, 0xa5, 0x9c, 0x19, 0x94, 0x73, 0x16, 0x61,
+	0xbb, 0x4b, 0xb1, 0x65, 0x27, 0x36, 0x90, 0x3a, 0x9c, 0x3f, 0xe8, 0xf9, 0x51, 0x72, 0x2e, 0x1a,
+	0xae, 0x83, 0x30, 0x49, 0x87, 0x91, 0x6b, 0x9b, 0x4b, 0x5d, 0x5b, 0x72, 0x09, 0xf2, 0xd4, 0x8c,
+	0x9c, 0x43, 0x56, 0x59, 0x58, 0x53, 0x9a, 0x45, 0x1d, 0x9f, 0xc8, 0xab, 0x70, 0xd9, 0xa5, 0x47,
+	0x72, 0x52, 0x19, 0x16, 0x3b, 0x74, 0xc4, 0x1a, 0x34, 0xba, 0x01, 0xaf, 0xe4, 0xd7, 0x94, 0xe6,
+	0xa2, 0x5e, 0x76, 0xe9, 0x91, 0xe8, 0xe9, 0x6e, 0x72, 0xd8, 0x09, 0x78, 0x4c, 0xe2, 0x3a, 0x9e,
+	0x91, 0x88, 0x15, 0x84, 0x2b, 0xb8, 0x8e, 0x87, 0x83, 0x84, 0xb4, 0xa1, 0x1c, 0x32, 0xde, 0x73,
+	0x99, 0x41, 0xf7, 0x22, 0x16, 0x1a, 0xbd, 0xc0, 0xa2, 0x11, 0xe3, 0x95, 0xa2, 0xf0, 0x24, 0xf2,
+	0x6c, 0x27, 0x3e, 0xfa, 0x40, 0x9e, 0x5c, 0xfb, 0xbb, 0x00, 0x0b, 0xe2, 0x96, 0x91, 0x2f, 0x14,
+	0xc8, 0xcb, 0x3d, 0x47, 0x36, 0xc6, 0xcc, 0x89, 0xe7, 0x17, 0x6b, 0x75, 0x73, 0x1a, 0x57, 0xf9,
+	0x4a, 0x1a, 0x2f, 0x7f, 0xfe, 0xeb, 0x9f, 0xdf, 0xce, 0xd7, 0xc8, 0x8a, 0xd6, 0xb6, 0x33, 0xb6,
+	0xb8, 0x5c, 0xab, 0xe4, 0x1b, 0x05, 0x16, 0x44, 0xe9, 0xa4, 0x39, 0x31, 0xf7, 0xc8, 0xbe, 0xad,
+	0x6e, 0x4c, 0xe1, 0x89, 0x10, 0x6d, 0x01, 0xb1, 0x49, 0x9a, 0x63, 0x20, 0x62, 0x0b, 0xd7, 0x3e,
+	0x19, 0x7e, 0x3b, 0x9f, 0xca, 0xc6, 0x08, 0x33, 0x39, 0x5b, 0x67, 0xca, 0xc6, 0xa4, 0x16, 0xd7,
+	0x99, 0x8d, 0x91, 0xe2, 0xdf, 0x2b, 0x50, 0x1a, 0x2e, 0x3d, 0x72, 0x75, 0x52, 0xfe, 0x67, 0x17,
+	0x6b, 0x75, 0x6b, 0x4a, 0x6f, 0x04, 0xba, 0x2e, 0x80, 0xb6, 0x48, 0x2b, 0x1b, 0x28, 0xa4, 0xfd,
+	0x8c, 0x3e, 0x7d, 0xa7, 0x40, 0x21, 0xf9, 0x10, 0x27, 0x56, 0x9f, 0x5e, 0x98, 0xd5, 0xd6, 0x54,
+	0xbe, 0x48, 0xb6, 0x2d, 0xc8, 0x5a, 0x64, 0x23, 0x9b, 0x0c, 0xaf, 0x46, 0x8a, 0xeb, 0x6b, 0x05,
+	0x0a, 0xb8, 0x1a, 0x27, 0x73, 0xa5, 0xd7, 0x6a, 0xb5, 0x35, 0x95, 0x2f, 0x72, 0xad, 0x0b, 0xae,
+	0x3a, 0x59, 0xcd, 0xe6, 0xc2, 0xc5, 0x19, 0xb3, 0xe4, 0xe2, 0x7d, 0x46, 0x5e, 0x99, 0x94, 0x7c,
+	0x64, 0xa1, 0x56, 0x9b, 0x67, 0x3b, 0x22, 0x82, 0x2a, 0x10, 0x9a, 0xe4, 0x4a, 0x36, 0x42, 0xd4,
+	0xa7, 0xc1, 0x68, 0x5f, 0x3a, 0xef, 0x3c, 0xfd, 0xa3, 0xa6, 0xfc, 0x38, 0xa8, 0x29, 0x8f, 0x06,
+	0x35, 0xe5, 0xf1, 0xa0, 0xa6, 0x3c, 0x1d, 0xd4, 0x94, 0x87, 0xa7, 0xb5, 0xb9, 0xc7, 0xa7, 0xb5,
+	0xb9, 0xdf, 0x4e, 0x6b, 0x73, 0x1f, 0x5d, 0x1d, 0x99, 0xc2, 0x6d, 0x7b, 0x9f, 0x76, 0xb9, 0xd6,
+	0xb6, 0xb7, 0xcc, 0x7b, 0xd4, 0xf1, 0xb4, 0xa3, 0x11, 0x09, 0x31, 0x8f, 0xbb, 0x79, 0xb1, 0x34,
+	0xae, 0xff, 0x33, 0x00, 0x53, 0x3d, 0xad, 0xe4, 0x34, 0x0c, 0x00, 0x00,
 }
 
 func (this *QueryParamsRequest) VerboseEqual(that interface{}) error {
@@ -1428,6 +1544,132 @@ func (this *QueryMarketsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueryTWAPRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueryTWAPRequest)
+	if !ok {
+		that2, ok := that.(QueryTWAPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryTWAPRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryTWAPRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryTWAPRequest but is not nil && this == nil")
+	}
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
+	}
+	if this.WindowSeconds != that1.WindowSeconds {
+		return fmt.Errorf("WindowSeconds this(%v) Not Equal that(%v)", this.WindowSeconds, that1.WindowSeconds)
+	}
+	return nil
+}
+func (this *QueryTWAPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTWAPRequest)
+	if !ok {
+		that2, ok := that.(QueryTWAPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	if this.WindowSeconds != that1.WindowSeconds {
+		return false
+	}
+	return true
+}
+func (this *QueryTWAPResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueryTWAPResponse)
+	if !ok {
+		that2, ok := that.(QueryTWAPResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryTWAPResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryTWAPResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryTWAPResponse but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.WindowSeconds != that1.WindowSeconds {
+		return fmt.Errorf("WindowSeconds this(%v) Not Equal that(%v)", this.WindowSeconds, that1.WindowSeconds)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	return nil
+}
+func (this *QueryTWAPResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTWAPResponse)
+	if !ok {
+		that2, ok := that.(QueryTWAPResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.WindowSeconds != that1.WindowSeconds {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
 func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -1605,6 +1847,15 @@ func (this *MarketResponse) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if this.MaxPriceDeviationBps != that1.MaxPriceDeviationBps {
+		return fmt.Errorf("MaxPriceDeviationBps this(%v) Not Equal that(%v)", this.MaxPriceDeviationBps, that1.MaxPriceDeviationBps)
+	}
+	if this.MinOracles != that1.MinOracles {
+		return fmt.Errorf("MinOracles this(%v) Not Equal that(%v)", this.MinOracles, that1.MinOracles)
+	}
+	if this.ResumeAfterUpdates != that1.ResumeAfterUpdates {
+		return fmt.Errorf("ResumeAfterUpdates this(%v) Not Equal that(%v)", this.ResumeAfterUpdates, that1.ResumeAfterUpdates)
+	}
 	return nil
 }
 func (this *MarketResponse) Equal(that interface{}) bool {
@@ -1646,6 +1897,15 @@ func (this *MarketResponse) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if this.MaxPriceDeviationBps != that1.MaxPriceDeviationBps {
+		return false
+	}
+	if this.MinOracles != that1.MinOracles {
+		return false
+	}
+	if this.ResumeAfterUpdates != that1.ResumeAfterUpdates {
+		return false
+	}
 	return true
 }
 
@@ -1673,6 +1933,8 @@ type QueryClient interface {
 	Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error)
 	// Markets queries all markets
 	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// TWAP queries the time weighted average price of a market over a trailing window
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
 }
 
 type queryClient struct {
@@ -1737,6 +1999,15 @@ func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/zgc.pricefeed.v1beta1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the pricefeed module.
@@ -1751,6 +2022,8 @@ type QueryServer interface {
 	Oracles(context.Context, *QueryOraclesRequest) (*QueryOraclesResponse, error)
 	// Markets queries all markets
 	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// TWAP queries the time weighted average price of a market over a trailing window
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1775,6 +2048,9 @@ func (*UnimplementedQueryServer) Oracles(ctx context.Context, req *QueryOraclesR
 func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
 }
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1888,6 +2164,24 @@ func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zgc.pricefeed.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zgc.pricefeed.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1916,6 +2210,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Markets",
 			Handler:    _Query_Markets_Handler,
 		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zgc/pricefeed/v1beta1/query.proto",
@@ -2289,7 +2587,7 @@ func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PostedPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2299,52 +2597,32 @@ func (m *PostedPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PostedPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PostedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrentPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2354,12 +2632,12 @@ func (m *CurrentPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrentPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrentPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2373,7 +2651,12 @@ func (m *CurrentPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.MarketID) > 0 {
 		i -= len(m.MarketID)
 		copy(dAtA[i:], m.MarketID)
@@ -2384,7 +2667,7 @@ func (m *CurrentPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *PostedPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2394,27 +2677,137 @@ func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PostedPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PostedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
 	}
-	if len(m.Oracles) > 0 {
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumeAfterUpdates != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResumeAfterUpdates))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinOracles != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinOracles))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPriceDeviationBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPriceDeviationBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
 		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Oracles[iNdEx])
 			copy(dAtA[i:], m.Oracles[iNdEx])
@@ -2606,6 +2999,40 @@ func (m *QueryMarketsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *PostedPriceResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2669,6 +3096,15 @@ func (m *MarketResponse) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.MaxPriceDeviationBps != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPriceDeviationBps))
+	}
+	if m.MinOracles != 0 {
+		n += 1 + sovQuery(uint64(m.MinOracles))
+	}
+	if m.ResumeAfterUpdates != 0 {
+		n += 1 + sovQuery(uint64(m.ResumeAfterUpdates))
+	}
 	return n
 }
 
@@ -3574,6 +4010,242 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4048,6 +4720,63 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviationBps", wireType)
+			}
+			m.MaxPriceDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceDeviationBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracles", wireType)
+			}
+			m.MinOracles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAfterUpdates", wireType)
+			}
+			m.ResumeAfterUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeAfterUpdates |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
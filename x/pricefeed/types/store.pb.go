@@ -82,6 +82,15 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// max_price_deviation_bps is the maximum change, in basis points, allowed between two consecutive current
+	// prices before the market is halted. Zero disables the check.
+	MaxPriceDeviationBps uint32 `protobuf:"varint,6,opt,name=max_price_deviation_bps,json=maxPriceDeviationBps,proto3" json:"max_price_deviation_bps,omitempty"`
+	// min_oracles is the minimum number of distinct oracles with an unexpired price required for the market to
+	// be priced. Zero disables the check.
+	MinOracles uint32 `protobuf:"varint,7,opt,name=min_oracles,json=minOracles,proto3" json:"min_oracles,omitempty"`
+	// resume_after_updates is the number of consecutive updates that must pass the checks before a halted market
+	// resumes. Zero resumes the market at the first update that passes.
+	ResumeAfterUpdates uint32 `protobuf:"varint,8,opt,name=resume_after_updates,json=resumeAfterUpdates,proto3" json:"resume_after_updates,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,6 +161,27 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
+func (m *Market) GetMaxPriceDeviationBps() uint32 {
+	if m != nil {
+		return m.MaxPriceDeviationBps
+	}
+	return 0
+}
+
+func (m *Market) GetMinOracles() uint32 {
+	if m != nil {
+		return m.MinOracles
+	}
+	return 0
+}
+
+func (m *Market) GetResumeAfterUpdates() uint32 {
+	if m != nil {
+		return m.ResumeAfterUpdates
+	}
+	return 0
+}
+
 // PostedPrice defines a price for market posted by a specific oracle.
 type PostedPrice struct {
 	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -261,50 +291,121 @@ func (m *CurrentPrice) GetMarketID() string {
 	return ""
 }
 
+// PriceSnapshot records the price accumulator of a market at the end of a block, the time weighted average
+// price between two snapshots is the difference of their cumulative prices divided by the difference of
+// their priced times.
+type PriceSnapshot struct {
+	MarketID string    `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Time     time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// price is the current price of the market at time, zero if the market had no valid price
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// cumulative_price is the sum of the current price multiplied by the seconds it was in effect
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+	// priced_seconds is the number of seconds during which the market had a valid price
+	PricedSeconds github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=priced_seconds,json=pricedSeconds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"priced_seconds"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2c3c1086cf495eb, []int{4}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "zgc.pricefeed.v1beta1.Params")
 	proto.RegisterType((*Market)(nil), "zgc.pricefeed.v1beta1.Market")
 	proto.RegisterType((*PostedPrice)(nil), "zgc.pricefeed.v1beta1.PostedPrice")
 	proto.RegisterType((*CurrentPrice)(nil), "zgc.pricefeed.v1beta1.CurrentPrice")
+	proto.RegisterType((*PriceSnapshot)(nil), "zgc.pricefeed.v1beta1.PriceSnapshot")
 }
 
 func init() { proto.RegisterFile("zgc/pricefeed/v1beta1/store.proto", fileDescriptor_b2c3c1086cf495eb) }
 
 var fileDescriptor_b2c3c1086cf495eb = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x36, 0x6d, 0x7e, 0x36, 0x01, 0x24, 0xf3, 0x23, 0x13, 0xa9, 0x76, 0xc8, 0x01, 0x19,
-	0x89, 0xac, 0xdb, 0x72, 0xe5, 0x12, 0x93, 0x43, 0x7b, 0x00, 0x45, 0x16, 0x27, 0x2e, 0xd1, 0x7a,
-	0x3d, 0x75, 0xad, 0xc6, 0x5d, 0xb3, 0xbb, 0xa9, 0x9a, 0x5e, 0x78, 0x85, 0x3e, 0x06, 0x42, 0xe2,
-	0xc6, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0xd2, 0xe2, 0x3c, 0x00, 0x77, 0x4e, 0xc8, 0x5e, 0xbb,
-	0xea, 0x81, 0x03, 0x15, 0x9c, 0x92, 0xf9, 0xe6, 0x9b, 0x6f, 0x66, 0xbe, 0x1d, 0xe3, 0x27, 0xa7,
-	0x11, 0x73, 0x53, 0x11, 0x33, 0xd8, 0x07, 0x08, 0xdd, 0xe3, 0xed, 0x00, 0x14, 0xdd, 0x76, 0xa5,
-	0xe2, 0x02, 0x48, 0x2a, 0xb8, 0xe2, 0xc6, 0xc3, 0xd3, 0x88, 0x91, 0x6b, 0x0a, 0x29, 0x29, 0xbd,
-	0xc7, 0x8c, 0xcb, 0x84, 0xcb, 0x69, 0x41, 0x72, 0x75, 0xa0, 0x2b, 0x7a, 0x0f, 0x22, 0x1e, 0x71,
-	0x8d, 0xe7, 0xff, 0x4a, 0xd4, 0x8e, 0x38, 0x8f, 0x66, 0xe0, 0x16, 0x51, 0x30, 0xdf, 0x77, 0x55,
-	0x9c, 0x80, 0x54, 0x34, 0x49, 0x35, 0x61, 0xe0, 0xe3, 0xc6, 0x84, 0x0a, 0x9a, 0x48, 0x63, 0x17,
-	0x37, 0x13, 0x2a, 0x0e, 0x41, 0x49, 0x13, 0xf5, 0xeb, 0x4e, 0x67, 0x67, 0x93, 0xfc, 0x71, 0x08,
-	0xf2, 0xba, 0x60, 0x79, 0xf7, 0xce, 0x97, 0x76, 0xed, 0xd3, 0xa5, 0xdd, 0xd4, 0xb1, 0xf4, 0xab,
-	0xf2, 0xc1, 0x4f, 0x84, 0x1b, 0x1a, 0x34, 0x9e, 0xe1, 0xb6, 0x46, 0xa7, 0x71, 0x68, 0xa2, 0x3e,
-	0x72, 0xda, 0x5e, 0x37, 0x5b, 0xda, 0x2d, 0x9d, 0xde, 0x1b, 0xfb, 0x2d, 0x9d, 0xde, 0x0b, 0x8d,
-	0x4d, 0x8c, 0x03, 0x2a, 0x61, 0x4a, 0xa5, 0x04, 0x65, 0xae, 0xe5, 0x5c, 0xbf, 0x9d, 0x23, 0xa3,
-	0x1c, 0x30, 0x6c, 0xdc, 0x79, 0x3f, 0xe7, 0xaa, 0xca, 0xd7, 0x8b, 0x3c, 0x2e, 0x20, 0x4d, 0x08,
-	0x70, 0x93, 0x0b, 0xca, 0x66, 0x20, 0xcd, 0xf5, 0x7e, 0xdd, 0xe9, 0x7a, 0xbb, 0xbf, 0x96, 0xf6,
-	0x30, 0x8a, 0xd5, 0xc1, 0x3c, 0x20, 0x8c, 0x27, 0xa5, 0x5d, 0xe5, 0xcf, 0x50, 0x86, 0x87, 0xae,
-	0x5a, 0xa4, 0x20, 0xc9, 0x88, 0xb1, 0x51, 0x18, 0x0a, 0x90, 0xf2, 0xeb, 0x97, 0xe1, 0xfd, 0xd2,
-	0xd4, 0x12, 0xf1, 0x16, 0x0a, 0xa4, 0x5f, 0x09, 0x1b, 0x8f, 0x70, 0x83, 0x32, 0x15, 0x1f, 0x83,
-	0xb9, 0xd1, 0x47, 0x4e, 0xcb, 0x2f, 0xa3, 0xc1, 0xe7, 0x35, 0xdc, 0x99, 0x70, 0xa9, 0x20, 0x9c,
-	0xe4, 0x76, 0xdd, 0x66, 0x6d, 0x8e, 0xef, 0x6a, 0xf5, 0x29, 0xd5, 0x2d, 0x8b, 0xd5, 0xff, 0xe7,
-	0xf4, 0x77, 0xb4, 0x7e, 0x89, 0x19, 0x63, 0xbc, 0x51, 0xbc, 0xa9, 0xb6, 0xd0, 0x23, 0xf9, 0x33,
-	0x7e, 0x5f, 0xda, 0x4f, 0xff, 0xa2, 0xd7, 0x18, 0x98, 0xaf, 0x8b, 0x8d, 0x97, 0xb8, 0x01, 0x27,
-	0x69, 0x2c, 0x16, 0xe6, 0x7a, 0x1f, 0x39, 0x9d, 0x9d, 0x1e, 0xd1, 0x97, 0x46, 0xaa, 0x4b, 0x23,
-	0x6f, 0xab, 0x4b, 0xf3, 0x5a, 0x79, 0x8b, 0xb3, 0x4b, 0x1b, 0xf9, 0x65, 0xcd, 0xe0, 0x03, 0xee,
-	0xbe, 0x9a, 0x0b, 0x01, 0x47, 0xea, 0xd6, 0x7e, 0x5d, 0x8f, 0xbf, 0xf6, 0x0f, 0xe3, 0x7b, 0x6f,
-	0xae, 0x7e, 0x58, 0xe8, 0x63, 0x66, 0xa1, 0xf3, 0xcc, 0x42, 0x17, 0x99, 0x85, 0xae, 0x32, 0x0b,
-	0x9d, 0xad, 0xac, 0xda, 0xc5, 0xca, 0xaa, 0x7d, 0x5b, 0x59, 0xb5, 0x77, 0xcf, 0x6f, 0x08, 0x6e,
-	0x45, 0x33, 0x1a, 0x48, 0x77, 0x2b, 0x1a, 0xb2, 0x03, 0x1a, 0x1f, 0xb9, 0x27, 0x37, 0xbe, 0xde,
-	0x42, 0x3a, 0x68, 0x14, 0x6b, 0xbf, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xf3, 0xeb, 0x7a,
-	0xdb, 0x03, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0xd3, 0x34, 0x49, 0x2f, 0x4d, 0xfb, 0x97, 0xff, 0x05, 0x4c, 0xa5, 0xda, 0x21, 0x03,
+	0x0a, 0x12, 0xb1, 0xdb, 0x22, 0x24, 0x06, 0x96, 0x98, 0x0c, 0xed, 0x00, 0x54, 0x2e, 0x1d, 0x60,
+	0xb1, 0xce, 0xe7, 0xab, 0x6b, 0x35, 0xce, 0x19, 0xbf, 0x73, 0x95, 0x76, 0xe1, 0x2b, 0xf4, 0x63,
+	0x20, 0x24, 0x36, 0x3e, 0x44, 0xc7, 0x8a, 0x09, 0x31, 0xa4, 0x25, 0x5d, 0xf9, 0x02, 0x30, 0x21,
+	0xdf, 0xd9, 0xa5, 0x03, 0x03, 0x29, 0x9d, 0x92, 0xfb, 0xfd, 0x7e, 0xef, 0xf7, 0xde, 0xbd, 0xf7,
+	0xce, 0xe8, 0xde, 0x51, 0x40, 0xac, 0x38, 0x09, 0x09, 0xdd, 0xa5, 0xd4, 0xb7, 0x0e, 0xd6, 0x3c,
+	0xca, 0xf1, 0x9a, 0x05, 0x9c, 0x25, 0xd4, 0x8c, 0x13, 0xc6, 0x99, 0x7a, 0xeb, 0x28, 0x20, 0xe6,
+	0xa5, 0xc4, 0xcc, 0x25, 0xcb, 0x77, 0x09, 0x83, 0x88, 0x81, 0x2b, 0x44, 0x96, 0x3c, 0xc8, 0x88,
+	0xe5, 0xa5, 0x80, 0x05, 0x4c, 0xe2, 0xd9, 0xbf, 0x1c, 0x35, 0x02, 0xc6, 0x82, 0x01, 0xb5, 0xc4,
+	0xc9, 0x4b, 0x77, 0x2d, 0x1e, 0x46, 0x14, 0x38, 0x8e, 0x62, 0x29, 0x68, 0x3b, 0xa8, 0xba, 0x85,
+	0x13, 0x1c, 0x81, 0xba, 0x81, 0x6a, 0x11, 0x4e, 0xf6, 0x29, 0x07, 0x4d, 0x69, 0xcd, 0x74, 0x1a,
+	0xeb, 0x2b, 0xe6, 0x1f, 0x8b, 0x30, 0x9f, 0x0b, 0x95, 0xbd, 0x78, 0x32, 0x36, 0x4a, 0x1f, 0xce,
+	0x8c, 0x9a, 0x3c, 0x83, 0x53, 0x84, 0xb7, 0x7f, 0x94, 0x51, 0x55, 0x82, 0xea, 0x03, 0x34, 0x27,
+	0x51, 0x37, 0xf4, 0x35, 0xa5, 0xa5, 0x74, 0xe6, 0xec, 0xf9, 0xc9, 0xd8, 0xa8, 0x4b, 0x7a, 0xb3,
+	0xef, 0xd4, 0x25, 0xbd, 0xe9, 0xab, 0x2b, 0x08, 0x79, 0x18, 0xa8, 0x8b, 0x01, 0x28, 0xd7, 0xca,
+	0x99, 0xd6, 0x99, 0xcb, 0x90, 0x5e, 0x06, 0xa8, 0x06, 0x6a, 0xbc, 0x4d, 0x19, 0x2f, 0xf8, 0x19,
+	0xc1, 0x23, 0x01, 0x49, 0x81, 0x87, 0x6a, 0x2c, 0xc1, 0x64, 0x40, 0x41, 0xab, 0xb4, 0x66, 0x3a,
+	0xf3, 0xf6, 0xc6, 0xcf, 0xb1, 0xd1, 0x0d, 0x42, 0xbe, 0x97, 0x7a, 0x26, 0x61, 0x51, 0xde, 0xae,
+	0xfc, 0xa7, 0x0b, 0xfe, 0xbe, 0xc5, 0x0f, 0x63, 0x0a, 0x66, 0x8f, 0x90, 0x9e, 0xef, 0x27, 0x14,
+	0xe0, 0xf3, 0xa7, 0xee, 0xff, 0x79, 0x53, 0x73, 0xc4, 0x3e, 0xe4, 0x14, 0x9c, 0xc2, 0x58, 0xbd,
+	0x8d, 0xaa, 0x98, 0xf0, 0xf0, 0x80, 0x6a, 0xb3, 0x2d, 0xa5, 0x53, 0x77, 0xf2, 0x93, 0xfa, 0x18,
+	0xdd, 0x89, 0xf0, 0xc8, 0x15, 0xbd, 0x72, 0x7d, 0x7a, 0x10, 0x62, 0x1e, 0xb2, 0xa1, 0xeb, 0xc5,
+	0xa0, 0x55, 0x5b, 0x4a, 0xa7, 0xe9, 0x2c, 0x45, 0x78, 0xb4, 0x95, 0xb1, 0xfd, 0x82, 0xb4, 0x63,
+	0xc8, 0xee, 0x14, 0x85, 0x43, 0xb7, 0x28, 0xbb, 0x26, 0xa4, 0x28, 0x0a, 0x87, 0x2f, 0xf3, 0x7c,
+	0xab, 0x68, 0x29, 0xa1, 0x90, 0x46, 0xd4, 0xc5, 0xbb, 0x9c, 0x26, 0x6e, 0x1a, 0xfb, 0x98, 0x53,
+	0xd0, 0xea, 0x42, 0xa9, 0x4a, 0xae, 0x97, 0x51, 0x3b, 0x92, 0x69, 0x7f, 0x2c, 0xa3, 0xc6, 0x16,
+	0x03, 0x4e, 0x7d, 0x91, 0x6e, 0x9a, 0x01, 0x30, 0xb4, 0x20, 0x2b, 0x71, 0xb1, 0xbc, 0xbc, 0x18,
+	0xc2, 0x4d, 0xf6, 0xb1, 0x29, 0xfd, 0x73, 0x4c, 0xed, 0xa3, 0x59, 0xd1, 0x31, 0x39, 0x4c, 0xdb,
+	0xcc, 0x16, 0xea, 0xeb, 0xd8, 0xb8, 0xff, 0x17, 0xb9, 0xfa, 0x94, 0x38, 0x32, 0x58, 0x7d, 0x8a,
+	0xaa, 0x74, 0x14, 0x87, 0xc9, 0xa1, 0x56, 0x69, 0x29, 0x9d, 0xc6, 0xfa, 0xb2, 0x29, 0x77, 0xde,
+	0x2c, 0x76, 0xde, 0x7c, 0x55, 0xec, 0xbc, 0x5d, 0xcf, 0x52, 0x1c, 0x9f, 0x19, 0x8a, 0x93, 0xc7,
+	0xb4, 0xdf, 0xa1, 0xf9, 0x67, 0x69, 0x92, 0xd0, 0x21, 0x9f, 0xba, 0x5f, 0x97, 0xe5, 0x97, 0xff,
+	0xa1, 0xfc, 0xf6, 0xf7, 0x32, 0x6a, 0x8a, 0xd4, 0xdb, 0x43, 0x1c, 0xc3, 0x1e, 0x9b, 0xea, 0xcd,
+	0x3c, 0x41, 0x95, 0xec, 0x41, 0x6b, 0xe5, 0x29, 0x6e, 0x2e, 0x22, 0x6e, 0xa8, 0xf7, 0xaf, 0xd1,
+	0x7f, 0x24, 0x8d, 0xd2, 0x01, 0xce, 0x5e, 0x81, 0x5c, 0x7f, 0xad, 0x72, 0x2d, 0xc3, 0xc5, 0xdf,
+	0x3e, 0x72, 0x10, 0x3b, 0x68, 0x41, 0xf8, 0xf9, 0x2e, 0x50, 0xc2, 0x86, 0x3e, 0x68, 0xb3, 0xd7,
+	0x32, 0x6e, 0x4a, 0x97, 0x6d, 0x69, 0x62, 0xbf, 0x38, 0xff, 0xa6, 0x2b, 0xef, 0x27, 0xba, 0x72,
+	0x32, 0xd1, 0x95, 0xd3, 0x89, 0xae, 0x9c, 0x4f, 0x74, 0xe5, 0xf8, 0x42, 0x2f, 0x9d, 0x5e, 0xe8,
+	0xa5, 0x2f, 0x17, 0x7a, 0xe9, 0xcd, 0xc3, 0x2b, 0xc6, 0xab, 0xc1, 0x00, 0x7b, 0x60, 0xad, 0x06,
+	0x5d, 0xb2, 0x87, 0xc3, 0xa1, 0x35, 0xba, 0xf2, 0xd9, 0x16, 0x29, 0xbc, 0xaa, 0xe8, 0xf5, 0xa3,
+	0x5f, 0x03, 0x00, 0xc3, 0xd7, 0xf2, 0x47, 0xd4, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) VerboseEqual(that interface{}) error {
@@ -416,6 +517,15 @@ func (this *Market) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if this.MaxPriceDeviationBps != that1.MaxPriceDeviationBps {
+		return fmt.Errorf("MaxPriceDeviationBps this(%v) Not Equal that(%v)", this.MaxPriceDeviationBps, that1.MaxPriceDeviationBps)
+	}
+	if this.MinOracles != that1.MinOracles {
+		return fmt.Errorf("MinOracles this(%v) Not Equal that(%v)", this.MinOracles, that1.MinOracles)
+	}
+	if this.ResumeAfterUpdates != that1.ResumeAfterUpdates {
+		return fmt.Errorf("ResumeAfterUpdates this(%v) Not Equal that(%v)", this.ResumeAfterUpdates, that1.ResumeAfterUpdates)
+	}
 	return nil
 }
 func (this *Market) Equal(that interface{}) bool {
@@ -457,6 +567,15 @@ func (this *Market) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if this.MaxPriceDeviationBps != that1.MaxPriceDeviationBps {
+		return false
+	}
+	if this.MinOracles != that1.MinOracles {
+		return false
+	}
+	if this.ResumeAfterUpdates != that1.ResumeAfterUpdates {
+		return false
+	}
 	return true
 }
 func (this *PostedPrice) VerboseEqual(that interface{}) error {
@@ -591,6 +710,84 @@ func (this *CurrentPrice) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PriceSnapshot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceSnapshot")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceSnapshot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceSnapshot but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Time.Equal(that1.Time) {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return fmt.Errorf("CumulativePrice this(%v) Not Equal that(%v)", this.CumulativePrice, that1.CumulativePrice)
+	}
+	if !this.PricedSeconds.Equal(that1.PricedSeconds) {
+		return fmt.Errorf("PricedSeconds this(%v) Not Equal that(%v)", this.PricedSeconds, that1.PricedSeconds)
+	}
+	return nil
+}
+func (this *PriceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return false
+	}
+	if !this.PricedSeconds.Equal(that1.PricedSeconds) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -648,6 +845,21 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResumeAfterUpdates != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.ResumeAfterUpdates))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinOracles != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOracles))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPriceDeviationBps != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MaxPriceDeviationBps))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -786,6 +998,74 @@ func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PricedSeconds.Size()
+		i -= size
+		if _, err := m.PricedSeconds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStore(v)
 	base := offset
@@ -839,6 +1119,15 @@ func (m *Market) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.MaxPriceDeviationBps != 0 {
+		n += 1 + sovStore(uint64(m.MaxPriceDeviationBps))
+	}
+	if m.MinOracles != 0 {
+		n += 1 + sovStore(uint64(m.MinOracles))
+	}
+	if m.ResumeAfterUpdates != 0 {
+		n += 1 + sovStore(uint64(m.ResumeAfterUpdates))
+	}
 	return n
 }
 
@@ -878,6 +1167,27 @@ func (m *CurrentPrice) Size() (n int) {
 	return n
 }
 
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.PricedSeconds.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
 func sovStore(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1145,6 +1455,63 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviationBps", wireType)
+			}
+			m.MaxPriceDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceDeviationBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracles", wireType)
+			}
+			m.MinOracles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAfterUpdates", wireType)
+			}
+			m.ResumeAfterUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeAfterUpdates |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1465,6 +1832,223 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricedSeconds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PricedSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStore(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
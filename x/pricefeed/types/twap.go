@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTWAPWindow is the longest window a time weighted average price can be computed over, price snapshots
+// older than it are pruned.
+const MaxTWAPWindow = 24 * time.Hour
+
+// NewPriceSnapshot returns a new PriceSnapshot
+func NewPriceSnapshot(marketID string, t time.Time, price, cumulativePrice, pricedSeconds sdk.Dec) PriceSnapshot {
+	return PriceSnapshot{
+		MarketID:        marketID,
+		Time:            t,
+		Price:           price,
+		CumulativePrice: cumulativePrice,
+		PricedSeconds:   pricedSeconds,
+	}
+}
+
+// Accumulate returns the cumulative price and priced seconds of the snapshot carried forward to t, assuming
+// the snapshot price stayed in effect until then.
+func (s PriceSnapshot) Accumulate(t time.Time) (cumulativePrice, pricedSeconds sdk.Dec) {
+	if !s.Price.IsPositive() || !t.After(s.Time) {
+		return s.CumulativePrice, s.PricedSeconds
+	}
+	elapsed := sdk.NewDec(t.Sub(s.Time).Nanoseconds()).QuoInt64(int64(time.Second))
+	return s.CumulativePrice.Add(s.Price.Mul(elapsed)), s.PricedSeconds.Add(elapsed)
+}